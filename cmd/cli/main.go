@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"mime"
 	"net/http"
 	"os"
+	"path/filepath"
 
 	_ "modernc.org/sqlite"
 )
@@ -33,6 +35,46 @@ func main() {
 			log.Fatal("--message flag is required")
 		}
 		triggerEvent(*serverURL, *message)
+	case "backup":
+		backupCmd := flag.NewFlagSet("backup", flag.ExitOnError)
+		out := backupCmd.String("out", "", "File to write the backup to (default: the name given by the server)")
+		serverURL := backupCmd.String("server", "http://localhost:8080", "Server URL")
+		if err := backupCmd.Parse(os.Args[2:]); err != nil {
+			log.Fatal("Failed to parse flags:", err)
+		}
+		download(*serverURL+"/api/admin/backup", *out)
+	case "restore":
+		restoreCmd := flag.NewFlagSet("restore", flag.ExitOnError)
+		in := restoreCmd.String("in", "", "Backup file to restore")
+		serverURL := restoreCmd.String("server", "http://localhost:8080", "Server URL")
+		if err := restoreCmd.Parse(os.Args[2:]); err != nil {
+			log.Fatal("Failed to parse flags:", err)
+		}
+
+		if *in == "" {
+			log.Fatal("--in flag is required")
+		}
+		upload(*serverURL+"/api/admin/restore", *in, "application/octet-stream")
+	case "export":
+		exportCmd := flag.NewFlagSet("export", flag.ExitOnError)
+		out := exportCmd.String("out", "", "File to write the dataset to (default: the name given by the server)")
+		serverURL := exportCmd.String("server", "http://localhost:8080", "Server URL")
+		if err := exportCmd.Parse(os.Args[2:]); err != nil {
+			log.Fatal("Failed to parse flags:", err)
+		}
+		download(*serverURL+"/api/admin/export", *out)
+	case "import":
+		importCmd := flag.NewFlagSet("import", flag.ExitOnError)
+		in := importCmd.String("in", "", "Dataset file to import")
+		serverURL := importCmd.String("server", "http://localhost:8080", "Server URL")
+		if err := importCmd.Parse(os.Args[2:]); err != nil {
+			log.Fatal("Failed to parse flags:", err)
+		}
+
+		if *in == "" {
+			log.Fatal("--in flag is required")
+		}
+		upload(*serverURL+"/api/admin/import", *in, "application/json")
 	default:
 		log.Fatal("Unknown command:", os.Args[1])
 	}
@@ -67,3 +109,76 @@ func triggerEvent(serverURL, message string) {
 	fmt.Println("Event triggered successfully:")
 	fmt.Println(string(body))
 }
+
+// download saves the file served at url to out, or to the file name the server gives it
+func download(url, out string) {
+	resp, err := http.Get(url)
+	if err != nil {
+		log.Fatal("Failed to download:", err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.Fatal("Failed to close response body:", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		log.Fatalf("Failed to download: %d - %s", resp.StatusCode, string(body))
+	}
+
+	if out == "" {
+		_, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition"))
+		if err != nil || params["filename"] == "" {
+			log.Fatal("Server did not name the file, pass --out")
+		}
+		out = filepath.Base(params["filename"])
+	}
+
+	file, err := os.Create(out)
+	if err != nil {
+		log.Fatal("Failed to create file:", err)
+	}
+	if _, err := io.Copy(file, resp.Body); err != nil {
+		log.Fatal("Failed to write file:", err)
+	}
+	if err := file.Close(); err != nil {
+		log.Fatal("Failed to write file:", err)
+	}
+
+	fmt.Println("Written to", out)
+}
+
+// upload posts the file at in to url
+func upload(url, in, contentType string) {
+	file, err := os.Open(in)
+	if err != nil {
+		log.Fatal("Failed to open file:", err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Fatal("Failed to close file:", err)
+		}
+	}()
+
+	resp, err := http.Post(url, contentType, file)
+	if err != nil {
+		log.Fatal("Failed to upload:", err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.Fatal("Failed to close response body:", err)
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Fatal("Failed to read response body:", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		log.Fatalf("Failed to upload: %d - %s", resp.StatusCode, string(body))
+	}
+
+	fmt.Println("Uploaded successfully:")
+	fmt.Println(string(body))
+}
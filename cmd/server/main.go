@@ -6,9 +6,19 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"path/filepath"
 	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/goodreads"
+	"github.com/amalgamated-tools/bookscraping/pkg/hardcover"
+	"github.com/amalgamated-tools/bookscraping/pkg/jobs"
+	"github.com/amalgamated-tools/bookscraping/pkg/match"
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+	"github.com/amalgamated-tools/bookscraping/pkg/openlibrary"
 	"github.com/amalgamated-tools/bookscraping/pkg/server"
 	"github.com/amalgamated-tools/bookscraping/pkg/telemetry"
 )
@@ -107,11 +117,98 @@ func realMain(cancelCtx context.Context) error { //nolint:contextcheck // The ne
 		slog.Debug("Using server address from SERVER_ADDR", slog.String("address", addr))
 	}
 
+	// Optional selector override so scraping can be hot-fixed without a release
+	var grOpts []goodreads.ClientOption
+	if selectorsFile, ok := os.LookupEnv("GOODREADS_SELECTORS_FILE"); ok && selectorsFile != "" {
+		selectors, err := goodreads.LoadSelectors(selectorsFile)
+		if err != nil {
+			return fmt.Errorf("failed to load Goodreads selectors: %w", err)
+		}
+		slog.Info("Using Goodreads selectors override", slog.String("path", selectorsFile))
+		grOpts = append(grOpts, goodreads.WithSelectors(selectors))
+	}
+
+	// Bulk completion fetches many series, so requests are spaced out by default
+	interval := goodreads.DefaultRequestInterval
+	if value, ok := os.LookupEnv("GOODREADS_REQUEST_INTERVAL"); ok && value != "" {
+		interval, err = time.ParseDuration(value)
+		if err != nil || interval < 0 {
+			return fmt.Errorf("GOODREADS_REQUEST_INTERVAL must be a non-negative duration such as 1s, got %q", value)
+		}
+	}
+	grOpts = append(grOpts, goodreads.WithRateLimit(interval))
+
+	grClient := goodreads.NewClient(grOpts...)
+
+	olClient := openlibrary.NewClient()
+
+	providers, err := metadataProviders(os.Getenv("METADATA_PROVIDERS"), grClient, olClient)
+	if err != nil {
+		return err
+	}
+	mergeMetadata, _ := strconv.ParseBool(os.Getenv("METADATA_MERGE"))
+
+	matcher, err := matcherFromEnv()
+	if err != nil {
+		return err
+	}
+
+	var refreshDays int64
+	if value := os.Getenv("SERIES_REFRESH_DAYS"); value != "" {
+		refreshDays, err = strconv.ParseInt(value, 10, 64)
+		if err != nil || refreshDays < 0 {
+			return fmt.Errorf("SERIES_REFRESH_DAYS must be a non-negative number of days, got %q", value)
+		}
+	}
+
+	jobOpts := []jobs.Option{}
+	if value := os.Getenv("JOB_WORKERS"); value != "" {
+		workers, err := strconv.Atoi(value)
+		if err != nil || workers < 1 {
+			return fmt.Errorf("JOB_WORKERS must be a positive number, got %q", value)
+		}
+		jobOpts = append(jobOpts, jobs.WithWorkers(workers))
+	}
+	if value := os.Getenv("JOB_MAX_ATTEMPTS"); value != "" {
+		attempts, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || attempts < 1 {
+			return fmt.Errorf("JOB_MAX_ATTEMPTS must be a positive number, got %q", value)
+		}
+		jobOpts = append(jobOpts, jobs.WithMaxAttempts(attempts))
+	}
+
+	backupDir := os.Getenv("BACKUP_DIR")
+	if backupDir == "" {
+		backupDir = filepath.Join(filepath.Dir(db.FilePath()), "backups")
+	}
+	var backupInterval time.Duration
+	if value := os.Getenv("BACKUP_INTERVAL"); value != "" {
+		backupInterval, err = time.ParseDuration(value)
+		if err != nil || backupInterval < 0 {
+			return fmt.Errorf("BACKUP_INTERVAL must be a non-negative duration such as 24h, got %q", value)
+		}
+	}
+	backupRetention := 7
+	if value := os.Getenv("BACKUP_RETENTION"); value != "" {
+		backupRetention, err = strconv.Atoi(value)
+		if err != nil || backupRetention < 0 {
+			return fmt.Errorf("BACKUP_RETENTION must be a non-negative number, got %q", value)
+		}
+	}
+
 	// Start server
 	srv := server.NewServer(
 		cancelCtx,
 		server.WithQuerier(queries),
 		server.WithAddr(addr),
+		server.WithGoodreadsClient(grClient),
+		server.WithOpenLibraryClient(olClient),
+		server.WithMetadataProviders(providers...),
+		server.WithMergedMetadata(mergeMetadata),
+		server.WithMatcher(matcher),
+		server.WithSeriesRefresh(refreshDays),
+		server.WithJobQueue(jobs.New(queries, jobOpts...)),
+		server.WithBackups(backupDir, backupInterval, backupRetention),
 	)
 
 	slog.InfoContext(cancelCtx, "Starting BookScraping server",
@@ -120,3 +217,59 @@ func realMain(cancelCtx context.Context) error { //nolint:contextcheck // The ne
 
 	return srv.Run(cancelCtx)
 }
+
+// matcherFromEnv builds the series matcher, reading thresholds from MATCH_THRESHOLD and MATCH_REVIEW_THRESHOLD
+func matcherFromEnv() (*match.Matcher, error) {
+	var opts []match.Option
+	for _, env := range []struct {
+		name string
+		opt  func(float64) match.Option
+	}{
+		{"MATCH_THRESHOLD", match.WithThreshold},
+		{"MATCH_REVIEW_THRESHOLD", match.WithReviewThreshold},
+	} {
+		value := os.Getenv(env.name)
+		if value == "" {
+			continue
+		}
+		threshold, err := strconv.ParseFloat(value, 64)
+		if err != nil || threshold < 0 || threshold > 1 {
+			return nil, fmt.Errorf("%s must be a number between 0 and 1, got %q", env.name, value)
+		}
+		opts = append(opts, env.opt(threshold))
+	}
+
+	matcher := match.New(opts...)
+	if threshold, review := matcher.Thresholds(); review > threshold {
+		return nil, fmt.Errorf("MATCH_REVIEW_THRESHOLD (%g) must not be above MATCH_THRESHOLD (%g)", review, threshold)
+	}
+	return matcher, nil
+}
+
+// metadataProviders builds the providers named in a comma separated list, in order.
+// An empty list means Goodreads only.
+func metadataProviders(names string, grClient *goodreads.Client, olClient *openlibrary.Client) ([]metadata.Provider, error) {
+	if strings.TrimSpace(names) == "" {
+		names = goodreads.ProviderName
+	}
+
+	var providers []metadata.Provider
+	for _, name := range strings.Split(names, ",") {
+		switch strings.TrimSpace(name) {
+		case goodreads.ProviderName:
+			providers = append(providers, goodreads.NewProvider(grClient))
+		case hardcover.ProviderName:
+			token := os.Getenv("HARDCOVER_API_TOKEN")
+			if token == "" {
+				return nil, fmt.Errorf("metadata provider %q needs HARDCOVER_API_TOKEN", name)
+			}
+			providers = append(providers, hardcover.NewProvider(hardcover.NewClient(token)))
+		case openlibrary.ProviderName:
+			providers = append(providers, openlibrary.NewProvider(olClient))
+		case "":
+		default:
+			return nil, fmt.Errorf("unknown metadata provider %q", name)
+		}
+	}
+	return providers, nil
+}
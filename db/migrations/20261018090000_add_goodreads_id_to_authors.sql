@@ -0,0 +1,7 @@
+-- migrate:up
+ALTER TABLE authors ADD COLUMN goodreads_id VARCHAR(255);
+CREATE INDEX IF NOT EXISTS idx_authors_goodreads_id ON authors(goodreads_id);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_authors_goodreads_id;
+ALTER TABLE authors DROP COLUMN goodreads_id;
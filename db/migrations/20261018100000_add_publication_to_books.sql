@@ -0,0 +1,9 @@
+-- migrate:up
+ALTER TABLE books ADD COLUMN publication_date VARCHAR(10);
+ALTER TABLE books ADD COLUMN to_be_published BOOLEAN DEFAULT 0;
+CREATE INDEX IF NOT EXISTS idx_books_publication_date ON books(publication_date);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_books_publication_date;
+ALTER TABLE books DROP COLUMN to_be_published;
+ALTER TABLE books DROP COLUMN publication_date;
@@ -0,0 +1,5 @@
+-- migrate:up
+ALTER TABLE books ADD COLUMN metadata_source VARCHAR(255);
+
+-- migrate:down
+ALTER TABLE books DROP COLUMN metadata_source;
@@ -0,0 +1,5 @@
+-- migrate:up
+ALTER TABLE books ADD COLUMN cover_url VARCHAR(512);
+
+-- migrate:down
+ALTER TABLE books DROP COLUMN cover_url;
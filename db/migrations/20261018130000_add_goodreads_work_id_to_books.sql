@@ -0,0 +1,7 @@
+-- migrate:up
+ALTER TABLE books ADD COLUMN goodreads_work_id VARCHAR(255);
+CREATE INDEX IF NOT EXISTS idx_books_goodreads_work_id ON books(goodreads_work_id);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_books_goodreads_work_id;
+ALTER TABLE books DROP COLUMN goodreads_work_id;
@@ -0,0 +1,5 @@
+-- migrate:up
+ALTER TABLE books ADD COLUMN description_markdown TEXT;
+
+-- migrate:down
+ALTER TABLE books DROP COLUMN description_markdown;
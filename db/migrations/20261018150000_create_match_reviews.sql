@@ -0,0 +1,18 @@
+-- migrate:up
+CREATE TABLE IF NOT EXISTS match_reviews (
+    id INTEGER PRIMARY KEY,
+    series_id INTEGER NOT NULL,
+    owned_book_id INTEGER NOT NULL,
+    candidate_book_id INTEGER NOT NULL,
+    candidate_title VARCHAR(255) NOT NULL,
+    candidate TEXT NOT NULL,
+    score REAL NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    UNIQUE (series_id, owned_book_id, candidate_book_id),
+    FOREIGN KEY (series_id) REFERENCES series(id) ON DELETE CASCADE,
+    FOREIGN KEY (owned_book_id) REFERENCES books(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_match_reviews_status ON match_reviews(status);
+
+-- migrate:down
+DROP TABLE match_reviews;
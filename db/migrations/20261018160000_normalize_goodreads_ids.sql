@@ -0,0 +1,28 @@
+-- migrate:up
+-- Goodreads URLs: keep the number after /book/show/
+UPDATE books
+SET goodreads_id = CAST(CAST(substr(goodreads_id, instr(goodreads_id, '/book/show/') + 11) AS INTEGER) AS TEXT)
+WHERE instr(goodreads_id, '/book/show/') > 0
+  AND substr(goodreads_id, instr(goodreads_id, '/book/show/') + 11) GLOB '[0-9]*';
+-- Slugs such as 7315139-die-twice or 7315139.Die_Twice: keep the leading number
+UPDATE books
+SET goodreads_id = CAST(CAST(goodreads_id AS INTEGER) AS TEXT)
+WHERE goodreads_id GLOB '[0-9]*'
+  AND goodreads_id != CAST(CAST(goodreads_id AS INTEGER) AS TEXT);
+-- Anything without a number is not a usable ID
+UPDATE books
+SET goodreads_id = NULL
+WHERE goodreads_id IS NOT NULL
+  AND (goodreads_id NOT GLOB '[0-9]*' OR goodreads_id = '0');
+-- Missing books created because an owned copy was stored under a slug are duplicates
+DELETE FROM books
+WHERE is_missing = 1
+  AND goodreads_id IS NOT NULL
+  AND EXISTS (
+    SELECT 1 FROM books owned
+    WHERE COALESCE(owned.is_missing, 0) = 0
+      AND owned.goodreads_id = books.goodreads_id
+  );
+
+-- migrate:down
+-- Normalized IDs can't be turned back into the slugs they came from
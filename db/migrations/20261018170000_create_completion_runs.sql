@@ -0,0 +1,27 @@
+-- migrate:up
+CREATE TABLE IF NOT EXISTS completion_runs (
+    id INTEGER PRIMARY KEY,
+    status VARCHAR(20) NOT NULL DEFAULT 'running',
+    provider VARCHAR(50) NOT NULL DEFAULT '',
+    stale_days INTEGER NOT NULL DEFAULT 0,
+    concurrency INTEGER NOT NULL DEFAULT 1,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finished_at DATETIME
+);
+CREATE TABLE IF NOT EXISTS completion_run_series (
+    run_id INTEGER NOT NULL,
+    series_id INTEGER NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    new_missing_books INTEGER NOT NULL DEFAULT 0,
+    review_books INTEGER NOT NULL DEFAULT 0,
+    error TEXT,
+    checked_at DATETIME,
+    PRIMARY KEY (run_id, series_id),
+    FOREIGN KEY (run_id) REFERENCES completion_runs(id) ON DELETE CASCADE,
+    FOREIGN KEY (series_id) REFERENCES series(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_completion_run_series_series_id ON completion_run_series(series_id);
+
+-- migrate:down
+DROP TABLE completion_run_series;
+DROP TABLE completion_runs;
@@ -0,0 +1,31 @@
+-- migrate:up
+ALTER TABLE series ADD COLUMN last_checked_at DATETIME;
+ALTER TABLE series ADD COLUMN last_check_status VARCHAR(20);
+ALTER TABLE series ADD COLUMN last_error TEXT;
+CREATE INDEX IF NOT EXISTS idx_series_last_checked_at ON series(last_checked_at);
+
+-- Series already completed by a bulk run start out with that run's result
+UPDATE series
+SET last_checked_at = (
+    SELECT MAX(c.checked_at) FROM completion_run_series c
+    WHERE c.series_id = series.id AND c.checked_at IS NOT NULL
+)
+WHERE series_id != 0;
+UPDATE series
+SET last_check_status = CASE (
+        SELECT c.status FROM completion_run_series c
+        WHERE c.series_id = series.id AND c.checked_at = series.last_checked_at
+        ORDER BY c.run_id DESC LIMIT 1
+    ) WHEN 'done' THEN 'success' ELSE 'failed' END,
+    last_error = (
+        SELECT c.error FROM completion_run_series c
+        WHERE c.series_id = series.id AND c.checked_at = series.last_checked_at
+        ORDER BY c.run_id DESC LIMIT 1
+    )
+WHERE last_checked_at IS NOT NULL;
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_series_last_checked_at;
+ALTER TABLE series DROP COLUMN last_error;
+ALTER TABLE series DROP COLUMN last_check_status;
+ALTER TABLE series DROP COLUMN last_checked_at;
@@ -0,0 +1,30 @@
+-- migrate:up
+ALTER TABLE series ADD COLUMN followed BOOLEAN NOT NULL DEFAULT 0;
+CREATE TABLE IF NOT EXISTS series_snapshots (
+    id INTEGER PRIMARY KEY,
+    series_id INTEGER NOT NULL,
+    provider VARCHAR(50) NOT NULL,
+    entries TEXT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (series_id) REFERENCES series(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_series_snapshots_series_id ON series_snapshots(series_id);
+CREATE TABLE IF NOT EXISTS series_changes (
+    id INTEGER PRIMARY KEY,
+    series_id INTEGER NOT NULL,
+    snapshot_id INTEGER NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    goodreads_id VARCHAR(255),
+    old_position VARCHAR(20),
+    new_position VARCHAR(20),
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (series_id) REFERENCES series(id) ON DELETE CASCADE,
+    FOREIGN KEY (snapshot_id) REFERENCES series_snapshots(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_series_changes_series_id ON series_changes(series_id);
+
+-- migrate:down
+DROP TABLE series_changes;
+DROP TABLE series_snapshots;
+ALTER TABLE series DROP COLUMN followed;
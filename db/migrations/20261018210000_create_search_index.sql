@@ -0,0 +1,132 @@
+-- migrate:up
+-- Rows are keyed by rowid = id * 4 + kind (1 book, 2 series, 3 author) so triggers can replace them without a scan
+CREATE VIRTUAL TABLE IF NOT EXISTS search_index USING fts5(
+    kind UNINDEXED,
+    ref_id UNINDEXED,
+    title,
+    description,
+    authors,
+    series,
+    tokenize = 'unicode61 remove_diacritics 2'
+);
+CREATE TRIGGER IF NOT EXISTS search_books_insert AFTER INSERT ON books
+BEGIN
+    INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+    SELECT b.id * 4 + 1, 'book', b.id, b.title, b.description,
+        (SELECT COALESCE(group_concat(a.name, ' '), '') FROM authors a JOIN book_authors ba ON ba.author_id = a.id WHERE ba.book_id = b.id),
+        COALESCE(b.series_name, '')
+    FROM books b WHERE b.id = new.id;
+END;
+CREATE TRIGGER IF NOT EXISTS search_books_update AFTER UPDATE ON books
+BEGIN
+    DELETE FROM search_index WHERE rowid = old.id * 4 + 1;
+    INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+    SELECT b.id * 4 + 1, 'book', b.id, b.title, b.description,
+        (SELECT COALESCE(group_concat(a.name, ' '), '') FROM authors a JOIN book_authors ba ON ba.author_id = a.id WHERE ba.book_id = b.id),
+        COALESCE(b.series_name, '')
+    FROM books b WHERE b.id = new.id;
+END;
+CREATE TRIGGER IF NOT EXISTS search_books_delete AFTER DELETE ON books
+BEGIN
+    DELETE FROM search_index WHERE rowid = old.id * 4 + 1;
+END;
+CREATE TRIGGER IF NOT EXISTS search_book_authors_insert AFTER INSERT ON book_authors
+BEGIN
+    DELETE FROM search_index WHERE rowid = new.book_id * 4 + 1;
+    INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+    SELECT b.id * 4 + 1, 'book', b.id, b.title, b.description,
+        (SELECT COALESCE(group_concat(a.name, ' '), '') FROM authors a JOIN book_authors ba ON ba.author_id = a.id WHERE ba.book_id = b.id),
+        COALESCE(b.series_name, '')
+    FROM books b WHERE b.id = new.book_id;
+END;
+CREATE TRIGGER IF NOT EXISTS search_book_authors_delete AFTER DELETE ON book_authors
+BEGIN
+    DELETE FROM search_index WHERE rowid = old.book_id * 4 + 1;
+    INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+    SELECT b.id * 4 + 1, 'book', b.id, b.title, b.description,
+        (SELECT COALESCE(group_concat(a.name, ' '), '') FROM authors a JOIN book_authors ba ON ba.author_id = a.id WHERE ba.book_id = b.id),
+        COALESCE(b.series_name, '')
+    FROM books b WHERE b.id = old.book_id;
+END;
+CREATE TRIGGER IF NOT EXISTS search_series_insert AFTER INSERT ON series
+BEGIN
+    INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+    SELECT s.id * 4 + 2, 'series', s.id, s.name, COALESCE(s.description, ''),
+        (SELECT COALESCE(group_concat(a.name, ' '), '') FROM authors a JOIN series_authors sa ON sa.author_id = a.id WHERE sa.series_id = s.id),
+        ''
+    FROM series s WHERE s.id = new.id;
+END;
+CREATE TRIGGER IF NOT EXISTS search_series_update AFTER UPDATE ON series
+BEGIN
+    DELETE FROM search_index WHERE rowid = old.id * 4 + 2;
+    INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+    SELECT s.id * 4 + 2, 'series', s.id, s.name, COALESCE(s.description, ''),
+        (SELECT COALESCE(group_concat(a.name, ' '), '') FROM authors a JOIN series_authors sa ON sa.author_id = a.id WHERE sa.series_id = s.id),
+        ''
+    FROM series s WHERE s.id = new.id;
+END;
+CREATE TRIGGER IF NOT EXISTS search_series_delete AFTER DELETE ON series
+BEGIN
+    DELETE FROM search_index WHERE rowid = old.id * 4 + 2;
+END;
+CREATE TRIGGER IF NOT EXISTS search_series_authors_insert AFTER INSERT ON series_authors
+BEGIN
+    DELETE FROM search_index WHERE rowid = new.series_id * 4 + 2;
+    INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+    SELECT s.id * 4 + 2, 'series', s.id, s.name, COALESCE(s.description, ''),
+        (SELECT COALESCE(group_concat(a.name, ' '), '') FROM authors a JOIN series_authors sa ON sa.author_id = a.id WHERE sa.series_id = s.id),
+        ''
+    FROM series s WHERE s.id = new.series_id;
+END;
+CREATE TRIGGER IF NOT EXISTS search_series_authors_delete AFTER DELETE ON series_authors
+BEGIN
+    DELETE FROM search_index WHERE rowid = old.series_id * 4 + 2;
+    INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+    SELECT s.id * 4 + 2, 'series', s.id, s.name, COALESCE(s.description, ''),
+        (SELECT COALESCE(group_concat(a.name, ' '), '') FROM authors a JOIN series_authors sa ON sa.author_id = a.id WHERE sa.series_id = s.id),
+        ''
+    FROM series s WHERE s.id = old.series_id;
+END;
+CREATE TRIGGER IF NOT EXISTS search_authors_insert AFTER INSERT ON authors
+BEGIN
+    INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+    SELECT a.id * 4 + 3, 'author', a.id, a.name, '', '', '' FROM authors a WHERE a.id = new.id;
+END;
+CREATE TRIGGER IF NOT EXISTS search_authors_update AFTER UPDATE OF name ON authors
+BEGIN
+    DELETE FROM search_index WHERE rowid = old.id * 4 + 3;
+    INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+    SELECT a.id * 4 + 3, 'author', a.id, a.name, '', '', '' FROM authors a WHERE a.id = new.id;
+END;
+CREATE TRIGGER IF NOT EXISTS search_authors_delete AFTER DELETE ON authors
+BEGIN
+    DELETE FROM search_index WHERE rowid = old.id * 4 + 3;
+END;
+INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+SELECT b.id * 4 + 1, 'book', b.id, b.title, b.description,
+    (SELECT COALESCE(group_concat(a.name, ' '), '') FROM authors a JOIN book_authors ba ON ba.author_id = a.id WHERE ba.book_id = b.id),
+    COALESCE(b.series_name, '')
+FROM books b;
+INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+SELECT s.id * 4 + 2, 'series', s.id, s.name, COALESCE(s.description, ''),
+    (SELECT COALESCE(group_concat(a.name, ' '), '') FROM authors a JOIN series_authors sa ON sa.author_id = a.id WHERE sa.series_id = s.id),
+    ''
+FROM series s;
+INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+SELECT a.id * 4 + 3, 'author', a.id, a.name, '', '', '' FROM authors a;
+
+-- migrate:down
+DROP TRIGGER IF EXISTS search_books_insert;
+DROP TRIGGER IF EXISTS search_books_update;
+DROP TRIGGER IF EXISTS search_books_delete;
+DROP TRIGGER IF EXISTS search_book_authors_insert;
+DROP TRIGGER IF EXISTS search_book_authors_delete;
+DROP TRIGGER IF EXISTS search_series_insert;
+DROP TRIGGER IF EXISTS search_series_update;
+DROP TRIGGER IF EXISTS search_series_delete;
+DROP TRIGGER IF EXISTS search_series_authors_insert;
+DROP TRIGGER IF EXISTS search_series_authors_delete;
+DROP TRIGGER IF EXISTS search_authors_insert;
+DROP TRIGGER IF EXISTS search_authors_update;
+DROP TRIGGER IF EXISTS search_authors_delete;
+DROP TABLE IF EXISTS search_index;
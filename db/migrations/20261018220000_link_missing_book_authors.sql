@@ -0,0 +1,11 @@
+-- migrate:up
+-- Missing books used to be stored without authors; credit them to the authors of their series
+INSERT OR IGNORE INTO book_authors (book_id, author_id)
+SELECT b.id, sa.author_id
+FROM books b
+JOIN series_authors sa ON sa.series_id = b.series_id
+WHERE b.is_missing = 1
+  AND NOT EXISTS (SELECT 1 FROM book_authors ba WHERE ba.book_id = b.id);
+
+-- migrate:down
+-- Links added here can't be told apart from ones made since
@@ -0,0 +1,17 @@
+-- migrate:up
+CREATE TABLE IF NOT EXISTS library_snapshots (
+    id INTEGER PRIMARY KEY,
+    taken_on VARCHAR(10) NOT NULL UNIQUE,
+    total_books INTEGER NOT NULL,
+    owned_books INTEGER NOT NULL,
+    missing_books INTEGER NOT NULL,
+    unreleased_books INTEGER NOT NULL,
+    total_series INTEGER NOT NULL,
+    complete_series INTEGER NOT NULL,
+    incomplete_series INTEGER NOT NULL,
+    books_without_identifiers INTEGER NOT NULL,
+    taken_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- migrate:down
+DROP TABLE library_snapshots;
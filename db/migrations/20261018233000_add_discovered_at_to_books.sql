@@ -0,0 +1,9 @@
+-- migrate:up
+ALTER TABLE books ADD COLUMN discovered_at DATETIME;
+-- Missing books found before this column existed count as discovered now
+UPDATE books SET discovered_at = CURRENT_TIMESTAMP WHERE is_missing = 1;
+CREATE INDEX IF NOT EXISTS idx_books_discovered_at ON books(discovered_at);
+
+-- migrate:down
+DROP INDEX idx_books_discovered_at;
+ALTER TABLE books DROP COLUMN discovered_at;
@@ -0,0 +1,10 @@
+-- migrate:up
+CREATE TABLE IF NOT EXISTS book_lookups (
+    book_id INTEGER PRIMARY KEY,
+    goodreads_checked_at DATETIME,
+    enrich_checked_at DATETIME,
+    FOREIGN KEY (book_id) REFERENCES books(id) ON DELETE CASCADE
+);
+
+-- migrate:down
+DROP TABLE book_lookups;
@@ -0,0 +1,5 @@
+-- migrate:up
+ALTER TABLE book_lookups ADD COLUMN work_id_checked_at DATETIME;
+
+-- migrate:down
+ALTER TABLE book_lookups DROP COLUMN work_id_checked_at;
@@ -8,11 +8,33 @@ WHERE book_id = ? LIMIT 1;
 
 -- name: ListBooks :many
 SELECT * FROM books
-ORDER BY title ASC
-LIMIT ? OFFSET ?;
+WHERE (sqlc.narg(missing) IS NULL OR COALESCE(is_missing, 0) = sqlc.narg(missing))
+  AND (sqlc.narg(series_id) IS NULL OR series_id = sqlc.narg(series_id))
+  AND (sqlc.narg(author_id) IS NULL OR id IN (SELECT ba.book_id FROM book_authors ba WHERE ba.author_id = sqlc.narg(author_id)))
+  AND (sqlc.narg(language) IS NULL OR language = sqlc.narg(language))
+  AND (sqlc.narg(has_goodreads_id) IS NULL OR (COALESCE(goodreads_id, '') != '') = sqlc.narg(has_goodreads_id))
+  AND (sqlc.narg(title) IS NULL OR title LIKE '%' || sqlc.narg(title) || '%')
+ORDER BY
+    CASE WHEN sqlc.arg(sort) = 'title' THEN title COLLATE NOCASE END ASC,
+    CASE WHEN sqlc.arg(sort) = 'title_desc' THEN title COLLATE NOCASE END DESC,
+    CASE WHEN sqlc.arg(sort) = 'series' THEN series_name COLLATE NOCASE END ASC,
+    CASE WHEN sqlc.arg(sort) = 'series' THEN series_number END ASC,
+    CASE WHEN sqlc.arg(sort) = 'series_desc' THEN series_name COLLATE NOCASE END DESC,
+    CASE WHEN sqlc.arg(sort) = 'series_desc' THEN series_number END DESC,
+    CASE WHEN sqlc.arg(sort) = 'published' THEN publication_date END ASC,
+    CASE WHEN sqlc.arg(sort) = 'published_desc' THEN publication_date END DESC,
+    CASE WHEN sqlc.arg(sort) = 'added_desc' THEN id END DESC,
+    id ASC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
 
 -- name: CountBooks :one
-SELECT COUNT(*) AS count FROM books;
+SELECT COUNT(*) AS count FROM books
+WHERE (sqlc.narg(missing) IS NULL OR COALESCE(is_missing, 0) = sqlc.narg(missing))
+  AND (sqlc.narg(series_id) IS NULL OR series_id = sqlc.narg(series_id))
+  AND (sqlc.narg(author_id) IS NULL OR id IN (SELECT ba.book_id FROM book_authors ba WHERE ba.author_id = sqlc.narg(author_id)))
+  AND (sqlc.narg(language) IS NULL OR language = sqlc.narg(language))
+  AND (sqlc.narg(has_goodreads_id) IS NULL OR (COALESCE(goodreads_id, '') != '') = sqlc.narg(has_goodreads_id))
+  AND (sqlc.narg(title) IS NULL OR title LIKE '%' || sqlc.narg(title) || '%');
 
 -- name: CreateBook :one
 INSERT INTO books (book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, is_missing)
@@ -20,11 +42,12 @@ VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: UpsertBook :one
-INSERT INTO books (book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, is_missing)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO books (book_id, title, description, description_markdown, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, is_missing)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(book_id) DO UPDATE SET
     title = excluded.title,
     description = excluded.description,
+    description_markdown = excluded.description_markdown,
     series_name = excluded.series_name,
     series_number = excluded.series_number,
     asin = excluded.asin,
@@ -48,12 +71,31 @@ SELECT * FROM series
 WHERE series_id = ? LIMIT 1;
 
 -- name: ListSeries :many
-SELECT * FROM series
-ORDER BY id ASC
-LIMIT ? OFFSET ?;
+SELECT * FROM series s
+WHERE (sqlc.narg(incomplete) IS NULL OR EXISTS (SELECT 1 FROM books mb WHERE mb.series_id = s.id AND mb.is_missing = 1) = sqlc.narg(incomplete))
+  AND (sqlc.narg(author_id) IS NULL OR s.id IN (SELECT sa.series_id FROM series_authors sa WHERE sa.author_id = sqlc.narg(author_id)))
+  AND (sqlc.narg(unmapped) IS NULL OR (s.series_id = 0) = sqlc.narg(unmapped))
+  AND (sqlc.narg(name) IS NULL OR s.name LIKE '%' || sqlc.narg(name) || '%')
+ORDER BY
+    CASE WHEN sqlc.arg(sort) = 'name' THEN s.name COLLATE NOCASE END ASC,
+    CASE WHEN sqlc.arg(sort) = 'name_desc' THEN s.name COLLATE NOCASE END DESC,
+    CASE WHEN sqlc.arg(sort) = 'total' THEN (SELECT COUNT(*) FROM books b WHERE b.series_id = s.id) END ASC,
+    CASE WHEN sqlc.arg(sort) = 'total_desc' THEN (SELECT COUNT(*) FROM books b WHERE b.series_id = s.id) END DESC,
+    CASE WHEN sqlc.arg(sort) = 'missing' THEN (SELECT COUNT(*) FROM books b WHERE b.series_id = s.id AND b.is_missing = 1) END ASC,
+    CASE WHEN sqlc.arg(sort) = 'missing_desc' THEN (SELECT COUNT(*) FROM books b WHERE b.series_id = s.id AND b.is_missing = 1) END DESC,
+    CASE WHEN sqlc.arg(sort) = 'completion' THEN CASE WHEN (SELECT COUNT(*) FROM books b WHERE b.series_id = s.id) = 0 THEN 0.0 ELSE CAST((SELECT COUNT(*) FROM books b WHERE b.series_id = s.id) - (SELECT COUNT(*) FROM books b WHERE b.series_id = s.id AND b.is_missing = 1) AS REAL) / (SELECT COUNT(*) FROM books b WHERE b.series_id = s.id) END END ASC,
+    CASE WHEN sqlc.arg(sort) = 'completion_desc' THEN CASE WHEN (SELECT COUNT(*) FROM books b WHERE b.series_id = s.id) = 0 THEN 0.0 ELSE CAST((SELECT COUNT(*) FROM books b WHERE b.series_id = s.id) - (SELECT COUNT(*) FROM books b WHERE b.series_id = s.id AND b.is_missing = 1) AS REAL) / (SELECT COUNT(*) FROM books b WHERE b.series_id = s.id) END END DESC,
+    CASE WHEN sqlc.arg(sort) = 'checked' THEN s.last_checked_at END ASC,
+    CASE WHEN sqlc.arg(sort) = 'checked_desc' THEN s.last_checked_at END DESC,
+    s.id ASC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
 
 -- name: CountSeries :one
-SELECT COUNT(*) AS count FROM series;
+SELECT COUNT(*) AS count FROM series s
+WHERE (sqlc.narg(incomplete) IS NULL OR EXISTS (SELECT 1 FROM books mb WHERE mb.series_id = s.id AND mb.is_missing = 1) = sqlc.narg(incomplete))
+  AND (sqlc.narg(author_id) IS NULL OR s.id IN (SELECT sa.series_id FROM series_authors sa WHERE sa.author_id = sqlc.narg(author_id)))
+  AND (sqlc.narg(unmapped) IS NULL OR (s.series_id = 0) = sqlc.narg(unmapped))
+  AND (sqlc.narg(name) IS NULL OR s.name LIKE '%' || sqlc.narg(name) || '%');
 
 -- name: CreateSeries :one
 INSERT INTO series (series_id, name, description, url, data)
@@ -80,13 +122,23 @@ RETURNING *;
 SELECT * FROM authors
 WHERE name = ? LIMIT 1;
 
+-- name: SetAuthorGoodreadsID :exec
+UPDATE authors
+SET goodreads_id = ?
+WHERE name = ?;
+
+-- name: ListAuthorsWithGoodreadsID :many
+SELECT * FROM authors
+WHERE goodreads_id IS NOT NULL AND goodreads_id != ''
+ORDER BY name ASC;
+
 -- name: LinkBookAuthor :exec
 INSERT INTO book_authors (book_id, author_id)
 VALUES (?, ?)
 ON CONFLICT (book_id, author_id) DO NOTHING;
 
 -- name: GetAuthorsForBook :many
-SELECT a.id, a.name FROM authors a
+SELECT a.id, a.name, a.goodreads_id FROM authors a
 JOIN book_authors ba ON a.id = ba.author_id
 WHERE ba.book_id = ?
 ORDER BY a.name ASC;
@@ -97,7 +149,7 @@ WHERE series_id = ?
 ORDER BY series_number ASC;
 
 -- name: GetSeriesAuthors :many
-SELECT a.id, a.name FROM authors a
+SELECT a.id, a.name, a.goodreads_id FROM authors a
 JOIN series_authors sa ON a.id = sa.author_id
 WHERE sa.series_id = ?
 ORDER BY a.name ASC;
@@ -119,16 +171,29 @@ SET series_id = ?
 WHERE id = ?;
 
 -- name: CreateMissingBook :one
-INSERT INTO books (book_id, title, description, series_name, series_number, goodreads_id, series_id, is_missing)
-VALUES (?, ?, ?, ?, ?, ?, ?, 1)
+INSERT INTO books (book_id, title, description, description_markdown, series_name, series_number, asin, isbn10, isbn13, hardcover_id, hardcover_book_id, goodreads_id, google_id, series_id, publication_date, to_be_published, metadata_source, cover_url, goodreads_work_id, is_missing, discovered_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 1, CURRENT_TIMESTAMP)
 ON CONFLICT(book_id) DO UPDATE SET
     title = excluded.title,
     description = excluded.description,
+    description_markdown = excluded.description_markdown,
     series_name = excluded.series_name,
     series_number = excluded.series_number,
+    asin = excluded.asin,
+    isbn10 = excluded.isbn10,
+    isbn13 = excluded.isbn13,
+    hardcover_id = excluded.hardcover_id,
+    hardcover_book_id = excluded.hardcover_book_id,
     goodreads_id = excluded.goodreads_id,
+    google_id = excluded.google_id,
     series_id = excluded.series_id,
-    is_missing = 1
+    publication_date = excluded.publication_date,
+    to_be_published = excluded.to_be_published,
+    metadata_source = excluded.metadata_source,
+    cover_url = excluded.cover_url,
+    goodreads_work_id = COALESCE(excluded.goodreads_work_id, goodreads_work_id),
+    is_missing = 1,
+    discovered_at = COALESCE(discovered_at, excluded.discovered_at)
 RETURNING *;
 
 -- name: GetSeriesByGoodreadsID :one
@@ -156,10 +221,535 @@ SELECT
     s.description,
     s.url,
     s.data,
+    s.last_checked_at,
+    s.last_check_status,
+    s.last_error,
+    s.followed,
     COUNT(b.id) as total_books,
     COUNT(CASE WHEN b.is_missing = 1 THEN 1 END) as missing_books
 FROM series s
 LEFT JOIN books b ON s.id = b.series_id
-GROUP BY s.id, s.series_id, s.name, s.description, s.url, s.data
-ORDER BY s.id ASC
-LIMIT ? OFFSET ?;
\ No newline at end of file
+WHERE (sqlc.narg(incomplete) IS NULL OR EXISTS (SELECT 1 FROM books mb WHERE mb.series_id = s.id AND mb.is_missing = 1) = sqlc.narg(incomplete))
+  AND (sqlc.narg(author_id) IS NULL OR s.id IN (SELECT sa.series_id FROM series_authors sa WHERE sa.author_id = sqlc.narg(author_id)))
+  AND (sqlc.narg(unmapped) IS NULL OR (s.series_id = 0) = sqlc.narg(unmapped))
+  AND (sqlc.narg(name) IS NULL OR s.name LIKE '%' || sqlc.narg(name) || '%')
+GROUP BY s.id, s.series_id, s.name, s.description, s.url, s.data, s.last_checked_at, s.last_check_status, s.last_error, s.followed
+ORDER BY
+    CASE WHEN sqlc.arg(sort) = 'name' THEN s.name COLLATE NOCASE END ASC,
+    CASE WHEN sqlc.arg(sort) = 'name_desc' THEN s.name COLLATE NOCASE END DESC,
+    CASE WHEN sqlc.arg(sort) = 'total' THEN COUNT(b.id) END ASC,
+    CASE WHEN sqlc.arg(sort) = 'total_desc' THEN COUNT(b.id) END DESC,
+    CASE WHEN sqlc.arg(sort) = 'missing' THEN COUNT(CASE WHEN b.is_missing = 1 THEN 1 END) END ASC,
+    CASE WHEN sqlc.arg(sort) = 'missing_desc' THEN COUNT(CASE WHEN b.is_missing = 1 THEN 1 END) END DESC,
+    CASE WHEN sqlc.arg(sort) = 'completion' THEN CASE WHEN COUNT(b.id) = 0 THEN 0.0 ELSE CAST(COUNT(b.id) - COUNT(CASE WHEN b.is_missing = 1 THEN 1 END) AS REAL) / COUNT(b.id) END END ASC,
+    CASE WHEN sqlc.arg(sort) = 'completion_desc' THEN CASE WHEN COUNT(b.id) = 0 THEN 0.0 ELSE CAST(COUNT(b.id) - COUNT(CASE WHEN b.is_missing = 1 THEN 1 END) AS REAL) / COUNT(b.id) END END DESC,
+    CASE WHEN sqlc.arg(sort) = 'checked' THEN s.last_checked_at END ASC,
+    CASE WHEN sqlc.arg(sort) = 'checked_desc' THEN s.last_checked_at END DESC,
+    s.id ASC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: SetSeriesCheckResult :exec
+UPDATE series
+SET last_checked_at = CURRENT_TIMESTAMP, last_check_status = ?, last_error = ?
+WHERE id = ?;
+
+-- name: ListSeriesIdentifiers :many
+SELECT id, series_id, name FROM series
+ORDER BY id ASC;
+
+-- name: ListUpcomingBooks :many
+SELECT b.* FROM books b
+JOIN series s ON s.id = b.series_id
+WHERE s.followed = 1
+  AND (b.to_be_published = 1 OR (b.publication_date != '' AND b.publication_date >= substr(date('now'), 1, length(b.publication_date))))
+ORDER BY b.publication_date IS NULL, b.publication_date ASC, b.title ASC;
+
+-- name: ListBooksWithoutGoodreadsID :many
+SELECT b.* FROM books b
+LEFT JOIN book_lookups l ON l.book_id = b.id
+WHERE (b.goodreads_id IS NULL OR b.goodreads_id = '')
+  AND (COALESCE(b.isbn13, '') != '' OR COALESCE(b.isbn10, '') != '')
+  AND (l.goodreads_checked_at IS NULL OR l.goodreads_checked_at < datetime('now', '-' || sqlc.arg(retry_days) || ' days'))
+ORDER BY l.goodreads_checked_at IS NOT NULL, l.goodreads_checked_at ASC, b.id ASC
+LIMIT sqlc.arg(limit);
+
+-- name: MarkGoodreadsIDLookup :exec
+INSERT INTO book_lookups (book_id, goodreads_checked_at)
+VALUES (?, CURRENT_TIMESTAMP)
+ON CONFLICT(book_id) DO UPDATE SET goodreads_checked_at = excluded.goodreads_checked_at;
+
+-- name: SetBookGoodreadsID :exec
+UPDATE books
+SET goodreads_id = ?
+WHERE id = ?;
+
+-- name: ListRecentWorkIDLookups :many
+SELECT l.book_id FROM book_lookups l
+JOIN books b ON b.id = l.book_id
+WHERE b.series_id = sqlc.arg(series_id)
+  AND l.work_id_checked_at >= datetime('now', '-' || sqlc.arg(retry_days) || ' days');
+
+-- name: MarkWorkIDLookup :exec
+INSERT INTO book_lookups (book_id, work_id_checked_at)
+VALUES (?, CURRENT_TIMESTAMP)
+ON CONFLICT(book_id) DO UPDATE SET work_id_checked_at = excluded.work_id_checked_at;
+
+-- name: ListMissingBooksToEnrich :many
+SELECT b.* FROM books b
+LEFT JOIN book_lookups l ON l.book_id = b.id
+WHERE b.is_missing = 1
+  AND (COALESCE(b.isbn13, '') != '' OR COALESCE(b.isbn10, '') != '')
+  AND (b.cover_url IS NULL OR b.description = '' OR b.publication_date IS NULL)
+  AND (l.enrich_checked_at IS NULL OR l.enrich_checked_at < datetime('now', '-' || sqlc.arg(retry_days) || ' days'))
+ORDER BY l.enrich_checked_at IS NOT NULL, l.enrich_checked_at ASC, b.id ASC
+LIMIT sqlc.arg(limit);
+
+-- name: MarkEnrichLookup :exec
+INSERT INTO book_lookups (book_id, enrich_checked_at)
+VALUES (?, CURRENT_TIMESTAMP)
+ON CONFLICT(book_id) DO UPDATE SET enrich_checked_at = excluded.enrich_checked_at;
+
+-- name: EnrichBook :exec
+UPDATE books
+SET cover_url = COALESCE(cover_url, sqlc.narg('cover_url')),
+    description = CASE WHEN description = '' THEN sqlc.arg('description') ELSE description END,
+    description_markdown = CASE WHEN description = '' THEN sqlc.narg('description_markdown') ELSE description_markdown END,
+    publication_date = COALESCE(publication_date, sqlc.narg('publication_date')),
+    goodreads_id = COALESCE(NULLIF(goodreads_id, ''), sqlc.narg('goodreads_id'))
+WHERE id = sqlc.arg('id');
+
+-- name: SetBookGoodreadsWorkID :exec
+UPDATE books
+SET goodreads_work_id = ?
+WHERE id = ?;
+
+-- name: UpsertMatchReview :one
+INSERT INTO match_reviews (series_id, owned_book_id, candidate_book_id, candidate_title, candidate, score)
+VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT(series_id, owned_book_id, candidate_book_id) DO UPDATE SET
+    candidate_title = excluded.candidate_title,
+    candidate = excluded.candidate,
+    score = excluded.score
+RETURNING *;
+
+-- name: ListMatchReviewsForSeries :many
+SELECT * FROM match_reviews
+WHERE series_id = ?
+ORDER BY id ASC;
+
+-- name: ListMatchReviews :many
+SELECT r.id, r.series_id, r.owned_book_id, r.candidate_book_id, r.candidate_title, r.candidate, r.score, r.status,
+    b.title AS owned_title,
+    s.name AS series_name
+FROM match_reviews r
+JOIN books b ON b.id = r.owned_book_id
+JOIN series s ON s.id = r.series_id
+WHERE r.status = ?
+ORDER BY r.id ASC;
+
+-- name: GetMatchReview :one
+SELECT * FROM match_reviews
+WHERE id = ? LIMIT 1;
+
+-- name: SetMatchReviewStatus :exec
+UPDATE match_reviews
+SET status = ?
+WHERE id = ?;
+
+-- name: DecideMatchReview :execrows
+UPDATE match_reviews
+SET status = ?
+WHERE id = ? AND status = 'pending';
+
+-- name: CreateCompletionRun :one
+INSERT INTO completion_runs (provider, stale_days, concurrency)
+VALUES (?, ?, ?)
+RETURNING *;
+
+-- name: AddCompletionRunSeries :execrows
+INSERT INTO completion_run_series (run_id, series_id)
+SELECT sqlc.arg(run_id), s.id FROM series s
+WHERE s.series_id != 0
+  AND (s.last_checked_at IS NULL OR s.last_checked_at <= datetime('now', '-' || sqlc.arg(stale_days) || ' days'));
+
+-- name: CountStaleSeries :one
+SELECT COUNT(*) AS count FROM series
+WHERE series_id != 0
+  AND (last_checked_at IS NULL OR last_checked_at <= datetime('now', '-' || sqlc.arg(stale_days) || ' days'));
+
+-- name: GetCompletionRun :one
+SELECT * FROM completion_runs
+WHERE id = ? LIMIT 1;
+
+-- name: ListCompletionRuns :many
+SELECT r.id, r.status, r.provider, r.stale_days, r.concurrency, r.created_at, r.finished_at,
+    (SELECT COUNT(*) FROM completion_run_series c WHERE c.run_id = r.id) AS total,
+    (SELECT COUNT(*) FROM completion_run_series c WHERE c.run_id = r.id AND c.status = 'done') AS done,
+    (SELECT COUNT(*) FROM completion_run_series c WHERE c.run_id = r.id AND c.status = 'failed') AS failed
+FROM completion_runs r
+ORDER BY r.id DESC
+LIMIT ?;
+
+-- name: ListCompletionRunSeries :many
+SELECT c.run_id, c.series_id, c.status, c.new_missing_books, c.review_books, c.error, c.checked_at,
+    s.name AS series_name
+FROM completion_run_series c
+JOIN series s ON s.id = c.series_id
+WHERE c.run_id = ?
+ORDER BY s.name ASC;
+
+-- name: ListPendingCompletionRunSeries :many
+SELECT series_id FROM completion_run_series
+WHERE run_id = ? AND status = 'pending'
+ORDER BY series_id ASC;
+
+-- name: FinishCompletionRunSeries :exec
+UPDATE completion_run_series
+SET status = ?, new_missing_books = ?, review_books = ?, error = ?, checked_at = CURRENT_TIMESTAMP
+WHERE run_id = ? AND series_id = ?;
+
+-- name: ResumeCompletionRun :exec
+UPDATE completion_runs
+SET status = 'running', finished_at = NULL
+WHERE id = ?;
+
+-- name: FinishCompletionRun :exec
+UPDATE completion_runs
+SET status = ?, finished_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: InterruptCompletionRuns :exec
+UPDATE completion_runs
+SET status = 'interrupted', finished_at = CURRENT_TIMESTAMP
+WHERE status = 'running';
+
+-- name: SetSeriesFollowed :execrows
+UPDATE series
+SET followed = ?
+WHERE id = ?;
+
+-- name: GetLatestSeriesSnapshot :one
+SELECT * FROM series_snapshots
+WHERE series_id = ? AND provider = ?
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: CreateSeriesSnapshot :one
+INSERT INTO series_snapshots (series_id, provider, entries)
+VALUES (?, ?, ?)
+RETURNING *;
+
+-- name: CreateSeriesChange :exec
+INSERT INTO series_changes (series_id, snapshot_id, kind, title, goodreads_id, old_position, new_position)
+VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListSeriesChanges :many
+SELECT * FROM series_changes
+WHERE series_id = ?
+ORDER BY id DESC
+LIMIT ?;
+
+-- name: EnqueueJob :one
+INSERT INTO jobs (kind, payload, max_attempts, run_at)
+VALUES (?, ?, ?, datetime('now', '+' || sqlc.arg(delay_seconds) || ' seconds'))
+RETURNING *;
+
+-- name: ClaimJob :one
+UPDATE jobs
+SET status = 'running',
+    attempts = attempts + 1,
+    lease_expires_at = datetime('now', '+' || sqlc.arg(lease_seconds) || ' seconds')
+WHERE id = (
+    SELECT id FROM jobs
+    WHERE (status = 'queued' AND run_at <= CURRENT_TIMESTAMP)
+       OR (status = 'running' AND lease_expires_at <= CURRENT_TIMESTAMP)
+    ORDER BY run_at ASC, id ASC
+    LIMIT 1
+)
+RETURNING *;
+
+-- name: ExtendJobLease :execrows
+UPDATE jobs
+SET lease_expires_at = datetime('now', '+' || sqlc.arg(lease_seconds) || ' seconds')
+WHERE id = sqlc.arg(id) AND status = 'running' AND attempts = sqlc.arg(attempts);
+
+-- name: CompleteJob :execrows
+UPDATE jobs
+SET status = 'succeeded', lease_expires_at = NULL, last_error = NULL, finished_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg(id) AND status = 'running' AND attempts = sqlc.arg(attempts);
+
+-- name: RetryJob :execrows
+UPDATE jobs
+SET status = 'queued',
+    lease_expires_at = NULL,
+    last_error = sqlc.arg(last_error),
+    run_at = datetime('now', '+' || sqlc.arg(delay_seconds) || ' seconds')
+WHERE id = sqlc.arg(id) AND status = 'running' AND attempts = sqlc.arg(attempts);
+
+-- name: ReleaseJob :execrows
+UPDATE jobs
+SET status = 'queued', attempts = attempts - 1, lease_expires_at = NULL, run_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg(id) AND status = 'running' AND attempts = sqlc.arg(attempts);
+
+-- name: DeadLetterJob :execrows
+UPDATE jobs
+SET status = 'dead', lease_expires_at = NULL, last_error = sqlc.arg(last_error), finished_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg(id) AND status = 'running' AND attempts = sqlc.arg(attempts);
+
+-- name: RequeueJob :execrows
+UPDATE jobs
+SET status = 'queued', attempts = 0, run_at = CURRENT_TIMESTAMP, lease_expires_at = NULL, finished_at = NULL
+WHERE id = ? AND status = 'dead';
+
+-- name: GetJob :one
+SELECT * FROM jobs
+WHERE id = ? LIMIT 1;
+
+-- name: ListJobs :many
+SELECT * FROM jobs
+WHERE status = COALESCE(sqlc.narg(status), status)
+  AND kind = COALESCE(sqlc.narg(kind), kind)
+ORDER BY id DESC
+LIMIT sqlc.arg(limit);
+
+-- name: CountJobsByStatus :many
+SELECT status, COUNT(*) AS count FROM jobs
+GROUP BY status
+ORDER BY status ASC;
+
+-- name: SearchLibrary :many
+SELECT CAST(kind AS TEXT) AS kind,
+    CAST(ref_id AS INTEGER) AS ref_id,
+    CAST(title AS TEXT) AS title,
+    CAST(highlight(search_index, 2, char(2), char(3)) AS TEXT) AS highlighted_title,
+    CAST(snippet(search_index, -1, char(2), char(3), '…', 16) AS TEXT) AS snippet,
+    CAST(bm25(search_index, 0.0, 0.0, 10.0, 1.0, 5.0, 3.0) AS REAL) AS rank
+FROM search_index
+WHERE search_index MATCH sqlc.arg(query)
+  AND kind = COALESCE(sqlc.narg(kind), kind)
+ORDER BY rank
+LIMIT sqlc.arg(limit);
+
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = ? LIMIT 1;
+
+-- name: CountAuthors :one
+SELECT COUNT(*) AS count FROM authors;
+
+-- name: ListAuthorsWithBookStats :many
+SELECT
+    a.id,
+    a.name,
+    a.goodreads_id,
+    (SELECT COUNT(*) FROM series_authors sa WHERE sa.author_id = a.id) AS series_count,
+    COUNT(CASE WHEN b.id IS NOT NULL AND COALESCE(b.is_missing, 0) = 0 THEN 1 END) AS owned_books,
+    COUNT(CASE WHEN b.is_missing = 1 THEN 1 END) AS missing_books
+FROM authors a
+LEFT JOIN book_authors ba ON ba.author_id = a.id
+LEFT JOIN books b ON b.id = ba.book_id
+GROUP BY a.id, a.name, a.goodreads_id
+ORDER BY a.name COLLATE NOCASE ASC
+LIMIT ? OFFSET ?;
+
+-- name: GetSeriesForAuthor :many
+SELECT
+    s.id,
+    s.series_id,
+    s.name,
+    COUNT(b.id) AS total_books,
+    COUNT(CASE WHEN b.is_missing = 1 THEN 1 END) AS missing_books
+FROM series s
+JOIN series_authors sa ON sa.series_id = s.id
+LEFT JOIN books b ON b.series_id = s.id
+WHERE sa.author_id = ?
+GROUP BY s.id, s.series_id, s.name
+ORDER BY s.name COLLATE NOCASE ASC;
+
+-- name: GetBooksByAuthor :many
+SELECT b.* FROM books b
+JOIN book_authors ba ON ba.book_id = b.id
+WHERE ba.author_id = ?
+ORDER BY b.series_name COLLATE NOCASE ASC, b.series_number ASC, b.title COLLATE NOCASE ASC;
+
+-- name: GetLibraryStats :one
+SELECT
+    (SELECT COUNT(*) FROM books) AS total_books,
+    (SELECT COUNT(*) FROM books WHERE COALESCE(is_missing, 0) = 0) AS owned_books,
+    (SELECT COUNT(*) FROM books WHERE is_missing = 1) AS missing_books,
+    (SELECT COUNT(*) FROM books WHERE is_missing = 1 AND to_be_published = 1) AS unreleased_books,
+    (SELECT COUNT(*) FROM series) AS total_series,
+    (SELECT COUNT(*) FROM series s WHERE EXISTS (SELECT 1 FROM books b WHERE b.series_id = s.id)
+        AND NOT EXISTS (SELECT 1 FROM books b WHERE b.series_id = s.id AND b.is_missing = 1)) AS complete_series,
+    (SELECT COUNT(*) FROM series s WHERE EXISTS (SELECT 1 FROM books b WHERE b.series_id = s.id AND b.is_missing = 1)) AS incomplete_series,
+    (SELECT COUNT(*) FROM series s WHERE NOT EXISTS (SELECT 1 FROM books b WHERE b.series_id = s.id)) AS empty_series,
+    (SELECT COUNT(*) FROM series WHERE followed = 1) AS followed_series,
+    (SELECT COUNT(*) FROM series WHERE series_id = 0) AS unmapped_series,
+    (SELECT COUNT(*) FROM books WHERE COALESCE(is_missing, 0) = 0 AND COALESCE(goodreads_id, '') = '') AS books_without_goodreads_id,
+    (SELECT COUNT(*) FROM books WHERE COALESCE(is_missing, 0) = 0 AND COALESCE(isbn10, '') = '' AND COALESCE(isbn13, '') = '') AS books_without_isbn,
+    (SELECT COUNT(*) FROM books WHERE COALESCE(is_missing, 0) = 0 AND COALESCE(goodreads_id, '') = '' AND COALESCE(isbn10, '') = ''
+        AND COALESCE(isbn13, '') = '' AND COALESCE(asin, '') = '' AND COALESCE(hardcover_id, '') = '' AND COALESCE(google_id, '') = '') AS books_without_identifiers;
+
+-- name: GetSeriesCompletionDistribution :many
+SELECT bucket, COUNT(*) AS series_count FROM (
+    SELECT
+        CASE
+            WHEN missing = 0 THEN '100'
+            WHEN (total - missing) * 4 < total THEN '0-24'
+            WHEN (total - missing) * 2 < total THEN '25-49'
+            WHEN (total - missing) * 4 < total * 3 THEN '50-74'
+            ELSE '75-99'
+        END AS bucket
+    FROM (
+        SELECT COUNT(*) AS total, COUNT(CASE WHEN is_missing = 1 THEN 1 END) AS missing
+        FROM books
+        WHERE series_id IS NOT NULL
+        GROUP BY series_id
+    )
+)
+GROUP BY bucket;
+
+-- name: ListTopAuthorsByMissingBooks :many
+SELECT a.id, a.name, COUNT(*) AS missing_books
+FROM authors a
+JOIN book_authors ba ON ba.author_id = a.id
+JOIN books b ON b.id = ba.book_id
+WHERE b.is_missing = 1
+GROUP BY a.id, a.name
+ORDER BY missing_books DESC, a.name COLLATE NOCASE ASC
+LIMIT ?;
+
+-- name: UpsertLibrarySnapshot :one
+INSERT INTO library_snapshots (taken_on, total_books, owned_books, missing_books, unreleased_books, total_series, complete_series, incomplete_series, books_without_identifiers)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(taken_on) DO UPDATE SET
+    total_books = excluded.total_books,
+    owned_books = excluded.owned_books,
+    missing_books = excluded.missing_books,
+    unreleased_books = excluded.unreleased_books,
+    total_series = excluded.total_series,
+    complete_series = excluded.complete_series,
+    incomplete_series = excluded.incomplete_series,
+    books_without_identifiers = excluded.books_without_identifiers,
+    taken_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: ListLibrarySnapshots :many
+SELECT * FROM library_snapshots
+WHERE taken_on >= ?
+ORDER BY taken_on ASC;
+
+-- name: ListMissingBooksForExport :many
+SELECT
+    b.id,
+    b.title,
+    CAST(COALESCE(s.name, b.series_name, '') AS TEXT) AS series_name,
+    b.series_number,
+    CAST(COALESCE((
+        SELECT group_concat(a.name, ', ' ORDER BY a.name COLLATE NOCASE)
+        FROM authors a
+        JOIN book_authors ba ON ba.author_id = a.id
+        WHERE ba.book_id = b.id
+    ), '') AS TEXT) AS authors,
+    b.goodreads_id,
+    b.isbn13,
+    b.isbn10,
+    b.publication_date,
+    CAST(COALESCE(b.to_be_published, 0) = 1 OR (COALESCE(b.publication_date, '') != '' AND b.publication_date >= substr(date('now'), 1, length(b.publication_date))) AS BOOLEAN) AS unreleased
+FROM books b
+LEFT JOIN series s ON s.id = b.series_id
+WHERE b.is_missing = 1
+  AND (sqlc.narg(series_id) IS NULL OR b.series_id = sqlc.narg(series_id))
+  AND (sqlc.narg(author_id) IS NULL OR b.id IN (SELECT ba.book_id FROM book_authors ba WHERE ba.author_id = sqlc.narg(author_id)))
+  AND (sqlc.narg(unreleased) IS NULL OR (COALESCE(b.to_be_published, 0) = 1 OR (COALESCE(b.publication_date, '') != '' AND b.publication_date >= substr(date('now'), 1, length(b.publication_date)))) = sqlc.narg(unreleased))
+ORDER BY series_name COLLATE NOCASE ASC, b.series_number ASC, b.title COLLATE NOCASE ASC;
+
+-- name: ListRecentlyDiscoveredBooks :many
+SELECT * FROM books
+WHERE is_missing = 1 AND discovered_at IS NOT NULL
+ORDER BY discovered_at DESC, id DESC
+LIMIT ?;
+
+-- name: ListRecentlyDiscoveredUpcomingBooks :many
+SELECT * FROM books
+WHERE is_missing = 1 AND discovered_at IS NOT NULL
+  AND (to_be_published = 1 OR (publication_date != '' AND publication_date >= substr(date('now'), 1, length(publication_date))))
+ORDER BY discovered_at DESC, id DESC
+LIMIT ?;
+
+-- name: ListAllSeries :many
+SELECT * FROM series
+ORDER BY series_id ASC;
+
+-- name: ListAllBooks :many
+SELECT * FROM books
+ORDER BY book_id ASC;
+
+-- name: ListAllAuthors :many
+SELECT * FROM authors
+ORDER BY name ASC;
+
+-- name: ListBookAuthorNames :many
+SELECT b.book_id, a.name FROM book_authors ba
+JOIN books b ON b.id = ba.book_id
+JOIN authors a ON a.id = ba.author_id
+ORDER BY b.book_id ASC, a.name ASC;
+
+-- name: ListSeriesAuthorNames :many
+SELECT s.series_id, a.name FROM series_authors sa
+JOIN series s ON s.id = sa.series_id
+JOIN authors a ON a.id = sa.author_id
+ORDER BY s.series_id ASC, a.name ASC;
+
+-- name: ListMatchReviewsForExport :many
+SELECT s.series_id, b.book_id AS owned_book_id, r.candidate_book_id, r.candidate_title, r.candidate, r.score, r.status
+FROM match_reviews r
+JOIN series s ON s.id = r.series_id
+JOIN books b ON b.id = r.owned_book_id
+ORDER BY s.series_id ASC, b.book_id ASC, r.candidate_book_id ASC;
+
+-- name: ListConfig :many
+SELECT key, value FROM configuration
+ORDER BY key ASC;
+
+-- name: ImportSeries :one
+INSERT INTO series (series_id, name, description, url, data, last_checked_at, last_check_status, last_error, followed)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(series_id) DO UPDATE SET
+    name = excluded.name,
+    description = excluded.description,
+    url = excluded.url,
+    data = excluded.data,
+    last_checked_at = excluded.last_checked_at,
+    last_check_status = excluded.last_check_status,
+    last_error = excluded.last_error,
+    followed = excluded.followed
+RETURNING *;
+
+-- name: ImportBook :one
+INSERT INTO books (book_id, title, description, description_markdown, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, goodreads_work_id, google_id, data, series_id, is_missing, publication_date, to_be_published, metadata_source, cover_url, discovered_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(book_id) DO UPDATE SET
+    title = excluded.title,
+    description = excluded.description,
+    description_markdown = excluded.description_markdown,
+    series_name = excluded.series_name,
+    series_number = excluded.series_number,
+    asin = excluded.asin,
+    isbn10 = excluded.isbn10,
+    isbn13 = excluded.isbn13,
+    language = excluded.language,
+    hardcover_id = excluded.hardcover_id,
+    hardcover_book_id = excluded.hardcover_book_id,
+    goodreads_id = excluded.goodreads_id,
+    goodreads_work_id = excluded.goodreads_work_id,
+    google_id = excluded.google_id,
+    data = excluded.data,
+    series_id = excluded.series_id,
+    is_missing = excluded.is_missing,
+    publication_date = excluded.publication_date,
+    to_be_published = excluded.to_be_published,
+    metadata_source = excluded.metadata_source,
+    cover_url = excluded.cover_url,
+    discovered_at = COALESCE(books.discovered_at, excluded.discovered_at)
+RETURNING *;
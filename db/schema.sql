@@ -15,7 +15,7 @@ CREATE TABLE books (
     goodreads_id VARCHAR(255),
     google_id VARCHAR(255),
     data JSON
-, series_id INTEGER REFERENCES series(id) ON DELETE SET NULL, is_missing BOOLEAN DEFAULT 0);
+, series_id INTEGER REFERENCES series(id) ON DELETE SET NULL, is_missing BOOLEAN DEFAULT 0, publication_date VARCHAR(10), to_be_published BOOLEAN DEFAULT 0, metadata_source VARCHAR(255), cover_url VARCHAR(512), goodreads_work_id VARCHAR(255), description_markdown TEXT, discovered_at DATETIME);
 CREATE TABLE series (
     id INTEGER PRIMARY KEY,
     series_id INTEGER NOT NULL UNIQUE,
@@ -23,11 +23,11 @@ CREATE TABLE series (
     description TEXT,
     url VARCHAR(255),
     data JSON
-);
+, last_checked_at DATETIME, last_check_status VARCHAR(20), last_error TEXT, followed BOOLEAN NOT NULL DEFAULT 0);
 CREATE TABLE authors (
     id INTEGER PRIMARY KEY,
     name VARCHAR(255) NOT NULL UNIQUE
-);
+, goodreads_id VARCHAR(255));
 CREATE TABLE book_authors (
     book_id INTEGER NOT NULL,
     author_id INTEGER NOT NULL,
@@ -49,6 +49,210 @@ CREATE TABLE series_authors (
 );
 CREATE INDEX idx_series_authors_series_id ON series_authors(series_id);
 CREATE INDEX idx_series_authors_author_id ON series_authors(author_id);
+CREATE INDEX idx_authors_goodreads_id ON authors(goodreads_id);
+CREATE INDEX idx_books_publication_date ON books(publication_date);
+CREATE INDEX idx_books_goodreads_work_id ON books(goodreads_work_id);
+CREATE TABLE match_reviews (
+    id INTEGER PRIMARY KEY,
+    series_id INTEGER NOT NULL,
+    owned_book_id INTEGER NOT NULL,
+    candidate_book_id INTEGER NOT NULL,
+    candidate_title VARCHAR(255) NOT NULL,
+    candidate TEXT NOT NULL,
+    score REAL NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    UNIQUE (series_id, owned_book_id, candidate_book_id),
+    FOREIGN KEY (series_id) REFERENCES series(id) ON DELETE CASCADE,
+    FOREIGN KEY (owned_book_id) REFERENCES books(id) ON DELETE CASCADE
+);
+CREATE INDEX idx_match_reviews_status ON match_reviews(status);
+CREATE TABLE completion_runs (
+    id INTEGER PRIMARY KEY,
+    status VARCHAR(20) NOT NULL DEFAULT 'running',
+    provider VARCHAR(50) NOT NULL DEFAULT '',
+    stale_days INTEGER NOT NULL DEFAULT 0,
+    concurrency INTEGER NOT NULL DEFAULT 1,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finished_at DATETIME
+);
+CREATE TABLE completion_run_series (
+    run_id INTEGER NOT NULL,
+    series_id INTEGER NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    new_missing_books INTEGER NOT NULL DEFAULT 0,
+    review_books INTEGER NOT NULL DEFAULT 0,
+    error TEXT,
+    checked_at DATETIME,
+    PRIMARY KEY (run_id, series_id),
+    FOREIGN KEY (run_id) REFERENCES completion_runs(id) ON DELETE CASCADE,
+    FOREIGN KEY (series_id) REFERENCES series(id) ON DELETE CASCADE
+);
+CREATE INDEX idx_completion_run_series_series_id ON completion_run_series(series_id);
+CREATE INDEX idx_series_last_checked_at ON series(last_checked_at);
+CREATE TABLE series_snapshots (
+    id INTEGER PRIMARY KEY,
+    series_id INTEGER NOT NULL,
+    provider VARCHAR(50) NOT NULL,
+    entries TEXT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (series_id) REFERENCES series(id) ON DELETE CASCADE
+);
+CREATE INDEX idx_series_snapshots_series_id ON series_snapshots(series_id);
+CREATE TABLE series_changes (
+    id INTEGER PRIMARY KEY,
+    series_id INTEGER NOT NULL,
+    snapshot_id INTEGER NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    goodreads_id VARCHAR(255),
+    old_position VARCHAR(20),
+    new_position VARCHAR(20),
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (series_id) REFERENCES series(id) ON DELETE CASCADE,
+    FOREIGN KEY (snapshot_id) REFERENCES series_snapshots(id) ON DELETE CASCADE
+);
+CREATE INDEX idx_series_changes_series_id ON series_changes(series_id);
+CREATE TABLE jobs (
+    id INTEGER PRIMARY KEY,
+    kind VARCHAR(50) NOT NULL,
+    payload TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'queued',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    max_attempts INTEGER NOT NULL,
+    run_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    lease_expires_at DATETIME,
+    last_error TEXT,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finished_at DATETIME
+);
+CREATE INDEX idx_jobs_status_run_at ON jobs(status, run_at);
+CREATE VIRTUAL TABLE search_index USING fts5(
+    kind UNINDEXED,
+    ref_id UNINDEXED,
+    title,
+    description,
+    authors,
+    series,
+    tokenize = 'unicode61 remove_diacritics 2'
+);
+CREATE TABLE IF NOT EXISTS 'search_index_data'(id INTEGER PRIMARY KEY, block BLOB);
+CREATE TABLE IF NOT EXISTS 'search_index_idx'(segid, term, pgno, PRIMARY KEY(segid, term)) WITHOUT ROWID;
+CREATE TABLE IF NOT EXISTS 'search_index_content'(id INTEGER PRIMARY KEY, c0, c1, c2, c3, c4, c5);
+CREATE TABLE IF NOT EXISTS 'search_index_docsize'(id INTEGER PRIMARY KEY, sz BLOB);
+CREATE TABLE IF NOT EXISTS 'search_index_config'(k PRIMARY KEY, v) WITHOUT ROWID;
+CREATE TRIGGER search_books_insert AFTER INSERT ON books
+BEGIN
+    INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+    SELECT b.id * 4 + 1, 'book', b.id, b.title, b.description,
+        (SELECT COALESCE(group_concat(a.name, ' '), '') FROM authors a JOIN book_authors ba ON ba.author_id = a.id WHERE ba.book_id = b.id),
+        COALESCE(b.series_name, '')
+    FROM books b WHERE b.id = new.id;
+END;
+CREATE TRIGGER search_books_update AFTER UPDATE ON books
+BEGIN
+    DELETE FROM search_index WHERE rowid = old.id * 4 + 1;
+    INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+    SELECT b.id * 4 + 1, 'book', b.id, b.title, b.description,
+        (SELECT COALESCE(group_concat(a.name, ' '), '') FROM authors a JOIN book_authors ba ON ba.author_id = a.id WHERE ba.book_id = b.id),
+        COALESCE(b.series_name, '')
+    FROM books b WHERE b.id = new.id;
+END;
+CREATE TRIGGER search_books_delete AFTER DELETE ON books
+BEGIN
+    DELETE FROM search_index WHERE rowid = old.id * 4 + 1;
+END;
+CREATE TRIGGER search_book_authors_insert AFTER INSERT ON book_authors
+BEGIN
+    DELETE FROM search_index WHERE rowid = new.book_id * 4 + 1;
+    INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+    SELECT b.id * 4 + 1, 'book', b.id, b.title, b.description,
+        (SELECT COALESCE(group_concat(a.name, ' '), '') FROM authors a JOIN book_authors ba ON ba.author_id = a.id WHERE ba.book_id = b.id),
+        COALESCE(b.series_name, '')
+    FROM books b WHERE b.id = new.book_id;
+END;
+CREATE TRIGGER search_book_authors_delete AFTER DELETE ON book_authors
+BEGIN
+    DELETE FROM search_index WHERE rowid = old.book_id * 4 + 1;
+    INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+    SELECT b.id * 4 + 1, 'book', b.id, b.title, b.description,
+        (SELECT COALESCE(group_concat(a.name, ' '), '') FROM authors a JOIN book_authors ba ON ba.author_id = a.id WHERE ba.book_id = b.id),
+        COALESCE(b.series_name, '')
+    FROM books b WHERE b.id = old.book_id;
+END;
+CREATE TRIGGER search_series_insert AFTER INSERT ON series
+BEGIN
+    INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+    SELECT s.id * 4 + 2, 'series', s.id, s.name, COALESCE(s.description, ''),
+        (SELECT COALESCE(group_concat(a.name, ' '), '') FROM authors a JOIN series_authors sa ON sa.author_id = a.id WHERE sa.series_id = s.id),
+        ''
+    FROM series s WHERE s.id = new.id;
+END;
+CREATE TRIGGER search_series_update AFTER UPDATE ON series
+BEGIN
+    DELETE FROM search_index WHERE rowid = old.id * 4 + 2;
+    INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+    SELECT s.id * 4 + 2, 'series', s.id, s.name, COALESCE(s.description, ''),
+        (SELECT COALESCE(group_concat(a.name, ' '), '') FROM authors a JOIN series_authors sa ON sa.author_id = a.id WHERE sa.series_id = s.id),
+        ''
+    FROM series s WHERE s.id = new.id;
+END;
+CREATE TRIGGER search_series_delete AFTER DELETE ON series
+BEGIN
+    DELETE FROM search_index WHERE rowid = old.id * 4 + 2;
+END;
+CREATE TRIGGER search_series_authors_insert AFTER INSERT ON series_authors
+BEGIN
+    DELETE FROM search_index WHERE rowid = new.series_id * 4 + 2;
+    INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+    SELECT s.id * 4 + 2, 'series', s.id, s.name, COALESCE(s.description, ''),
+        (SELECT COALESCE(group_concat(a.name, ' '), '') FROM authors a JOIN series_authors sa ON sa.author_id = a.id WHERE sa.series_id = s.id),
+        ''
+    FROM series s WHERE s.id = new.series_id;
+END;
+CREATE TRIGGER search_series_authors_delete AFTER DELETE ON series_authors
+BEGIN
+    DELETE FROM search_index WHERE rowid = old.series_id * 4 + 2;
+    INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+    SELECT s.id * 4 + 2, 'series', s.id, s.name, COALESCE(s.description, ''),
+        (SELECT COALESCE(group_concat(a.name, ' '), '') FROM authors a JOIN series_authors sa ON sa.author_id = a.id WHERE sa.series_id = s.id),
+        ''
+    FROM series s WHERE s.id = old.series_id;
+END;
+CREATE TRIGGER search_authors_insert AFTER INSERT ON authors
+BEGIN
+    INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+    SELECT a.id * 4 + 3, 'author', a.id, a.name, '', '', '' FROM authors a WHERE a.id = new.id;
+END;
+CREATE TRIGGER search_authors_update AFTER UPDATE OF name ON authors
+BEGIN
+    DELETE FROM search_index WHERE rowid = old.id * 4 + 3;
+    INSERT INTO search_index (rowid, kind, ref_id, title, description, authors, series)
+    SELECT a.id * 4 + 3, 'author', a.id, a.name, '', '', '' FROM authors a WHERE a.id = new.id;
+END;
+CREATE TRIGGER search_authors_delete AFTER DELETE ON authors
+BEGIN
+    DELETE FROM search_index WHERE rowid = old.id * 4 + 3;
+END;
+CREATE TABLE library_snapshots (
+    id INTEGER PRIMARY KEY,
+    taken_on VARCHAR(10) NOT NULL UNIQUE,
+    total_books INTEGER NOT NULL,
+    owned_books INTEGER NOT NULL,
+    missing_books INTEGER NOT NULL,
+    unreleased_books INTEGER NOT NULL,
+    total_series INTEGER NOT NULL,
+    complete_series INTEGER NOT NULL,
+    incomplete_series INTEGER NOT NULL,
+    books_without_identifiers INTEGER NOT NULL,
+    taken_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX idx_books_discovered_at ON books(discovered_at);
+CREATE TABLE book_lookups (
+    book_id INTEGER PRIMARY KEY,
+    goodreads_checked_at DATETIME,
+    enrich_checked_at DATETIME,
+    FOREIGN KEY (book_id) REFERENCES books(id) ON DELETE CASCADE
+, work_id_checked_at DATETIME);
 -- Dbmate schema migrations
 INSERT INTO "schema_migrations" (version) VALUES
   ('20260107234752'),
@@ -57,4 +261,22 @@ INSERT INTO "schema_migrations" (version) VALUES
   ('20260115100000'),
   ('20260117154351'),
   ('20260117154352'),
-  ('20260117155114');
+  ('20260117155114'),
+  ('20261018090000'),
+  ('20261018100000'),
+  ('20261018110000'),
+  ('20261018120000'),
+  ('20261018130000'),
+  ('20261018140000'),
+  ('20261018150000'),
+  ('20261018160000'),
+  ('20261018170000'),
+  ('20261018180000'),
+  ('20261018190000'),
+  ('20261018200000'),
+  ('20261018210000'),
+  ('20261018220000'),
+  ('20261018230000'),
+  ('20261018233000'),
+  ('20261019000000'),
+  ('20261019010000');
@@ -0,0 +1,476 @@
+// Package dataset exports the library as a portable, versioned JSON document and imports it into another instance.
+// Records refer to each other by their stable identifiers (series and book IDs, author names), never by database row IDs,
+// so a dataset can be imported into any instance. Secret settings are never exported or imported.
+package dataset
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+)
+
+// Version is the schema version of the datasets this build writes. Datasets with a newer version are refused.
+const Version = 1
+
+// ErrUnsupportedVersion is returned when importing a dataset with a schema version this build doesn't know
+var ErrUnsupportedVersion = errors.New("unsupported dataset version")
+
+// ErrInvalidDataset is returned when a dataset refers to records it doesn't contain and the database doesn't have
+var ErrInvalidDataset = errors.New("invalid dataset")
+
+// secretMarkers mark setting keys whose values are credentials
+var secretMarkers = []string{"password", "token", "secret"}
+
+// Dataset is the whole library in a portable form
+type Dataset struct {
+	Version      int               `json:"version"`
+	ExportedAt   time.Time         `json:"exported_at"`
+	Series       []Series          `json:"series"`
+	Books        []Book            `json:"books"`
+	Authors      []Author          `json:"authors"`
+	MatchReviews []MatchReview     `json:"match_reviews"`
+	Settings     map[string]string `json:"settings"`
+}
+
+// Series is a series with its check state, follow flag and authors
+type Series struct {
+	SeriesID        int64           `json:"series_id"`
+	Name            string          `json:"name"`
+	Description     *string         `json:"description,omitempty"`
+	URL             *string         `json:"url,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+	Followed        bool            `json:"followed"`
+	LastCheckedAt   *time.Time      `json:"last_checked_at,omitempty"`
+	LastCheckStatus *string         `json:"last_check_status,omitempty"`
+	LastError       *string         `json:"last_error,omitempty"`
+	// Authors are author names
+	Authors []string `json:"authors,omitempty"`
+}
+
+// Book is an owned or missing book with its identifiers and authors
+type Book struct {
+	BookID              int64   `json:"book_id"`
+	Title               string  `json:"title"`
+	Description         string  `json:"description"`
+	DescriptionMarkdown *string `json:"description_markdown,omitempty"`
+	// SeriesID is the series_id of the book's series
+	SeriesID        *int64          `json:"series_id,omitempty"`
+	SeriesName      *string         `json:"series_name,omitempty"`
+	SeriesNumber    *float64        `json:"series_number,omitempty"`
+	Asin            *string         `json:"asin,omitempty"`
+	Isbn10          *string         `json:"isbn10,omitempty"`
+	Isbn13          *string         `json:"isbn13,omitempty"`
+	Language        *string         `json:"language,omitempty"`
+	HardcoverID     *string         `json:"hardcover_id,omitempty"`
+	HardcoverBookID *int64          `json:"hardcover_book_id,omitempty"`
+	GoodreadsID     *string         `json:"goodreads_id,omitempty"`
+	GoodreadsWorkID *string         `json:"goodreads_work_id,omitempty"`
+	GoogleID        *string         `json:"google_id,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+	IsMissing       bool            `json:"is_missing"`
+	PublicationDate *string         `json:"publication_date,omitempty"`
+	ToBePublished   bool            `json:"to_be_published"`
+	MetadataSource  *string         `json:"metadata_source,omitempty"`
+	CoverURL        *string         `json:"cover_url,omitempty"`
+	DiscoveredAt    *time.Time      `json:"discovered_at,omitempty"`
+	// Authors are author names
+	Authors []string `json:"authors,omitempty"`
+}
+
+// Author is an author and their Goodreads ID
+type Author struct {
+	Name        string  `json:"name"`
+	GoodreadsID *string `json:"goodreads_id,omitempty"`
+}
+
+// MatchReview is an uncertain match between an owned book and a series entry, with its decision
+type MatchReview struct {
+	// SeriesID is the series_id of the series
+	SeriesID int64 `json:"series_id"`
+	// OwnedBookID is the book_id of the owned book
+	OwnedBookID     int64   `json:"owned_book_id"`
+	CandidateBookID int64   `json:"candidate_book_id"`
+	CandidateTitle  string  `json:"candidate_title"`
+	Candidate       string  `json:"candidate"`
+	Score           float64 `json:"score"`
+	Status          string  `json:"status"`
+}
+
+// Summary counts the records an import wrote
+type Summary struct {
+	Series       int `json:"series"`
+	Books        int `json:"books"`
+	Authors      int `json:"authors"`
+	MatchReviews int `json:"match_reviews"`
+	Settings     int `json:"settings"`
+	// SkippedSettings are secret settings in the dataset that were not imported
+	SkippedSettings []string `json:"skipped_settings,omitempty"`
+}
+
+// IsSecret reports whether a setting holds a credential, which is left out of exports and imports
+func IsSecret(key string) bool {
+	key = strings.ToLower(key)
+	for _, marker := range secretMarkers {
+		if strings.Contains(key, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// Export reads the whole library into a dataset
+func Export(ctx context.Context, queries db.Querier, now time.Time) (*Dataset, error) {
+	d := &Dataset{
+		Version:    Version,
+		ExportedAt: now.UTC(),
+		Series:     []Series{},
+		Books:      []Book{},
+		Authors:    []Author{},
+		Settings:   map[string]string{},
+	}
+
+	seriesAuthors, err := queries.ListSeriesAuthorNames(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list series authors: %w", err)
+	}
+	authorsBySeries := make(map[int64][]string)
+	for _, row := range seriesAuthors {
+		authorsBySeries[row.SeriesID] = append(authorsBySeries[row.SeriesID], row.Name)
+	}
+
+	series, err := queries.ListAllSeries(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list series: %w", err)
+	}
+	// Books refer to their series by row ID, which doesn't carry over to another instance
+	seriesIDs := make(map[int64]int64, len(series))
+	for _, s := range series {
+		seriesIDs[s.ID] = s.SeriesID
+		d.Series = append(d.Series, Series{
+			SeriesID:        s.SeriesID,
+			Name:            s.Name,
+			Description:     s.Description,
+			URL:             s.Url,
+			Data:            rawJSON(s.Data),
+			Followed:        s.Followed,
+			LastCheckedAt:   s.LastCheckedAt,
+			LastCheckStatus: s.LastCheckStatus,
+			LastError:       s.LastError,
+			Authors:         authorsBySeries[s.SeriesID],
+		})
+	}
+
+	bookAuthors, err := queries.ListBookAuthorNames(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list book authors: %w", err)
+	}
+	authorsByBook := make(map[int64][]string)
+	for _, row := range bookAuthors {
+		authorsByBook[row.BookID] = append(authorsByBook[row.BookID], row.Name)
+	}
+
+	books, err := queries.ListAllBooks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list books: %w", err)
+	}
+	for _, b := range books {
+		var seriesID *int64
+		if b.SeriesID != nil {
+			if id, ok := seriesIDs[*b.SeriesID]; ok {
+				seriesID = &id
+			}
+		}
+		d.Books = append(d.Books, Book{
+			BookID:              b.BookID,
+			Title:               b.Title,
+			Description:         b.Description,
+			DescriptionMarkdown: b.DescriptionMarkdown,
+			SeriesID:            seriesID,
+			SeriesName:          b.SeriesName,
+			SeriesNumber:        b.SeriesNumber,
+			Asin:                b.Asin,
+			Isbn10:              b.Isbn10,
+			Isbn13:              b.Isbn13,
+			Language:            b.Language,
+			HardcoverID:         b.HardcoverID,
+			HardcoverBookID:     b.HardcoverBookID,
+			GoodreadsID:         b.GoodreadsID,
+			GoodreadsWorkID:     b.GoodreadsWorkID,
+			GoogleID:            b.GoogleID,
+			Data:                rawJSON(b.Data),
+			IsMissing:           b.IsMissing != nil && *b.IsMissing,
+			PublicationDate:     b.PublicationDate,
+			ToBePublished:       b.ToBePublished != nil && *b.ToBePublished,
+			MetadataSource:      b.MetadataSource,
+			CoverURL:            b.CoverUrl,
+			DiscoveredAt:        b.DiscoveredAt,
+			Authors:             authorsByBook[b.BookID],
+		})
+	}
+
+	authors, err := queries.ListAllAuthors(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list authors: %w", err)
+	}
+	for _, a := range authors {
+		d.Authors = append(d.Authors, Author{Name: a.Name, GoodreadsID: a.GoodreadsID})
+	}
+
+	reviews, err := queries.ListMatchReviewsForExport(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list match reviews: %w", err)
+	}
+	d.MatchReviews = make([]MatchReview, 0, len(reviews))
+	for _, r := range reviews {
+		d.MatchReviews = append(d.MatchReviews, MatchReview(r))
+	}
+
+	settings, err := queries.ListConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list settings: %w", err)
+	}
+	for _, setting := range settings {
+		if !IsSecret(setting.Key) {
+			d.Settings[setting.Key] = setting.Value
+		}
+	}
+
+	return d, nil
+}
+
+// Import upserts a dataset into the library. Existing records with the same identifiers are updated,
+// and links to authors are added to the ones already there.
+func Import(ctx context.Context, queries db.Querier, d *Dataset) (Summary, error) {
+	var summary Summary
+	if d.Version < 1 || d.Version > Version {
+		return summary, fmt.Errorf("%w: %d, this version reads up to %d", ErrUnsupportedVersion, d.Version, Version)
+	}
+
+	im := importer{
+		queries: queries,
+		authors: make(map[string]int64),
+		series:  make(map[int64]int64),
+		books:   make(map[int64]int64),
+	}
+
+	for _, a := range d.Authors {
+		if _, err := im.authorID(ctx, a.Name); err != nil {
+			return summary, err
+		}
+		if a.GoodreadsID != nil {
+			if err := queries.SetAuthorGoodreadsID(ctx, db.SetAuthorGoodreadsIDParams{GoodreadsID: a.GoodreadsID, Name: a.Name}); err != nil {
+				return summary, fmt.Errorf("failed to set Goodreads ID of author %q: %w", a.Name, err)
+			}
+		}
+		summary.Authors++
+	}
+
+	for _, s := range d.Series {
+		series, err := queries.ImportSeries(ctx, db.ImportSeriesParams{
+			SeriesID:        s.SeriesID,
+			Name:            s.Name,
+			Description:     s.Description,
+			Url:             s.URL,
+			Data:            jsonValue(s.Data),
+			LastCheckedAt:   s.LastCheckedAt,
+			LastCheckStatus: s.LastCheckStatus,
+			LastError:       s.LastError,
+			Followed:        s.Followed,
+		})
+		if err != nil {
+			return summary, fmt.Errorf("failed to import series %d: %w", s.SeriesID, err)
+		}
+		im.series[s.SeriesID] = series.ID
+
+		for _, name := range s.Authors {
+			authorID, err := im.authorID(ctx, name)
+			if err != nil {
+				return summary, err
+			}
+			if err := queries.LinkSeriesAuthor(ctx, db.LinkSeriesAuthorParams{SeriesID: series.ID, AuthorID: authorID}); err != nil {
+				return summary, fmt.Errorf("failed to link series %d to author %q: %w", s.SeriesID, name, err)
+			}
+		}
+		summary.Series++
+	}
+
+	for _, b := range d.Books {
+		var seriesID *int64
+		if b.SeriesID != nil {
+			id, err := im.seriesID(ctx, *b.SeriesID)
+			if err != nil {
+				return summary, fmt.Errorf("book %d: %w", b.BookID, err)
+			}
+			seriesID = &id
+		}
+
+		isMissing, toBePublished := b.IsMissing, b.ToBePublished
+		book, err := queries.ImportBook(ctx, db.ImportBookParams{
+			BookID:              b.BookID,
+			Title:               b.Title,
+			Description:         b.Description,
+			DescriptionMarkdown: b.DescriptionMarkdown,
+			SeriesName:          b.SeriesName,
+			SeriesNumber:        b.SeriesNumber,
+			Asin:                b.Asin,
+			Isbn10:              b.Isbn10,
+			Isbn13:              b.Isbn13,
+			Language:            b.Language,
+			HardcoverID:         b.HardcoverID,
+			HardcoverBookID:     b.HardcoverBookID,
+			GoodreadsID:         b.GoodreadsID,
+			GoodreadsWorkID:     b.GoodreadsWorkID,
+			GoogleID:            b.GoogleID,
+			Data:                jsonValue(b.Data),
+			SeriesID:            seriesID,
+			IsMissing:           &isMissing,
+			PublicationDate:     b.PublicationDate,
+			ToBePublished:       &toBePublished,
+			MetadataSource:      b.MetadataSource,
+			CoverUrl:            b.CoverURL,
+			DiscoveredAt:        b.DiscoveredAt,
+		})
+		if err != nil {
+			return summary, fmt.Errorf("failed to import book %d: %w", b.BookID, err)
+		}
+		im.books[b.BookID] = book.ID
+
+		for _, name := range b.Authors {
+			authorID, err := im.authorID(ctx, name)
+			if err != nil {
+				return summary, err
+			}
+			if err := queries.LinkBookAuthor(ctx, db.LinkBookAuthorParams{BookID: book.ID, AuthorID: authorID}); err != nil {
+				return summary, fmt.Errorf("failed to link book %d to author %q: %w", b.BookID, name, err)
+			}
+		}
+		summary.Books++
+	}
+
+	for _, r := range d.MatchReviews {
+		seriesID, err := im.seriesID(ctx, r.SeriesID)
+		if err != nil {
+			return summary, fmt.Errorf("match review: %w", err)
+		}
+		bookID, err := im.bookID(ctx, r.OwnedBookID)
+		if err != nil {
+			return summary, fmt.Errorf("match review: %w", err)
+		}
+
+		review, err := queries.UpsertMatchReview(ctx, db.UpsertMatchReviewParams{
+			SeriesID:        seriesID,
+			OwnedBookID:     bookID,
+			CandidateBookID: r.CandidateBookID,
+			CandidateTitle:  r.CandidateTitle,
+			Candidate:       r.Candidate,
+			Score:           r.Score,
+		})
+		if err != nil {
+			return summary, fmt.Errorf("failed to import match review of book %d: %w", r.OwnedBookID, err)
+		}
+		if r.Status != "" && r.Status != review.Status {
+			if err := queries.SetMatchReviewStatus(ctx, db.SetMatchReviewStatusParams{Status: r.Status, ID: review.ID}); err != nil {
+				return summary, fmt.Errorf("failed to set status of match review of book %d: %w", r.OwnedBookID, err)
+			}
+		}
+		summary.MatchReviews++
+	}
+
+	for key, value := range d.Settings {
+		if IsSecret(key) {
+			summary.SkippedSettings = append(summary.SkippedSettings, key)
+			continue
+		}
+		if err := queries.SetConfig(ctx, db.SetConfigParams{Key: key, Value: value}); err != nil {
+			return summary, fmt.Errorf("failed to import setting %q: %w", key, err)
+		}
+		summary.Settings++
+	}
+
+	return summary, nil
+}
+
+// importer maps the stable identifiers in a dataset to the row IDs of the database being imported into
+type importer struct {
+	queries db.Querier
+	// authors maps author names to row IDs
+	authors map[string]int64
+	// series maps series_id to row IDs
+	series map[int64]int64
+	// books maps book_id to row IDs
+	books map[int64]int64
+}
+
+// authorID returns the row ID of the named author, creating the author when needed
+func (im *importer) authorID(ctx context.Context, name string) (int64, error) {
+	if id, ok := im.authors[name]; ok {
+		return id, nil
+	}
+	author, err := im.queries.UpsertAuthor(ctx, name)
+	if err != nil {
+		return 0, fmt.Errorf("failed to import author %q: %w", name, err)
+	}
+	im.authors[name] = author.ID
+	return author.ID, nil
+}
+
+// seriesID returns the row ID of a series from the dataset or already in the database
+func (im *importer) seriesID(ctx context.Context, seriesID int64) (int64, error) {
+	if id, ok := im.series[seriesID]; ok {
+		return id, nil
+	}
+	series, err := im.queries.GetSeriesBySeriesID(ctx, seriesID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("%w: unknown series %d", ErrInvalidDataset, seriesID)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get series %d: %w", seriesID, err)
+	}
+	im.series[seriesID] = series.ID
+	return series.ID, nil
+}
+
+// bookID returns the row ID of a book from the dataset or already in the database
+func (im *importer) bookID(ctx context.Context, bookID int64) (int64, error) {
+	if id, ok := im.books[bookID]; ok {
+		return id, nil
+	}
+	book, err := im.queries.GetBookByBookID(ctx, bookID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("%w: unknown book %d", ErrInvalidDataset, bookID)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get book %d: %w", bookID, err)
+	}
+	im.books[bookID] = book.ID
+	return book.ID, nil
+}
+
+// rawJSON returns a JSON column as raw JSON, or nil when it is empty or not valid JSON
+func rawJSON(data any) json.RawMessage {
+	var raw []byte
+	switch data := data.(type) {
+	case []byte:
+		raw = data
+	case string:
+		raw = []byte(data)
+	}
+	if len(raw) == 0 || !json.Valid(raw) {
+		return nil
+	}
+	return raw
+}
+
+// jsonValue is the value stored in a JSON column for raw JSON
+func jsonValue(raw json.RawMessage) any {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	return string(raw)
+}
@@ -0,0 +1,147 @@
+package dataset
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExport(t *testing.T) {
+	ctx := context.Background()
+	seriesRowID, missing := int64(7), true
+
+	mockQuerier := db.NewMockQuerier(t)
+	mockQuerier.On("ListSeriesAuthorNames", mock.Anything).Return([]db.ListSeriesAuthorNamesRow{{SeriesID: 45175, Name: "Andrew Grant"}}, nil).Once()
+	mockQuerier.On("ListAllSeries", mock.Anything).Return([]db.Series{{ID: seriesRowID, SeriesID: 45175, Name: "David Trevellyan", Data: `{"x":1}`, Followed: true}}, nil).Once()
+	mockQuerier.On("ListBookAuthorNames", mock.Anything).Return([]db.ListBookAuthorNamesRow{{BookID: 42, Name: "Andrew Grant"}}, nil).Once()
+	mockQuerier.On("ListAllBooks", mock.Anything).Return([]db.Book{{ID: 3, BookID: 42, Title: "Die Twice", SeriesID: &seriesRowID, IsMissing: &missing}}, nil).Once()
+	mockQuerier.On("ListAllAuthors", mock.Anything).Return([]db.Author{{ID: 9, Name: "Andrew Grant"}}, nil).Once()
+	mockQuerier.On("ListMatchReviewsForExport", mock.Anything).Return([]db.ListMatchReviewsForExportRow{}, nil).Once()
+	mockQuerier.On("ListConfig", mock.Anything).Return([]db.Configuration{
+		{Key: "booklore_access_token", Value: "secret"},
+		{Key: "password", Value: "hunter2"},
+		{Key: "serverUrl", Value: "https://booklore.example"},
+	}, nil).Once()
+
+	d, err := Export(ctx, mockQuerier, time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	if d.Version != Version {
+		t.Errorf("Version = %d, want %d", d.Version, Version)
+	}
+	if len(d.Series) != 1 || string(d.Series[0].Data) != `{"x":1}` || len(d.Series[0].Authors) != 1 || !d.Series[0].Followed {
+		t.Errorf("Series = %+v", d.Series)
+	}
+	if len(d.Books) != 1 || d.Books[0].SeriesID == nil || *d.Books[0].SeriesID != 45175 || !d.Books[0].IsMissing {
+		t.Errorf("Books = %+v, want the book to refer to series 45175", d.Books)
+	}
+	if len(d.Settings) != 1 || d.Settings["serverUrl"] != "https://booklore.example" {
+		t.Errorf("Settings = %v, want only serverUrl", d.Settings)
+	}
+}
+
+func TestImport(t *testing.T) {
+	ctx := context.Background()
+	seriesID := int64(45175)
+	d := &Dataset{
+		Version: Version,
+		Series:  []Series{{SeriesID: seriesID, Name: "David Trevellyan", Authors: []string{"Andrew Grant"}}},
+		Books:   []Book{{BookID: 42, Title: "Die Twice", SeriesID: &seriesID, Authors: []string{"Andrew Grant"}}},
+		MatchReviews: []MatchReview{
+			{SeriesID: seriesID, OwnedBookID: 42, CandidateBookID: 1000000001, CandidateTitle: "Die Twice", Status: "accepted"},
+		},
+		Settings: map[string]string{"serverUrl": "https://booklore.example", "booklore_refresh_token": "secret"},
+	}
+
+	mockQuerier := db.NewMockQuerier(t)
+	mockQuerier.On("ImportSeries", mock.Anything, mock.MatchedBy(func(arg db.ImportSeriesParams) bool {
+		return arg.SeriesID == seriesID && arg.Data == nil
+	})).Return(db.Series{ID: 7, SeriesID: seriesID}, nil).Once()
+	mockQuerier.On("UpsertAuthor", mock.Anything, "Andrew Grant").Return(db.Author{ID: 9, Name: "Andrew Grant"}, nil).Once()
+	mockQuerier.On("LinkSeriesAuthor", mock.Anything, db.LinkSeriesAuthorParams{SeriesID: 7, AuthorID: 9}).Return(nil).Once()
+	mockQuerier.On("ImportBook", mock.Anything, mock.MatchedBy(func(arg db.ImportBookParams) bool {
+		return arg.BookID == 42 && arg.SeriesID != nil && *arg.SeriesID == 7
+	})).Return(db.Book{ID: 3, BookID: 42}, nil).Once()
+	mockQuerier.On("LinkBookAuthor", mock.Anything, db.LinkBookAuthorParams{BookID: 3, AuthorID: 9}).Return(nil).Once()
+	mockQuerier.On("UpsertMatchReview", mock.Anything, mock.MatchedBy(func(arg db.UpsertMatchReviewParams) bool {
+		return arg.SeriesID == 7 && arg.OwnedBookID == 3
+	})).Return(db.MatchReview{ID: 5, Status: "pending"}, nil).Once()
+	mockQuerier.On("SetMatchReviewStatus", mock.Anything, db.SetMatchReviewStatusParams{Status: "accepted", ID: 5}).Return(nil).Once()
+	mockQuerier.On("SetConfig", mock.Anything, db.SetConfigParams{Key: "serverUrl", Value: "https://booklore.example"}).Return(nil).Once()
+
+	summary, err := Import(ctx, mockQuerier, d)
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+	if summary.Series != 1 || summary.Books != 1 || summary.MatchReviews != 1 || summary.Settings != 1 {
+		t.Errorf("summary = %+v", summary)
+	}
+	if len(summary.SkippedSettings) != 1 || summary.SkippedSettings[0] != "booklore_refresh_token" {
+		t.Errorf("SkippedSettings = %v", summary.SkippedSettings)
+	}
+}
+
+func TestImport_invalid(t *testing.T) {
+	ctx := context.Background()
+	unknownSeries := int64(99)
+
+	tests := []struct {
+		name    string
+		dataset *Dataset
+		setup   func(*db.MockQuerier)
+		wantErr error
+	}{
+		{
+			name:    "newer version",
+			dataset: &Dataset{Version: Version + 1},
+			wantErr: ErrUnsupportedVersion,
+		},
+		{
+			name:    "missing version",
+			dataset: &Dataset{},
+			wantErr: ErrUnsupportedVersion,
+		},
+		{
+			name:    "unknown series",
+			dataset: &Dataset{Version: Version, Books: []Book{{BookID: 42, SeriesID: &unknownSeries}}},
+			setup: func(m *db.MockQuerier) {
+				m.On("GetSeriesBySeriesID", mock.Anything, unknownSeries).Return(db.Series{}, sql.ErrNoRows).Once()
+			},
+			wantErr: ErrInvalidDataset,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQuerier := db.NewMockQuerier(t)
+			if tt.setup != nil {
+				tt.setup(mockQuerier)
+			}
+			if _, err := Import(ctx, mockQuerier, tt.dataset); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Import() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIsSecret(t *testing.T) {
+	for key, want := range map[string]bool{
+		"password":               true,
+		"booklore_access_token":  true,
+		"booklore_refresh_token": true,
+		"API_SECRET":             true,
+		"serverUrl":              false,
+		"username":               false,
+	} {
+		if got := IsSecret(key); got != want {
+			t.Errorf("IsSecret(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
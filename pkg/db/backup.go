@@ -0,0 +1,172 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"modernc.org/sqlite"
+)
+
+// Backup files are named bookscraping-<UTC time>.db, so their names sort by age
+const (
+	backupPrefix     = "bookscraping-"
+	backupSuffix     = ".db"
+	backupTimeFormat = "20060102T150405Z"
+)
+
+// ErrInvalidSnapshot is returned when a file to restore is not a usable database snapshot
+var ErrInvalidSnapshot = errors.New("invalid database snapshot")
+
+// Backup writes a consistent snapshot of the database to path with VACUUM INTO.
+// path must not exist or must be an empty file.
+func (q *Queries) Backup(ctx context.Context, path string) error {
+	if _, err := q.db.ExecContext(ctx, "VACUUM INTO ?", path); err != nil {
+		return fmt.Errorf("failed to back up database to %s: %w", path, err)
+	}
+	return nil
+}
+
+// Restore validates the snapshot at path, copies it over the database with the SQLite backup API
+// and applies the migrations the snapshot predates
+func (q *Queries) Restore(ctx context.Context, path string) error {
+	if err := ValidateSnapshot(ctx, path); err != nil {
+		return err
+	}
+
+	sqlDB, ok := q.db.(*sql.DB)
+	if !ok {
+		return errors.New("restoring needs a database, not a transaction")
+	}
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get database connection: %w", err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			slog.Error("Failed to release database connection", slog.Any("error", err))
+		}
+	}()
+
+	err = conn.Raw(func(driverConn any) error {
+		restorer, ok := driverConn.(interface {
+			NewRestore(srcURI string) (*sqlite.Backup, error)
+		})
+		if !ok {
+			return errors.New("database driver cannot restore backups")
+		}
+
+		backup, err := restorer.NewRestore(path)
+		if err != nil {
+			return err
+		}
+		for more := true; more; {
+			if more, err = backup.Step(-1); err != nil {
+				return errors.Join(err, backup.Finish())
+			}
+		}
+		return backup.Finish()
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore database from %s: %w", path, err)
+	}
+
+	if err := runMigrations(sqlDB); err != nil {
+		return fmt.Errorf("failed to migrate restored database: %w", err)
+	}
+	return nil
+}
+
+// ValidateSnapshot checks that the file at path is an intact bookscraping database
+// without migrations this version doesn't know about
+func ValidateSnapshot(ctx context.Context, path string) error {
+	snapshot, err := sql.Open("sqlite", "file:"+path+"?mode=ro")
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSnapshot, err)
+	}
+	defer func() {
+		if err := snapshot.Close(); err != nil {
+			slog.Error("Failed to close snapshot", slog.String("path", path), slog.Any("error", err))
+		}
+	}()
+
+	var result string
+	if err := snapshot.QueryRowContext(ctx, "PRAGMA integrity_check").Scan(&result); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSnapshot, err)
+	}
+	if result != "ok" {
+		return fmt.Errorf("%w: integrity check failed: %s", ErrInvalidSnapshot, result)
+	}
+
+	rows, err := snapshot.QueryContext(ctx, "SELECT version FROM schema_migrations")
+	if err != nil {
+		return fmt.Errorf("%w: not a bookscraping database: %v", ErrInvalidSnapshot, err)
+	}
+	defer rows.Close()
+
+	migrations, err := migrationFiles()
+	if err != nil {
+		return err
+	}
+	known := make(map[string]bool, len(migrations))
+	for _, filename := range migrations {
+		known[strings.TrimSuffix(filename, ".sql")] = true
+	}
+
+	for rows.Next() {
+		var version string
+		if err := rows.Scan(&version); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidSnapshot, err)
+		}
+		if !known[version] {
+			return fmt.Errorf("%w: migration %s is from a newer version", ErrInvalidSnapshot, version)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSnapshot, err)
+	}
+	return nil
+}
+
+// BackupFileName names a backup taken at t
+func BackupFileName(t time.Time) string {
+	return backupPrefix + t.UTC().Format(backupTimeFormat) + backupSuffix
+}
+
+// PruneBackups removes all but the newest keep backups in dir and returns the paths it removed
+func PruneBackups(dir string, keep int) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup directory %s: %w", dir, err)
+	}
+
+	var backups []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.Type().IsRegular() && strings.HasPrefix(name, backupPrefix) && strings.HasSuffix(name, backupSuffix) {
+			backups = append(backups, name)
+		}
+	}
+	if len(backups) <= keep {
+		return nil, nil
+	}
+	sort.Strings(backups)
+
+	var removed []string
+	for _, name := range backups[:len(backups)-keep] {
+		path := filepath.Join(dir, name)
+		if err := os.Remove(path); err != nil {
+			return removed, fmt.Errorf("failed to remove old backup %s: %w", path, err)
+		}
+		removed = append(removed, path)
+	}
+	return removed, nil
+}
@@ -0,0 +1,129 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// migratedDB opens and migrates the database at path; migrations are read relative to the repository root
+func migratedDB(t *testing.T, path string) *sql.DB {
+	t.Helper()
+	sqlDB, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatalf("opening database: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+	if err := runMigrations(sqlDB); err != nil {
+		t.Fatalf("migrating database: %v", err)
+	}
+	return sqlDB
+}
+
+func TestQueries_BackupAndRestore(t *testing.T) {
+	t.Chdir("../..")
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	sqlDB := migratedDB(t, filepath.Join(dir, "live.db"))
+	queries := New(sqlDB)
+	if _, err := queries.CreateSeries(ctx, CreateSeriesParams{SeriesID: 1, Name: "Kept"}); err != nil {
+		t.Fatalf("CreateSeries() error = %v", err)
+	}
+
+	snapshot := filepath.Join(dir, "snapshot.db")
+	if err := queries.Backup(ctx, snapshot); err != nil {
+		t.Fatalf("Backup() error = %v", err)
+	}
+	if err := ValidateSnapshot(ctx, snapshot); err != nil {
+		t.Fatalf("ValidateSnapshot() error = %v", err)
+	}
+
+	if _, err := queries.CreateSeries(ctx, CreateSeriesParams{SeriesID: 2, Name: "Lost"}); err != nil {
+		t.Fatalf("CreateSeries() error = %v", err)
+	}
+	if err := queries.Restore(ctx, snapshot); err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+
+	count, err := queries.CountSeries(ctx, CountSeriesParams{})
+	if err != nil {
+		t.Fatalf("CountSeries() error = %v", err)
+	}
+	if count != 1 {
+		t.Errorf("series after restore = %d, want 1", count)
+	}
+}
+
+func TestValidateSnapshot(t *testing.T) {
+	t.Chdir("../..")
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	garbage := filepath.Join(dir, "garbage.db")
+	if err := os.WriteFile(garbage, []byte("definitely not a database, just some text that is long enough"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	newer := filepath.Join(dir, "newer.db")
+	newerDB := migratedDB(t, newer)
+	if _, err := newerDB.Exec("INSERT INTO schema_migrations (version) VALUES ('99991231000000_from_the_future')"); err != nil {
+		t.Fatal(err)
+	}
+
+	empty := filepath.Join(dir, "empty.db")
+	if err := os.WriteFile(empty, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, path := range map[string]string{"garbage": garbage, "newer": newer, "empty": empty} {
+		if err := ValidateSnapshot(ctx, path); !errors.Is(err, ErrInvalidSnapshot) {
+			t.Errorf("ValidateSnapshot(%s) error = %v, want ErrInvalidSnapshot", name, err)
+		}
+	}
+}
+
+func TestPruneBackups(t *testing.T) {
+	dir := t.TempDir()
+	start := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	for i := range 4 {
+		name := BackupFileName(start.Add(time.Duration(i) * time.Hour))
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, "notes.txt"), nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	removed, err := PruneBackups(dir, 2)
+	if err != nil {
+		t.Fatalf("PruneBackups() error = %v", err)
+	}
+	if len(removed) != 2 || filepath.Base(removed[0]) != "bookscraping-20261018T000000Z.db" {
+		t.Errorf("removed = %v", removed)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	want := []string{"bookscraping-20261018T020000Z.db", "bookscraping-20261018T030000Z.db", "notes.txt"}
+	if len(names) != len(want) {
+		t.Fatalf("left %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Errorf("left %v, want %v", names, want)
+			break
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package db
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestQueries_upcomingBooks(t *testing.T) {
+	t.Chdir("../..")
+	ctx := context.Background()
+	queries := New(migratedDB(t, filepath.Join(t.TempDir(), "library.db")))
+
+	series, err := queries.CreateSeries(ctx, CreateSeriesParams{SeriesID: 1, Name: "Series"})
+	if err != nil {
+		t.Fatalf("CreateSeries() error = %v", err)
+	}
+	if _, err := queries.SetSeriesFollowed(ctx, SetSeriesFollowedParams{Followed: true, ID: series.ID}); err != nil {
+		t.Fatalf("SetSeriesFollowed() error = %v", err)
+	}
+
+	// Publication dates can be a year, a month or a day; each is upcoming until it has fully passed.
+	// SQLite's date('now') is in UTC.
+	now := time.Now().UTC()
+	dates := map[string]bool{
+		now.Format("2006"):                          true,
+		now.Format("2006-01"):                       true,
+		now.Format(time.DateOnly):                   true,
+		now.AddDate(1, 0, 0).Format("2006"):         true,
+		now.AddDate(0, 0, 1).Format(time.DateOnly):  true,
+		now.AddDate(-1, 0, 0).Format("2006"):        false,
+		now.AddDate(0, -1, 0).Format("2006-01"):     false,
+		now.AddDate(0, 0, -1).Format(time.DateOnly): false,
+		"": false,
+	}
+
+	var want []string
+	bookID, missing, discovered := int64(0), true, now
+	for date, upcoming := range dates {
+		bookID++
+		title := "Published " + date
+		if upcoming {
+			want = append(want, title)
+		}
+		_, err := queries.ImportBook(ctx, ImportBookParams{
+			BookID:          bookID,
+			Title:           title,
+			SeriesID:        &series.ID,
+			IsMissing:       &missing,
+			PublicationDate: &date,
+			DiscoveredAt:    &discovered,
+		})
+		if err != nil {
+			t.Fatalf("ImportBook() error = %v", err)
+		}
+	}
+	slices.Sort(want)
+
+	// Only followed series count towards the upcoming list, but the feed and export cover every series
+	unfollowed, err := queries.CreateSeries(ctx, CreateSeriesParams{SeriesID: 2, Name: "Unfollowed"})
+	if err != nil {
+		t.Fatalf("CreateSeries() error = %v", err)
+	}
+	unfollowedDate := now.AddDate(1, 0, 0).Format("2006")
+	_, err = queries.ImportBook(ctx, ImportBookParams{
+		BookID:          bookID + 1,
+		Title:           "Unfollowed " + unfollowedDate,
+		SeriesID:        &unfollowed.ID,
+		IsMissing:       &missing,
+		PublicationDate: &unfollowedDate,
+		DiscoveredAt:    &discovered,
+	})
+	if err != nil {
+		t.Fatalf("ImportBook() error = %v", err)
+	}
+	wantAll := append(slices.Clone(want), "Unfollowed "+unfollowedDate)
+	slices.Sort(wantAll)
+
+	titles := func(books []Book) []string {
+		got := make([]string, len(books))
+		for i, book := range books {
+			got[i] = book.Title
+		}
+		slices.Sort(got)
+		return got
+	}
+
+	upcoming, err := queries.ListUpcomingBooks(ctx)
+	if err != nil {
+		t.Fatalf("ListUpcomingBooks() error = %v", err)
+	}
+	if got := titles(upcoming); !slices.Equal(got, want) {
+		t.Errorf("ListUpcomingBooks() = %q, want %q", got, want)
+	}
+
+	recent, err := queries.ListRecentlyDiscoveredUpcomingBooks(ctx, 100)
+	if err != nil {
+		t.Fatalf("ListRecentlyDiscoveredUpcomingBooks() error = %v", err)
+	}
+	if got := titles(recent); !slices.Equal(got, wantAll) {
+		t.Errorf("ListRecentlyDiscoveredUpcomingBooks() = %q, want %q", got, wantAll)
+	}
+
+	unreleased := true
+	rows, err := queries.ListMissingBooksForExport(ctx, ListMissingBooksForExportParams{Unreleased: &unreleased})
+	if err != nil {
+		t.Fatalf("ListMissingBooksForExport() error = %v", err)
+	}
+	var got []string
+	for _, row := range rows {
+		if !row.Unreleased {
+			t.Errorf("ListMissingBooksForExport() row %q is not unreleased", row.Title)
+		}
+		got = append(got, row.Title)
+	}
+	slices.Sort(got)
+	if !slices.Equal(got, wantAll) {
+		t.Errorf("ListMissingBooksForExport() = %q, want %q", got, wantAll)
+	}
+}
@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestQueries_jobLeaseTakeover(t *testing.T) {
+	t.Chdir("../..")
+	ctx := context.Background()
+	queries := New(migratedDB(t, filepath.Join(t.TempDir(), "jobs.db")))
+
+	job, err := queries.EnqueueJob(ctx, EnqueueJobParams{Kind: "test", Payload: "{}", MaxAttempts: 3})
+	if err != nil {
+		t.Fatalf("EnqueueJob() error = %v", err)
+	}
+
+	// A zero lease expires straight away, so a second worker can take the job over
+	first, err := queries.ClaimJob(ctx, 0)
+	if err != nil {
+		t.Fatalf("ClaimJob() error = %v", err)
+	}
+	second, err := queries.ClaimJob(ctx, 60)
+	if err != nil {
+		t.Fatalf("ClaimJob() takeover error = %v", err)
+	}
+	if first.ID != job.ID || second.ID != job.ID || second.Attempts != first.Attempts+1 {
+		t.Fatalf("claims = %+v, %+v", first, second)
+	}
+
+	// The first worker's lease is gone: nothing it writes may land
+	stale := []struct {
+		name  string
+		write func() (int64, error)
+	}{
+		{"ExtendJobLease", func() (int64, error) {
+			return queries.ExtendJobLease(ctx, ExtendJobLeaseParams{LeaseSeconds: 60, ID: job.ID, Attempts: first.Attempts})
+		}},
+		{"RetryJob", func() (int64, error) {
+			return queries.RetryJob(ctx, RetryJobParams{ID: job.ID, Attempts: first.Attempts})
+		}},
+		{"ReleaseJob", func() (int64, error) {
+			return queries.ReleaseJob(ctx, ReleaseJobParams{ID: job.ID, Attempts: first.Attempts})
+		}},
+		{"DeadLetterJob", func() (int64, error) {
+			return queries.DeadLetterJob(ctx, DeadLetterJobParams{ID: job.ID, Attempts: first.Attempts})
+		}},
+		{"CompleteJob", func() (int64, error) {
+			return queries.CompleteJob(ctx, CompleteJobParams{ID: job.ID, Attempts: first.Attempts})
+		}},
+	}
+	for _, w := range stale {
+		if rows, err := w.write(); err != nil || rows != 0 {
+			t.Errorf("stale %s = %d, %v, want 0 rows", w.name, rows, err)
+		}
+	}
+
+	if rows, err := queries.CompleteJob(ctx, CompleteJobParams{ID: job.ID, Attempts: second.Attempts}); err != nil || rows != 1 {
+		t.Fatalf("CompleteJob() = %d, %v, want 1 row", rows, err)
+	}
+	// Once the job is finished, even its owner's late writes are ignored
+	if rows, err := queries.RetryJob(ctx, RetryJobParams{ID: job.ID, Attempts: second.Attempts}); err != nil || rows != 0 {
+		t.Errorf("RetryJob() after completion = %d, %v, want 0 rows", rows, err)
+	}
+}
+
+func TestQueries_releaseJob(t *testing.T) {
+	t.Chdir("../..")
+	ctx := context.Background()
+	queries := New(migratedDB(t, filepath.Join(t.TempDir(), "jobs.db")))
+
+	job, err := queries.EnqueueJob(ctx, EnqueueJobParams{Kind: "test", Payload: "{}", MaxAttempts: 1})
+	if err != nil {
+		t.Fatalf("EnqueueJob() error = %v", err)
+	}
+	claimed, err := queries.ClaimJob(ctx, 60)
+	if err != nil {
+		t.Fatalf("ClaimJob() error = %v", err)
+	}
+
+	// Interrupting the only attempt gives it back, so the job is claimed again as its first attempt
+	if rows, err := queries.ReleaseJob(ctx, ReleaseJobParams{ID: job.ID, Attempts: claimed.Attempts}); err != nil || rows != 1 {
+		t.Fatalf("ReleaseJob() = %d, %v, want 1 row", rows, err)
+	}
+	again, err := queries.ClaimJob(ctx, 60)
+	if err != nil {
+		t.Fatalf("ClaimJob() after release error = %v", err)
+	}
+	if again.ID != job.ID || again.Attempts != 1 || again.Attempts > again.MaxAttempts {
+		t.Errorf("claim after release = %+v, want attempt 1 of 1", again)
+	}
+}
@@ -37,145 +37,157 @@ func (_m *MockQuerier) EXPECT() *MockQuerier_Expecter {
 	return &MockQuerier_Expecter{mock: &_m.Mock}
 }
 
-// CountBooks provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) CountBooks(ctx context.Context) (int64, error) {
-	ret := _mock.Called(ctx)
+// AddCompletionRunSeries provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) AddCompletionRunSeries(ctx context.Context, arg AddCompletionRunSeriesParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountBooks")
+		panic("no return value specified for AddCompletionRunSeries")
 	}
 
 	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, AddCompletionRunSeriesParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, AddCompletionRunSeriesParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, AddCompletionRunSeriesParams) error); ok {
+		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockQuerier_CountBooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountBooks'
-type MockQuerier_CountBooks_Call struct {
+// MockQuerier_AddCompletionRunSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCompletionRunSeries'
+type MockQuerier_AddCompletionRunSeries_Call struct {
 	*mock.Call
 }
 
-// CountBooks is a helper method to define mock.On call
+// AddCompletionRunSeries is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockQuerier_Expecter) CountBooks(ctx interface{}) *MockQuerier_CountBooks_Call {
-	return &MockQuerier_CountBooks_Call{Call: _e.mock.On("CountBooks", ctx)}
+//   - arg AddCompletionRunSeriesParams
+func (_e *MockQuerier_Expecter) AddCompletionRunSeries(ctx interface{}, arg interface{}) *MockQuerier_AddCompletionRunSeries_Call {
+	return &MockQuerier_AddCompletionRunSeries_Call{Call: _e.mock.On("AddCompletionRunSeries", ctx, arg)}
 }
 
-func (_c *MockQuerier_CountBooks_Call) Run(run func(ctx context.Context)) *MockQuerier_CountBooks_Call {
+func (_c *MockQuerier_AddCompletionRunSeries_Call) Run(run func(ctx context.Context, arg AddCompletionRunSeriesParams)) *MockQuerier_AddCompletionRunSeries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 AddCompletionRunSeriesParams
+		if args[1] != nil {
+			arg1 = args[1].(AddCompletionRunSeriesParams)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockQuerier_CountBooks_Call) Return(n int64, err error) *MockQuerier_CountBooks_Call {
+func (_c *MockQuerier_AddCompletionRunSeries_Call) Return(n int64, err error) *MockQuerier_AddCompletionRunSeries_Call {
 	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockQuerier_CountBooks_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *MockQuerier_CountBooks_Call {
+func (_c *MockQuerier_AddCompletionRunSeries_Call) RunAndReturn(run func(ctx context.Context, arg AddCompletionRunSeriesParams) (int64, error)) *MockQuerier_AddCompletionRunSeries_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountSeries provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) CountSeries(ctx context.Context) (int64, error) {
-	ret := _mock.Called(ctx)
+// ClaimJob provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ClaimJob(ctx context.Context, leaseSeconds int64) (Job, error) {
+	ret := _mock.Called(ctx, leaseSeconds)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountSeries")
+		panic("no return value specified for ClaimJob")
 	}
 
-	var r0 int64
+	var r0 Job
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (Job, error)); ok {
+		return returnFunc(ctx, leaseSeconds)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) Job); ok {
+		r0 = returnFunc(ctx, leaseSeconds)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(Job)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, leaseSeconds)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockQuerier_CountSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSeries'
-type MockQuerier_CountSeries_Call struct {
+// MockQuerier_ClaimJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimJob'
+type MockQuerier_ClaimJob_Call struct {
 	*mock.Call
 }
 
-// CountSeries is a helper method to define mock.On call
+// ClaimJob is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockQuerier_Expecter) CountSeries(ctx interface{}) *MockQuerier_CountSeries_Call {
-	return &MockQuerier_CountSeries_Call{Call: _e.mock.On("CountSeries", ctx)}
+//   - leaseSeconds int64
+func (_e *MockQuerier_Expecter) ClaimJob(ctx interface{}, leaseSeconds interface{}) *MockQuerier_ClaimJob_Call {
+	return &MockQuerier_ClaimJob_Call{Call: _e.mock.On("ClaimJob", ctx, leaseSeconds)}
 }
 
-func (_c *MockQuerier_CountSeries_Call) Run(run func(ctx context.Context)) *MockQuerier_CountSeries_Call {
+func (_c *MockQuerier_ClaimJob_Call) Run(run func(ctx context.Context, leaseSeconds int64)) *MockQuerier_ClaimJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockQuerier_CountSeries_Call) Return(n int64, err error) *MockQuerier_CountSeries_Call {
-	_c.Call.Return(n, err)
+func (_c *MockQuerier_ClaimJob_Call) Return(job Job, err error) *MockQuerier_ClaimJob_Call {
+	_c.Call.Return(job, err)
 	return _c
 }
 
-func (_c *MockQuerier_CountSeries_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *MockQuerier_CountSeries_Call {
+func (_c *MockQuerier_ClaimJob_Call) RunAndReturn(run func(ctx context.Context, leaseSeconds int64) (Job, error)) *MockQuerier_ClaimJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateBook provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) CreateBook(ctx context.Context, arg CreateBookParams) (Book, error) {
+// CompleteJob provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) CompleteJob(ctx context.Context, arg CompleteJobParams) (int64, error) {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateBook")
+		panic("no return value specified for CompleteJob")
 	}
 
-	var r0 Book
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateBookParams) (Book, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CompleteJobParams) (int64, error)); ok {
 		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateBookParams) Book); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CompleteJobParams) int64); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(Book)
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, CreateBookParams) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, CompleteJobParams) error); ok {
 		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -183,27 +195,27 @@ func (_mock *MockQuerier) CreateBook(ctx context.Context, arg CreateBookParams)
 	return r0, r1
 }
 
-// MockQuerier_CreateBook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBook'
-type MockQuerier_CreateBook_Call struct {
+// MockQuerier_CompleteJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteJob'
+type MockQuerier_CompleteJob_Call struct {
 	*mock.Call
 }
 
-// CreateBook is a helper method to define mock.On call
+// CompleteJob is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg CreateBookParams
-func (_e *MockQuerier_Expecter) CreateBook(ctx interface{}, arg interface{}) *MockQuerier_CreateBook_Call {
-	return &MockQuerier_CreateBook_Call{Call: _e.mock.On("CreateBook", ctx, arg)}
+//   - arg CompleteJobParams
+func (_e *MockQuerier_Expecter) CompleteJob(ctx interface{}, arg interface{}) *MockQuerier_CompleteJob_Call {
+	return &MockQuerier_CompleteJob_Call{Call: _e.mock.On("CompleteJob", ctx, arg)}
 }
 
-func (_c *MockQuerier_CreateBook_Call) Run(run func(ctx context.Context, arg CreateBookParams)) *MockQuerier_CreateBook_Call {
+func (_c *MockQuerier_CompleteJob_Call) Run(run func(ctx context.Context, arg CompleteJobParams)) *MockQuerier_CompleteJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 CreateBookParams
+		var arg1 CompleteJobParams
 		if args[1] != nil {
-			arg1 = args[1].(CreateBookParams)
+			arg1 = args[1].(CompleteJobParams)
 		}
 		run(
 			arg0,
@@ -213,101 +225,95 @@ func (_c *MockQuerier_CreateBook_Call) Run(run func(ctx context.Context, arg Cre
 	return _c
 }
 
-func (_c *MockQuerier_CreateBook_Call) Return(book Book, err error) *MockQuerier_CreateBook_Call {
-	_c.Call.Return(book, err)
+func (_c *MockQuerier_CompleteJob_Call) Return(n int64, err error) *MockQuerier_CompleteJob_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockQuerier_CreateBook_Call) RunAndReturn(run func(ctx context.Context, arg CreateBookParams) (Book, error)) *MockQuerier_CreateBook_Call {
+func (_c *MockQuerier_CompleteJob_Call) RunAndReturn(run func(ctx context.Context, arg CompleteJobParams) (int64, error)) *MockQuerier_CompleteJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateMissingBook provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) CreateMissingBook(ctx context.Context, arg CreateMissingBookParams) (Book, error) {
-	ret := _mock.Called(ctx, arg)
+// CountAuthors provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) CountAuthors(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateMissingBook")
+		panic("no return value specified for CountAuthors")
 	}
 
-	var r0 Book
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateMissingBookParams) (Book, error)); ok {
-		return returnFunc(ctx, arg)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateMissingBookParams) Book); ok {
-		r0 = returnFunc(ctx, arg)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
 	} else {
-		r0 = ret.Get(0).(Book)
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, CreateMissingBookParams) error); ok {
-		r1 = returnFunc(ctx, arg)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockQuerier_CreateMissingBook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMissingBook'
-type MockQuerier_CreateMissingBook_Call struct {
+// MockQuerier_CountAuthors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountAuthors'
+type MockQuerier_CountAuthors_Call struct {
 	*mock.Call
 }
 
-// CreateMissingBook is a helper method to define mock.On call
+// CountAuthors is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg CreateMissingBookParams
-func (_e *MockQuerier_Expecter) CreateMissingBook(ctx interface{}, arg interface{}) *MockQuerier_CreateMissingBook_Call {
-	return &MockQuerier_CreateMissingBook_Call{Call: _e.mock.On("CreateMissingBook", ctx, arg)}
+func (_e *MockQuerier_Expecter) CountAuthors(ctx interface{}) *MockQuerier_CountAuthors_Call {
+	return &MockQuerier_CountAuthors_Call{Call: _e.mock.On("CountAuthors", ctx)}
 }
 
-func (_c *MockQuerier_CreateMissingBook_Call) Run(run func(ctx context.Context, arg CreateMissingBookParams)) *MockQuerier_CreateMissingBook_Call {
+func (_c *MockQuerier_CountAuthors_Call) Run(run func(ctx context.Context)) *MockQuerier_CountAuthors_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 CreateMissingBookParams
-		if args[1] != nil {
-			arg1 = args[1].(CreateMissingBookParams)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockQuerier_CreateMissingBook_Call) Return(book Book, err error) *MockQuerier_CreateMissingBook_Call {
-	_c.Call.Return(book, err)
+func (_c *MockQuerier_CountAuthors_Call) Return(n int64, err error) *MockQuerier_CountAuthors_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockQuerier_CreateMissingBook_Call) RunAndReturn(run func(ctx context.Context, arg CreateMissingBookParams) (Book, error)) *MockQuerier_CreateMissingBook_Call {
+func (_c *MockQuerier_CountAuthors_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *MockQuerier_CountAuthors_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateSeries provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) CreateSeries(ctx context.Context, arg CreateSeriesParams) (Series, error) {
+// CountBooks provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) CountBooks(ctx context.Context, arg CountBooksParams) (int64, error) {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateSeries")
+		panic("no return value specified for CountBooks")
 	}
 
-	var r0 Series
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateSeriesParams) (Series, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CountBooksParams) (int64, error)); ok {
 		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateSeriesParams) Series); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CountBooksParams) int64); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(Series)
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, CreateSeriesParams) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, CountBooksParams) error); ok {
 		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -315,27 +321,27 @@ func (_mock *MockQuerier) CreateSeries(ctx context.Context, arg CreateSeriesPara
 	return r0, r1
 }
 
-// MockQuerier_CreateSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSeries'
-type MockQuerier_CreateSeries_Call struct {
+// MockQuerier_CountBooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountBooks'
+type MockQuerier_CountBooks_Call struct {
 	*mock.Call
 }
 
-// CreateSeries is a helper method to define mock.On call
+// CountBooks is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg CreateSeriesParams
-func (_e *MockQuerier_Expecter) CreateSeries(ctx interface{}, arg interface{}) *MockQuerier_CreateSeries_Call {
-	return &MockQuerier_CreateSeries_Call{Call: _e.mock.On("CreateSeries", ctx, arg)}
+//   - arg CountBooksParams
+func (_e *MockQuerier_Expecter) CountBooks(ctx interface{}, arg interface{}) *MockQuerier_CountBooks_Call {
+	return &MockQuerier_CountBooks_Call{Call: _e.mock.On("CountBooks", ctx, arg)}
 }
 
-func (_c *MockQuerier_CreateSeries_Call) Run(run func(ctx context.Context, arg CreateSeriesParams)) *MockQuerier_CreateSeries_Call {
+func (_c *MockQuerier_CountBooks_Call) Run(run func(ctx context.Context, arg CountBooksParams)) *MockQuerier_CountBooks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 CreateSeriesParams
+		var arg1 CountBooksParams
 		if args[1] != nil {
-			arg1 = args[1].(CreateSeriesParams)
+			arg1 = args[1].(CountBooksParams)
 		}
 		run(
 			arg0,
@@ -345,131 +351,125 @@ func (_c *MockQuerier_CreateSeries_Call) Run(run func(ctx context.Context, arg C
 	return _c
 }
 
-func (_c *MockQuerier_CreateSeries_Call) Return(series Series, err error) *MockQuerier_CreateSeries_Call {
-	_c.Call.Return(series, err)
+func (_c *MockQuerier_CountBooks_Call) Return(n int64, err error) *MockQuerier_CountBooks_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockQuerier_CreateSeries_Call) RunAndReturn(run func(ctx context.Context, arg CreateSeriesParams) (Series, error)) *MockQuerier_CreateSeries_Call {
+func (_c *MockQuerier_CountBooks_Call) RunAndReturn(run func(ctx context.Context, arg CountBooksParams) (int64, error)) *MockQuerier_CountBooks_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAuthorByName provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) GetAuthorByName(ctx context.Context, name string) (Author, error) {
-	ret := _mock.Called(ctx, name)
+// CountJobsByStatus provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) CountJobsByStatus(ctx context.Context) ([]CountJobsByStatusRow, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAuthorByName")
+		panic("no return value specified for CountJobsByStatus")
 	}
 
-	var r0 Author
+	var r0 []CountJobsByStatusRow
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (Author, error)); ok {
-		return returnFunc(ctx, name)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]CountJobsByStatusRow, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) Author); ok {
-		r0 = returnFunc(ctx, name)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []CountJobsByStatusRow); ok {
+		r0 = returnFunc(ctx)
 	} else {
-		r0 = ret.Get(0).(Author)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]CountJobsByStatusRow)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, name)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockQuerier_GetAuthorByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthorByName'
-type MockQuerier_GetAuthorByName_Call struct {
+// MockQuerier_CountJobsByStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountJobsByStatus'
+type MockQuerier_CountJobsByStatus_Call struct {
 	*mock.Call
 }
 
-// GetAuthorByName is a helper method to define mock.On call
+// CountJobsByStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name string
-func (_e *MockQuerier_Expecter) GetAuthorByName(ctx interface{}, name interface{}) *MockQuerier_GetAuthorByName_Call {
-	return &MockQuerier_GetAuthorByName_Call{Call: _e.mock.On("GetAuthorByName", ctx, name)}
+func (_e *MockQuerier_Expecter) CountJobsByStatus(ctx interface{}) *MockQuerier_CountJobsByStatus_Call {
+	return &MockQuerier_CountJobsByStatus_Call{Call: _e.mock.On("CountJobsByStatus", ctx)}
 }
 
-func (_c *MockQuerier_GetAuthorByName_Call) Run(run func(ctx context.Context, name string)) *MockQuerier_GetAuthorByName_Call {
+func (_c *MockQuerier_CountJobsByStatus_Call) Run(run func(ctx context.Context)) *MockQuerier_CountJobsByStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetAuthorByName_Call) Return(author Author, err error) *MockQuerier_GetAuthorByName_Call {
-	_c.Call.Return(author, err)
+func (_c *MockQuerier_CountJobsByStatus_Call) Return(countJobsByStatusRows []CountJobsByStatusRow, err error) *MockQuerier_CountJobsByStatus_Call {
+	_c.Call.Return(countJobsByStatusRows, err)
 	return _c
 }
 
-func (_c *MockQuerier_GetAuthorByName_Call) RunAndReturn(run func(ctx context.Context, name string) (Author, error)) *MockQuerier_GetAuthorByName_Call {
+func (_c *MockQuerier_CountJobsByStatus_Call) RunAndReturn(run func(ctx context.Context) ([]CountJobsByStatusRow, error)) *MockQuerier_CountJobsByStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAuthorsForBook provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) GetAuthorsForBook(ctx context.Context, bookID int64) ([]Author, error) {
-	ret := _mock.Called(ctx, bookID)
+// CountSeries provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) CountSeries(ctx context.Context, arg CountSeriesParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAuthorsForBook")
+		panic("no return value specified for CountSeries")
 	}
 
-	var r0 []Author
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) ([]Author, error)); ok {
-		return returnFunc(ctx, bookID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CountSeriesParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) []Author); ok {
-		r0 = returnFunc(ctx, bookID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CountSeriesParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]Author)
-		}
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, bookID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, CountSeriesParams) error); ok {
+		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockQuerier_GetAuthorsForBook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthorsForBook'
-type MockQuerier_GetAuthorsForBook_Call struct {
+// MockQuerier_CountSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSeries'
+type MockQuerier_CountSeries_Call struct {
 	*mock.Call
 }
 
-// GetAuthorsForBook is a helper method to define mock.On call
+// CountSeries is a helper method to define mock.On call
 //   - ctx context.Context
-//   - bookID int64
-func (_e *MockQuerier_Expecter) GetAuthorsForBook(ctx interface{}, bookID interface{}) *MockQuerier_GetAuthorsForBook_Call {
-	return &MockQuerier_GetAuthorsForBook_Call{Call: _e.mock.On("GetAuthorsForBook", ctx, bookID)}
+//   - arg CountSeriesParams
+func (_e *MockQuerier_Expecter) CountSeries(ctx interface{}, arg interface{}) *MockQuerier_CountSeries_Call {
+	return &MockQuerier_CountSeries_Call{Call: _e.mock.On("CountSeries", ctx, arg)}
 }
 
-func (_c *MockQuerier_GetAuthorsForBook_Call) Run(run func(ctx context.Context, bookID int64)) *MockQuerier_GetAuthorsForBook_Call {
+func (_c *MockQuerier_CountSeries_Call) Run(run func(ctx context.Context, arg CountSeriesParams)) *MockQuerier_CountSeries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int64
+		var arg1 CountSeriesParams
 		if args[1] != nil {
-			arg1 = args[1].(int64)
+			arg1 = args[1].(CountSeriesParams)
 		}
 		run(
 			arg0,
@@ -479,65 +479,63 @@ func (_c *MockQuerier_GetAuthorsForBook_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockQuerier_GetAuthorsForBook_Call) Return(authors []Author, err error) *MockQuerier_GetAuthorsForBook_Call {
-	_c.Call.Return(authors, err)
+func (_c *MockQuerier_CountSeries_Call) Return(n int64, err error) *MockQuerier_CountSeries_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockQuerier_GetAuthorsForBook_Call) RunAndReturn(run func(ctx context.Context, bookID int64) ([]Author, error)) *MockQuerier_GetAuthorsForBook_Call {
+func (_c *MockQuerier_CountSeries_Call) RunAndReturn(run func(ctx context.Context, arg CountSeriesParams) (int64, error)) *MockQuerier_CountSeries_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAuthorsForMultipleSeries provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) GetAuthorsForMultipleSeries(ctx context.Context, seriesIds []int64) ([]GetAuthorsForMultipleSeriesRow, error) {
-	ret := _mock.Called(ctx, seriesIds)
+// CountStaleSeries provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) CountStaleSeries(ctx context.Context, staleDays int64) (int64, error) {
+	ret := _mock.Called(ctx, staleDays)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAuthorsForMultipleSeries")
+		panic("no return value specified for CountStaleSeries")
 	}
 
-	var r0 []GetAuthorsForMultipleSeriesRow
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) ([]GetAuthorsForMultipleSeriesRow, error)); ok {
-		return returnFunc(ctx, seriesIds)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return returnFunc(ctx, staleDays)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) []GetAuthorsForMultipleSeriesRow); ok {
-		r0 = returnFunc(ctx, seriesIds)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = returnFunc(ctx, staleDays)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]GetAuthorsForMultipleSeriesRow)
-		}
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
-		r1 = returnFunc(ctx, seriesIds)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, staleDays)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockQuerier_GetAuthorsForMultipleSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthorsForMultipleSeries'
-type MockQuerier_GetAuthorsForMultipleSeries_Call struct {
+// MockQuerier_CountStaleSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStaleSeries'
+type MockQuerier_CountStaleSeries_Call struct {
 	*mock.Call
 }
 
-// GetAuthorsForMultipleSeries is a helper method to define mock.On call
+// CountStaleSeries is a helper method to define mock.On call
 //   - ctx context.Context
-//   - seriesIds []int64
-func (_e *MockQuerier_Expecter) GetAuthorsForMultipleSeries(ctx interface{}, seriesIds interface{}) *MockQuerier_GetAuthorsForMultipleSeries_Call {
-	return &MockQuerier_GetAuthorsForMultipleSeries_Call{Call: _e.mock.On("GetAuthorsForMultipleSeries", ctx, seriesIds)}
+//   - staleDays int64
+func (_e *MockQuerier_Expecter) CountStaleSeries(ctx interface{}, staleDays interface{}) *MockQuerier_CountStaleSeries_Call {
+	return &MockQuerier_CountStaleSeries_Call{Call: _e.mock.On("CountStaleSeries", ctx, staleDays)}
 }
 
-func (_c *MockQuerier_GetAuthorsForMultipleSeries_Call) Run(run func(ctx context.Context, seriesIds []int64)) *MockQuerier_GetAuthorsForMultipleSeries_Call {
+func (_c *MockQuerier_CountStaleSeries_Call) Run(run func(ctx context.Context, staleDays int64)) *MockQuerier_CountStaleSeries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 []int64
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].([]int64)
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
@@ -547,36 +545,1126 @@ func (_c *MockQuerier_GetAuthorsForMultipleSeries_Call) Run(run func(ctx context
 	return _c
 }
 
-func (_c *MockQuerier_GetAuthorsForMultipleSeries_Call) Return(getAuthorsForMultipleSeriesRows []GetAuthorsForMultipleSeriesRow, err error) *MockQuerier_GetAuthorsForMultipleSeries_Call {
-	_c.Call.Return(getAuthorsForMultipleSeriesRows, err)
+func (_c *MockQuerier_CountStaleSeries_Call) Return(n int64, err error) *MockQuerier_CountStaleSeries_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockQuerier_GetAuthorsForMultipleSeries_Call) RunAndReturn(run func(ctx context.Context, seriesIds []int64) ([]GetAuthorsForMultipleSeriesRow, error)) *MockQuerier_GetAuthorsForMultipleSeries_Call {
+func (_c *MockQuerier_CountStaleSeries_Call) RunAndReturn(run func(ctx context.Context, staleDays int64) (int64, error)) *MockQuerier_CountStaleSeries_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetBook provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) GetBook(ctx context.Context, id int64) (Book, error) {
-	ret := _mock.Called(ctx, id)
+// CreateBook provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) CreateBook(ctx context.Context, arg CreateBookParams) (Book, error) {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetBook")
+		panic("no return value specified for CreateBook")
 	}
 
 	var r0 Book
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (Book, error)); ok {
-		return returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateBookParams) (Book, error)); ok {
+		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) Book); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateBookParams) Book); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
 		r0 = ret.Get(0).(Book)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, CreateBookParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_CreateBook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBook'
+type MockQuerier_CreateBook_Call struct {
+	*mock.Call
+}
+
+// CreateBook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateBookParams
+func (_e *MockQuerier_Expecter) CreateBook(ctx interface{}, arg interface{}) *MockQuerier_CreateBook_Call {
+	return &MockQuerier_CreateBook_Call{Call: _e.mock.On("CreateBook", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateBook_Call) Run(run func(ctx context.Context, arg CreateBookParams)) *MockQuerier_CreateBook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 CreateBookParams
+		if args[1] != nil {
+			arg1 = args[1].(CreateBookParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateBook_Call) Return(book Book, err error) *MockQuerier_CreateBook_Call {
+	_c.Call.Return(book, err)
+	return _c
+}
+
+func (_c *MockQuerier_CreateBook_Call) RunAndReturn(run func(ctx context.Context, arg CreateBookParams) (Book, error)) *MockQuerier_CreateBook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCompletionRun provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) CreateCompletionRun(ctx context.Context, arg CreateCompletionRunParams) (CompletionRun, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCompletionRun")
+	}
+
+	var r0 CompletionRun
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateCompletionRunParams) (CompletionRun, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateCompletionRunParams) CompletionRun); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(CompletionRun)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, CreateCompletionRunParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_CreateCompletionRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCompletionRun'
+type MockQuerier_CreateCompletionRun_Call struct {
+	*mock.Call
+}
+
+// CreateCompletionRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateCompletionRunParams
+func (_e *MockQuerier_Expecter) CreateCompletionRun(ctx interface{}, arg interface{}) *MockQuerier_CreateCompletionRun_Call {
+	return &MockQuerier_CreateCompletionRun_Call{Call: _e.mock.On("CreateCompletionRun", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateCompletionRun_Call) Run(run func(ctx context.Context, arg CreateCompletionRunParams)) *MockQuerier_CreateCompletionRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 CreateCompletionRunParams
+		if args[1] != nil {
+			arg1 = args[1].(CreateCompletionRunParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateCompletionRun_Call) Return(completionRun CompletionRun, err error) *MockQuerier_CreateCompletionRun_Call {
+	_c.Call.Return(completionRun, err)
+	return _c
+}
+
+func (_c *MockQuerier_CreateCompletionRun_Call) RunAndReturn(run func(ctx context.Context, arg CreateCompletionRunParams) (CompletionRun, error)) *MockQuerier_CreateCompletionRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMissingBook provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) CreateMissingBook(ctx context.Context, arg CreateMissingBookParams) (Book, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMissingBook")
+	}
+
+	var r0 Book
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateMissingBookParams) (Book, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateMissingBookParams) Book); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(Book)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, CreateMissingBookParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_CreateMissingBook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMissingBook'
+type MockQuerier_CreateMissingBook_Call struct {
+	*mock.Call
+}
+
+// CreateMissingBook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateMissingBookParams
+func (_e *MockQuerier_Expecter) CreateMissingBook(ctx interface{}, arg interface{}) *MockQuerier_CreateMissingBook_Call {
+	return &MockQuerier_CreateMissingBook_Call{Call: _e.mock.On("CreateMissingBook", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateMissingBook_Call) Run(run func(ctx context.Context, arg CreateMissingBookParams)) *MockQuerier_CreateMissingBook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 CreateMissingBookParams
+		if args[1] != nil {
+			arg1 = args[1].(CreateMissingBookParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateMissingBook_Call) Return(book Book, err error) *MockQuerier_CreateMissingBook_Call {
+	_c.Call.Return(book, err)
+	return _c
+}
+
+func (_c *MockQuerier_CreateMissingBook_Call) RunAndReturn(run func(ctx context.Context, arg CreateMissingBookParams) (Book, error)) *MockQuerier_CreateMissingBook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSeries provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) CreateSeries(ctx context.Context, arg CreateSeriesParams) (Series, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSeries")
+	}
+
+	var r0 Series
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateSeriesParams) (Series, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateSeriesParams) Series); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(Series)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, CreateSeriesParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_CreateSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSeries'
+type MockQuerier_CreateSeries_Call struct {
+	*mock.Call
+}
+
+// CreateSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateSeriesParams
+func (_e *MockQuerier_Expecter) CreateSeries(ctx interface{}, arg interface{}) *MockQuerier_CreateSeries_Call {
+	return &MockQuerier_CreateSeries_Call{Call: _e.mock.On("CreateSeries", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateSeries_Call) Run(run func(ctx context.Context, arg CreateSeriesParams)) *MockQuerier_CreateSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 CreateSeriesParams
+		if args[1] != nil {
+			arg1 = args[1].(CreateSeriesParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateSeries_Call) Return(series Series, err error) *MockQuerier_CreateSeries_Call {
+	_c.Call.Return(series, err)
+	return _c
+}
+
+func (_c *MockQuerier_CreateSeries_Call) RunAndReturn(run func(ctx context.Context, arg CreateSeriesParams) (Series, error)) *MockQuerier_CreateSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSeriesChange provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) CreateSeriesChange(ctx context.Context, arg CreateSeriesChangeParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSeriesChange")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateSeriesChangeParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockQuerier_CreateSeriesChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSeriesChange'
+type MockQuerier_CreateSeriesChange_Call struct {
+	*mock.Call
+}
+
+// CreateSeriesChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateSeriesChangeParams
+func (_e *MockQuerier_Expecter) CreateSeriesChange(ctx interface{}, arg interface{}) *MockQuerier_CreateSeriesChange_Call {
+	return &MockQuerier_CreateSeriesChange_Call{Call: _e.mock.On("CreateSeriesChange", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateSeriesChange_Call) Run(run func(ctx context.Context, arg CreateSeriesChangeParams)) *MockQuerier_CreateSeriesChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 CreateSeriesChangeParams
+		if args[1] != nil {
+			arg1 = args[1].(CreateSeriesChangeParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateSeriesChange_Call) Return(err error) *MockQuerier_CreateSeriesChange_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockQuerier_CreateSeriesChange_Call) RunAndReturn(run func(ctx context.Context, arg CreateSeriesChangeParams) error) *MockQuerier_CreateSeriesChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSeriesSnapshot provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) CreateSeriesSnapshot(ctx context.Context, arg CreateSeriesSnapshotParams) (SeriesSnapshot, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSeriesSnapshot")
+	}
+
+	var r0 SeriesSnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateSeriesSnapshotParams) (SeriesSnapshot, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateSeriesSnapshotParams) SeriesSnapshot); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(SeriesSnapshot)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, CreateSeriesSnapshotParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_CreateSeriesSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSeriesSnapshot'
+type MockQuerier_CreateSeriesSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateSeriesSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateSeriesSnapshotParams
+func (_e *MockQuerier_Expecter) CreateSeriesSnapshot(ctx interface{}, arg interface{}) *MockQuerier_CreateSeriesSnapshot_Call {
+	return &MockQuerier_CreateSeriesSnapshot_Call{Call: _e.mock.On("CreateSeriesSnapshot", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateSeriesSnapshot_Call) Run(run func(ctx context.Context, arg CreateSeriesSnapshotParams)) *MockQuerier_CreateSeriesSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 CreateSeriesSnapshotParams
+		if args[1] != nil {
+			arg1 = args[1].(CreateSeriesSnapshotParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateSeriesSnapshot_Call) Return(seriesSnapshot SeriesSnapshot, err error) *MockQuerier_CreateSeriesSnapshot_Call {
+	_c.Call.Return(seriesSnapshot, err)
+	return _c
+}
+
+func (_c *MockQuerier_CreateSeriesSnapshot_Call) RunAndReturn(run func(ctx context.Context, arg CreateSeriesSnapshotParams) (SeriesSnapshot, error)) *MockQuerier_CreateSeriesSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeadLetterJob provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) DeadLetterJob(ctx context.Context, arg DeadLetterJobParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeadLetterJob")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, DeadLetterJobParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, DeadLetterJobParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, DeadLetterJobParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_DeadLetterJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeadLetterJob'
+type MockQuerier_DeadLetterJob_Call struct {
+	*mock.Call
+}
+
+// DeadLetterJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg DeadLetterJobParams
+func (_e *MockQuerier_Expecter) DeadLetterJob(ctx interface{}, arg interface{}) *MockQuerier_DeadLetterJob_Call {
+	return &MockQuerier_DeadLetterJob_Call{Call: _e.mock.On("DeadLetterJob", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeadLetterJob_Call) Run(run func(ctx context.Context, arg DeadLetterJobParams)) *MockQuerier_DeadLetterJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 DeadLetterJobParams
+		if args[1] != nil {
+			arg1 = args[1].(DeadLetterJobParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeadLetterJob_Call) Return(n int64, err error) *MockQuerier_DeadLetterJob_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockQuerier_DeadLetterJob_Call) RunAndReturn(run func(ctx context.Context, arg DeadLetterJobParams) (int64, error)) *MockQuerier_DeadLetterJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DecideMatchReview provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) DecideMatchReview(ctx context.Context, arg DecideMatchReviewParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecideMatchReview")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, DecideMatchReviewParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, DecideMatchReviewParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, DecideMatchReviewParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_DecideMatchReview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DecideMatchReview'
+type MockQuerier_DecideMatchReview_Call struct {
+	*mock.Call
+}
+
+// DecideMatchReview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg DecideMatchReviewParams
+func (_e *MockQuerier_Expecter) DecideMatchReview(ctx interface{}, arg interface{}) *MockQuerier_DecideMatchReview_Call {
+	return &MockQuerier_DecideMatchReview_Call{Call: _e.mock.On("DecideMatchReview", ctx, arg)}
+}
+
+func (_c *MockQuerier_DecideMatchReview_Call) Run(run func(ctx context.Context, arg DecideMatchReviewParams)) *MockQuerier_DecideMatchReview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 DecideMatchReviewParams
+		if args[1] != nil {
+			arg1 = args[1].(DecideMatchReviewParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DecideMatchReview_Call) Return(n int64, err error) *MockQuerier_DecideMatchReview_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockQuerier_DecideMatchReview_Call) RunAndReturn(run func(ctx context.Context, arg DecideMatchReviewParams) (int64, error)) *MockQuerier_DecideMatchReview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueJob provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) EnqueueJob(ctx context.Context, arg EnqueueJobParams) (Job, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueJob")
+	}
+
+	var r0 Job
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, EnqueueJobParams) (Job, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, EnqueueJobParams) Job); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(Job)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, EnqueueJobParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_EnqueueJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueJob'
+type MockQuerier_EnqueueJob_Call struct {
+	*mock.Call
+}
+
+// EnqueueJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg EnqueueJobParams
+func (_e *MockQuerier_Expecter) EnqueueJob(ctx interface{}, arg interface{}) *MockQuerier_EnqueueJob_Call {
+	return &MockQuerier_EnqueueJob_Call{Call: _e.mock.On("EnqueueJob", ctx, arg)}
+}
+
+func (_c *MockQuerier_EnqueueJob_Call) Run(run func(ctx context.Context, arg EnqueueJobParams)) *MockQuerier_EnqueueJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 EnqueueJobParams
+		if args[1] != nil {
+			arg1 = args[1].(EnqueueJobParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_EnqueueJob_Call) Return(job Job, err error) *MockQuerier_EnqueueJob_Call {
+	_c.Call.Return(job, err)
+	return _c
+}
+
+func (_c *MockQuerier_EnqueueJob_Call) RunAndReturn(run func(ctx context.Context, arg EnqueueJobParams) (Job, error)) *MockQuerier_EnqueueJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnrichBook provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) EnrichBook(ctx context.Context, arg EnrichBookParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnrichBook")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, EnrichBookParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockQuerier_EnrichBook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnrichBook'
+type MockQuerier_EnrichBook_Call struct {
+	*mock.Call
+}
+
+// EnrichBook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg EnrichBookParams
+func (_e *MockQuerier_Expecter) EnrichBook(ctx interface{}, arg interface{}) *MockQuerier_EnrichBook_Call {
+	return &MockQuerier_EnrichBook_Call{Call: _e.mock.On("EnrichBook", ctx, arg)}
+}
+
+func (_c *MockQuerier_EnrichBook_Call) Run(run func(ctx context.Context, arg EnrichBookParams)) *MockQuerier_EnrichBook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 EnrichBookParams
+		if args[1] != nil {
+			arg1 = args[1].(EnrichBookParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_EnrichBook_Call) Return(err error) *MockQuerier_EnrichBook_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockQuerier_EnrichBook_Call) RunAndReturn(run func(ctx context.Context, arg EnrichBookParams) error) *MockQuerier_EnrichBook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExtendJobLease provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ExtendJobLease(ctx context.Context, arg ExtendJobLeaseParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExtendJobLease")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ExtendJobLeaseParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ExtendJobLeaseParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ExtendJobLeaseParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ExtendJobLease_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExtendJobLease'
+type MockQuerier_ExtendJobLease_Call struct {
+	*mock.Call
+}
+
+// ExtendJobLease is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg ExtendJobLeaseParams
+func (_e *MockQuerier_Expecter) ExtendJobLease(ctx interface{}, arg interface{}) *MockQuerier_ExtendJobLease_Call {
+	return &MockQuerier_ExtendJobLease_Call{Call: _e.mock.On("ExtendJobLease", ctx, arg)}
+}
+
+func (_c *MockQuerier_ExtendJobLease_Call) Run(run func(ctx context.Context, arg ExtendJobLeaseParams)) *MockQuerier_ExtendJobLease_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ExtendJobLeaseParams
+		if args[1] != nil {
+			arg1 = args[1].(ExtendJobLeaseParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExtendJobLease_Call) Return(n int64, err error) *MockQuerier_ExtendJobLease_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockQuerier_ExtendJobLease_Call) RunAndReturn(run func(ctx context.Context, arg ExtendJobLeaseParams) (int64, error)) *MockQuerier_ExtendJobLease_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishCompletionRun provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) FinishCompletionRun(ctx context.Context, arg FinishCompletionRunParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishCompletionRun")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, FinishCompletionRunParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockQuerier_FinishCompletionRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishCompletionRun'
+type MockQuerier_FinishCompletionRun_Call struct {
+	*mock.Call
+}
+
+// FinishCompletionRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg FinishCompletionRunParams
+func (_e *MockQuerier_Expecter) FinishCompletionRun(ctx interface{}, arg interface{}) *MockQuerier_FinishCompletionRun_Call {
+	return &MockQuerier_FinishCompletionRun_Call{Call: _e.mock.On("FinishCompletionRun", ctx, arg)}
+}
+
+func (_c *MockQuerier_FinishCompletionRun_Call) Run(run func(ctx context.Context, arg FinishCompletionRunParams)) *MockQuerier_FinishCompletionRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 FinishCompletionRunParams
+		if args[1] != nil {
+			arg1 = args[1].(FinishCompletionRunParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_FinishCompletionRun_Call) Return(err error) *MockQuerier_FinishCompletionRun_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockQuerier_FinishCompletionRun_Call) RunAndReturn(run func(ctx context.Context, arg FinishCompletionRunParams) error) *MockQuerier_FinishCompletionRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishCompletionRunSeries provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) FinishCompletionRunSeries(ctx context.Context, arg FinishCompletionRunSeriesParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishCompletionRunSeries")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, FinishCompletionRunSeriesParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockQuerier_FinishCompletionRunSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishCompletionRunSeries'
+type MockQuerier_FinishCompletionRunSeries_Call struct {
+	*mock.Call
+}
+
+// FinishCompletionRunSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg FinishCompletionRunSeriesParams
+func (_e *MockQuerier_Expecter) FinishCompletionRunSeries(ctx interface{}, arg interface{}) *MockQuerier_FinishCompletionRunSeries_Call {
+	return &MockQuerier_FinishCompletionRunSeries_Call{Call: _e.mock.On("FinishCompletionRunSeries", ctx, arg)}
+}
+
+func (_c *MockQuerier_FinishCompletionRunSeries_Call) Run(run func(ctx context.Context, arg FinishCompletionRunSeriesParams)) *MockQuerier_FinishCompletionRunSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 FinishCompletionRunSeriesParams
+		if args[1] != nil {
+			arg1 = args[1].(FinishCompletionRunSeriesParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_FinishCompletionRunSeries_Call) Return(err error) *MockQuerier_FinishCompletionRunSeries_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockQuerier_FinishCompletionRunSeries_Call) RunAndReturn(run func(ctx context.Context, arg FinishCompletionRunSeriesParams) error) *MockQuerier_FinishCompletionRunSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuthor provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) GetAuthor(ctx context.Context, id int64) (Author, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuthor")
+	}
+
+	var r0 Author
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (Author, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) Author); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(Author)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_GetAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthor'
+type MockQuerier_GetAuthor_Call struct {
+	*mock.Call
+}
+
+// GetAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockQuerier_Expecter) GetAuthor(ctx interface{}, id interface{}) *MockQuerier_GetAuthor_Call {
+	return &MockQuerier_GetAuthor_Call{Call: _e.mock.On("GetAuthor", ctx, id)}
+}
+
+func (_c *MockQuerier_GetAuthor_Call) Run(run func(ctx context.Context, id int64)) *MockQuerier_GetAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAuthor_Call) Return(author Author, err error) *MockQuerier_GetAuthor_Call {
+	_c.Call.Return(author, err)
+	return _c
+}
+
+func (_c *MockQuerier_GetAuthor_Call) RunAndReturn(run func(ctx context.Context, id int64) (Author, error)) *MockQuerier_GetAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuthorByName provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) GetAuthorByName(ctx context.Context, name string) (Author, error) {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuthorByName")
+	}
+
+	var r0 Author
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (Author, error)); ok {
+		return returnFunc(ctx, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) Author); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		r0 = ret.Get(0).(Author)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_GetAuthorByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthorByName'
+type MockQuerier_GetAuthorByName_Call struct {
+	*mock.Call
+}
+
+// GetAuthorByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockQuerier_Expecter) GetAuthorByName(ctx interface{}, name interface{}) *MockQuerier_GetAuthorByName_Call {
+	return &MockQuerier_GetAuthorByName_Call{Call: _e.mock.On("GetAuthorByName", ctx, name)}
+}
+
+func (_c *MockQuerier_GetAuthorByName_Call) Run(run func(ctx context.Context, name string)) *MockQuerier_GetAuthorByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAuthorByName_Call) Return(author Author, err error) *MockQuerier_GetAuthorByName_Call {
+	_c.Call.Return(author, err)
+	return _c
+}
+
+func (_c *MockQuerier_GetAuthorByName_Call) RunAndReturn(run func(ctx context.Context, name string) (Author, error)) *MockQuerier_GetAuthorByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuthorsForBook provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) GetAuthorsForBook(ctx context.Context, bookID int64) ([]Author, error) {
+	ret := _mock.Called(ctx, bookID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuthorsForBook")
+	}
+
+	var r0 []Author
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) ([]Author, error)); ok {
+		return returnFunc(ctx, bookID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) []Author); ok {
+		r0 = returnFunc(ctx, bookID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Author)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, bookID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_GetAuthorsForBook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthorsForBook'
+type MockQuerier_GetAuthorsForBook_Call struct {
+	*mock.Call
+}
+
+// GetAuthorsForBook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bookID int64
+func (_e *MockQuerier_Expecter) GetAuthorsForBook(ctx interface{}, bookID interface{}) *MockQuerier_GetAuthorsForBook_Call {
+	return &MockQuerier_GetAuthorsForBook_Call{Call: _e.mock.On("GetAuthorsForBook", ctx, bookID)}
+}
+
+func (_c *MockQuerier_GetAuthorsForBook_Call) Run(run func(ctx context.Context, bookID int64)) *MockQuerier_GetAuthorsForBook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAuthorsForBook_Call) Return(authors []Author, err error) *MockQuerier_GetAuthorsForBook_Call {
+	_c.Call.Return(authors, err)
+	return _c
+}
+
+func (_c *MockQuerier_GetAuthorsForBook_Call) RunAndReturn(run func(ctx context.Context, bookID int64) ([]Author, error)) *MockQuerier_GetAuthorsForBook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuthorsForMultipleSeries provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) GetAuthorsForMultipleSeries(ctx context.Context, seriesIds []int64) ([]GetAuthorsForMultipleSeriesRow, error) {
+	ret := _mock.Called(ctx, seriesIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuthorsForMultipleSeries")
+	}
+
+	var r0 []GetAuthorsForMultipleSeriesRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) ([]GetAuthorsForMultipleSeriesRow, error)); ok {
+		return returnFunc(ctx, seriesIds)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) []GetAuthorsForMultipleSeriesRow); ok {
+		r0 = returnFunc(ctx, seriesIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetAuthorsForMultipleSeriesRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = returnFunc(ctx, seriesIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_GetAuthorsForMultipleSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthorsForMultipleSeries'
+type MockQuerier_GetAuthorsForMultipleSeries_Call struct {
+	*mock.Call
+}
+
+// GetAuthorsForMultipleSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - seriesIds []int64
+func (_e *MockQuerier_Expecter) GetAuthorsForMultipleSeries(ctx interface{}, seriesIds interface{}) *MockQuerier_GetAuthorsForMultipleSeries_Call {
+	return &MockQuerier_GetAuthorsForMultipleSeries_Call{Call: _e.mock.On("GetAuthorsForMultipleSeries", ctx, seriesIds)}
+}
+
+func (_c *MockQuerier_GetAuthorsForMultipleSeries_Call) Run(run func(ctx context.Context, seriesIds []int64)) *MockQuerier_GetAuthorsForMultipleSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []int64
+		if args[1] != nil {
+			arg1 = args[1].([]int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAuthorsForMultipleSeries_Call) Return(getAuthorsForMultipleSeriesRows []GetAuthorsForMultipleSeriesRow, err error) *MockQuerier_GetAuthorsForMultipleSeries_Call {
+	_c.Call.Return(getAuthorsForMultipleSeriesRows, err)
+	return _c
+}
+
+func (_c *MockQuerier_GetAuthorsForMultipleSeries_Call) RunAndReturn(run func(ctx context.Context, seriesIds []int64) ([]GetAuthorsForMultipleSeriesRow, error)) *MockQuerier_GetAuthorsForMultipleSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBook provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) GetBook(ctx context.Context, id int64) (Book, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBook")
+	}
+
+	var r0 Book
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (Book, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) Book); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(Book)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -588,14 +1676,3217 @@ type MockQuerier_GetBook_Call struct {
 	*mock.Call
 }
 
-// GetBook is a helper method to define mock.On call
+// GetBook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockQuerier_Expecter) GetBook(ctx interface{}, id interface{}) *MockQuerier_GetBook_Call {
+	return &MockQuerier_GetBook_Call{Call: _e.mock.On("GetBook", ctx, id)}
+}
+
+func (_c *MockQuerier_GetBook_Call) Run(run func(ctx context.Context, id int64)) *MockQuerier_GetBook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetBook_Call) Return(book Book, err error) *MockQuerier_GetBook_Call {
+	_c.Call.Return(book, err)
+	return _c
+}
+
+func (_c *MockQuerier_GetBook_Call) RunAndReturn(run func(ctx context.Context, id int64) (Book, error)) *MockQuerier_GetBook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBookByBookID provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) GetBookByBookID(ctx context.Context, bookID int64) (Book, error) {
+	ret := _mock.Called(ctx, bookID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBookByBookID")
+	}
+
+	var r0 Book
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (Book, error)); ok {
+		return returnFunc(ctx, bookID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) Book); ok {
+		r0 = returnFunc(ctx, bookID)
+	} else {
+		r0 = ret.Get(0).(Book)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, bookID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_GetBookByBookID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBookByBookID'
+type MockQuerier_GetBookByBookID_Call struct {
+	*mock.Call
+}
+
+// GetBookByBookID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bookID int64
+func (_e *MockQuerier_Expecter) GetBookByBookID(ctx interface{}, bookID interface{}) *MockQuerier_GetBookByBookID_Call {
+	return &MockQuerier_GetBookByBookID_Call{Call: _e.mock.On("GetBookByBookID", ctx, bookID)}
+}
+
+func (_c *MockQuerier_GetBookByBookID_Call) Run(run func(ctx context.Context, bookID int64)) *MockQuerier_GetBookByBookID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetBookByBookID_Call) Return(book Book, err error) *MockQuerier_GetBookByBookID_Call {
+	_c.Call.Return(book, err)
+	return _c
+}
+
+func (_c *MockQuerier_GetBookByBookID_Call) RunAndReturn(run func(ctx context.Context, bookID int64) (Book, error)) *MockQuerier_GetBookByBookID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBooksByAuthor provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) GetBooksByAuthor(ctx context.Context, authorID int64) ([]Book, error) {
+	ret := _mock.Called(ctx, authorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBooksByAuthor")
+	}
+
+	var r0 []Book
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) ([]Book, error)); ok {
+		return returnFunc(ctx, authorID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) []Book); ok {
+		r0 = returnFunc(ctx, authorID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Book)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, authorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_GetBooksByAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBooksByAuthor'
+type MockQuerier_GetBooksByAuthor_Call struct {
+	*mock.Call
+}
+
+// GetBooksByAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorID int64
+func (_e *MockQuerier_Expecter) GetBooksByAuthor(ctx interface{}, authorID interface{}) *MockQuerier_GetBooksByAuthor_Call {
+	return &MockQuerier_GetBooksByAuthor_Call{Call: _e.mock.On("GetBooksByAuthor", ctx, authorID)}
+}
+
+func (_c *MockQuerier_GetBooksByAuthor_Call) Run(run func(ctx context.Context, authorID int64)) *MockQuerier_GetBooksByAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetBooksByAuthor_Call) Return(books []Book, err error) *MockQuerier_GetBooksByAuthor_Call {
+	_c.Call.Return(books, err)
+	return _c
+}
+
+func (_c *MockQuerier_GetBooksByAuthor_Call) RunAndReturn(run func(ctx context.Context, authorID int64) ([]Book, error)) *MockQuerier_GetBooksByAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBooksBySeries provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) GetBooksBySeries(ctx context.Context, seriesID *int64) ([]Book, error) {
+	ret := _mock.Called(ctx, seriesID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBooksBySeries")
+	}
+
+	var r0 []Book
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *int64) ([]Book, error)); ok {
+		return returnFunc(ctx, seriesID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *int64) []Book); ok {
+		r0 = returnFunc(ctx, seriesID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Book)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *int64) error); ok {
+		r1 = returnFunc(ctx, seriesID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_GetBooksBySeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBooksBySeries'
+type MockQuerier_GetBooksBySeries_Call struct {
+	*mock.Call
+}
+
+// GetBooksBySeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - seriesID *int64
+func (_e *MockQuerier_Expecter) GetBooksBySeries(ctx interface{}, seriesID interface{}) *MockQuerier_GetBooksBySeries_Call {
+	return &MockQuerier_GetBooksBySeries_Call{Call: _e.mock.On("GetBooksBySeries", ctx, seriesID)}
+}
+
+func (_c *MockQuerier_GetBooksBySeries_Call) Run(run func(ctx context.Context, seriesID *int64)) *MockQuerier_GetBooksBySeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *int64
+		if args[1] != nil {
+			arg1 = args[1].(*int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetBooksBySeries_Call) Return(books []Book, err error) *MockQuerier_GetBooksBySeries_Call {
+	_c.Call.Return(books, err)
+	return _c
+}
+
+func (_c *MockQuerier_GetBooksBySeries_Call) RunAndReturn(run func(ctx context.Context, seriesID *int64) ([]Book, error)) *MockQuerier_GetBooksBySeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCompletionRun provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) GetCompletionRun(ctx context.Context, id int64) (CompletionRun, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCompletionRun")
+	}
+
+	var r0 CompletionRun
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (CompletionRun, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) CompletionRun); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(CompletionRun)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_GetCompletionRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompletionRun'
+type MockQuerier_GetCompletionRun_Call struct {
+	*mock.Call
+}
+
+// GetCompletionRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockQuerier_Expecter) GetCompletionRun(ctx interface{}, id interface{}) *MockQuerier_GetCompletionRun_Call {
+	return &MockQuerier_GetCompletionRun_Call{Call: _e.mock.On("GetCompletionRun", ctx, id)}
+}
+
+func (_c *MockQuerier_GetCompletionRun_Call) Run(run func(ctx context.Context, id int64)) *MockQuerier_GetCompletionRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetCompletionRun_Call) Return(completionRun CompletionRun, err error) *MockQuerier_GetCompletionRun_Call {
+	_c.Call.Return(completionRun, err)
+	return _c
+}
+
+func (_c *MockQuerier_GetCompletionRun_Call) RunAndReturn(run func(ctx context.Context, id int64) (CompletionRun, error)) *MockQuerier_GetCompletionRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConfig provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) GetConfig(ctx context.Context, key string) (string, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfig")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_GetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfig'
+type MockQuerier_GetConfig_Call struct {
+	*mock.Call
+}
+
+// GetConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockQuerier_Expecter) GetConfig(ctx interface{}, key interface{}) *MockQuerier_GetConfig_Call {
+	return &MockQuerier_GetConfig_Call{Call: _e.mock.On("GetConfig", ctx, key)}
+}
+
+func (_c *MockQuerier_GetConfig_Call) Run(run func(ctx context.Context, key string)) *MockQuerier_GetConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetConfig_Call) Return(s string, err error) *MockQuerier_GetConfig_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockQuerier_GetConfig_Call) RunAndReturn(run func(ctx context.Context, key string) (string, error)) *MockQuerier_GetConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJob provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) GetJob(ctx context.Context, id int64) (Job, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJob")
+	}
+
+	var r0 Job
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (Job, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) Job); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(Job)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_GetJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJob'
+type MockQuerier_GetJob_Call struct {
+	*mock.Call
+}
+
+// GetJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockQuerier_Expecter) GetJob(ctx interface{}, id interface{}) *MockQuerier_GetJob_Call {
+	return &MockQuerier_GetJob_Call{Call: _e.mock.On("GetJob", ctx, id)}
+}
+
+func (_c *MockQuerier_GetJob_Call) Run(run func(ctx context.Context, id int64)) *MockQuerier_GetJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetJob_Call) Return(job Job, err error) *MockQuerier_GetJob_Call {
+	_c.Call.Return(job, err)
+	return _c
+}
+
+func (_c *MockQuerier_GetJob_Call) RunAndReturn(run func(ctx context.Context, id int64) (Job, error)) *MockQuerier_GetJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestSeriesSnapshot provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) GetLatestSeriesSnapshot(ctx context.Context, arg GetLatestSeriesSnapshotParams) (SeriesSnapshot, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestSeriesSnapshot")
+	}
+
+	var r0 SeriesSnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetLatestSeriesSnapshotParams) (SeriesSnapshot, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GetLatestSeriesSnapshotParams) SeriesSnapshot); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(SeriesSnapshot)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, GetLatestSeriesSnapshotParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_GetLatestSeriesSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestSeriesSnapshot'
+type MockQuerier_GetLatestSeriesSnapshot_Call struct {
+	*mock.Call
+}
+
+// GetLatestSeriesSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetLatestSeriesSnapshotParams
+func (_e *MockQuerier_Expecter) GetLatestSeriesSnapshot(ctx interface{}, arg interface{}) *MockQuerier_GetLatestSeriesSnapshot_Call {
+	return &MockQuerier_GetLatestSeriesSnapshot_Call{Call: _e.mock.On("GetLatestSeriesSnapshot", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetLatestSeriesSnapshot_Call) Run(run func(ctx context.Context, arg GetLatestSeriesSnapshotParams)) *MockQuerier_GetLatestSeriesSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 GetLatestSeriesSnapshotParams
+		if args[1] != nil {
+			arg1 = args[1].(GetLatestSeriesSnapshotParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetLatestSeriesSnapshot_Call) Return(seriesSnapshot SeriesSnapshot, err error) *MockQuerier_GetLatestSeriesSnapshot_Call {
+	_c.Call.Return(seriesSnapshot, err)
+	return _c
+}
+
+func (_c *MockQuerier_GetLatestSeriesSnapshot_Call) RunAndReturn(run func(ctx context.Context, arg GetLatestSeriesSnapshotParams) (SeriesSnapshot, error)) *MockQuerier_GetLatestSeriesSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLibraryStats provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) GetLibraryStats(ctx context.Context) (GetLibraryStatsRow, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLibraryStats")
+	}
+
+	var r0 GetLibraryStatsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (GetLibraryStatsRow, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) GetLibraryStatsRow); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(GetLibraryStatsRow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_GetLibraryStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLibraryStats'
+type MockQuerier_GetLibraryStats_Call struct {
+	*mock.Call
+}
+
+// GetLibraryStats is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) GetLibraryStats(ctx interface{}) *MockQuerier_GetLibraryStats_Call {
+	return &MockQuerier_GetLibraryStats_Call{Call: _e.mock.On("GetLibraryStats", ctx)}
+}
+
+func (_c *MockQuerier_GetLibraryStats_Call) Run(run func(ctx context.Context)) *MockQuerier_GetLibraryStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetLibraryStats_Call) Return(getLibraryStatsRow GetLibraryStatsRow, err error) *MockQuerier_GetLibraryStats_Call {
+	_c.Call.Return(getLibraryStatsRow, err)
+	return _c
+}
+
+func (_c *MockQuerier_GetLibraryStats_Call) RunAndReturn(run func(ctx context.Context) (GetLibraryStatsRow, error)) *MockQuerier_GetLibraryStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMatchReview provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) GetMatchReview(ctx context.Context, id int64) (MatchReview, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMatchReview")
+	}
+
+	var r0 MatchReview
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (MatchReview, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) MatchReview); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(MatchReview)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_GetMatchReview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMatchReview'
+type MockQuerier_GetMatchReview_Call struct {
+	*mock.Call
+}
+
+// GetMatchReview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockQuerier_Expecter) GetMatchReview(ctx interface{}, id interface{}) *MockQuerier_GetMatchReview_Call {
+	return &MockQuerier_GetMatchReview_Call{Call: _e.mock.On("GetMatchReview", ctx, id)}
+}
+
+func (_c *MockQuerier_GetMatchReview_Call) Run(run func(ctx context.Context, id int64)) *MockQuerier_GetMatchReview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetMatchReview_Call) Return(matchReview MatchReview, err error) *MockQuerier_GetMatchReview_Call {
+	_c.Call.Return(matchReview, err)
+	return _c
+}
+
+func (_c *MockQuerier_GetMatchReview_Call) RunAndReturn(run func(ctx context.Context, id int64) (MatchReview, error)) *MockQuerier_GetMatchReview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMultipleConfig provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) GetMultipleConfig(ctx context.Context, keys []string) ([]Configuration, error) {
+	ret := _mock.Called(ctx, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMultipleConfig")
+	}
+
+	var r0 []Configuration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]Configuration, error)); ok {
+		return returnFunc(ctx, keys)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []Configuration); ok {
+		r0 = returnFunc(ctx, keys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Configuration)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_GetMultipleConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMultipleConfig'
+type MockQuerier_GetMultipleConfig_Call struct {
+	*mock.Call
+}
+
+// GetMultipleConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keys []string
+func (_e *MockQuerier_Expecter) GetMultipleConfig(ctx interface{}, keys interface{}) *MockQuerier_GetMultipleConfig_Call {
+	return &MockQuerier_GetMultipleConfig_Call{Call: _e.mock.On("GetMultipleConfig", ctx, keys)}
+}
+
+func (_c *MockQuerier_GetMultipleConfig_Call) Run(run func(ctx context.Context, keys []string)) *MockQuerier_GetMultipleConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetMultipleConfig_Call) Return(configurations []Configuration, err error) *MockQuerier_GetMultipleConfig_Call {
+	_c.Call.Return(configurations, err)
+	return _c
+}
+
+func (_c *MockQuerier_GetMultipleConfig_Call) RunAndReturn(run func(ctx context.Context, keys []string) ([]Configuration, error)) *MockQuerier_GetMultipleConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeries provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) GetSeries(ctx context.Context, id int64) (Series, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeries")
+	}
+
+	var r0 Series
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (Series, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) Series); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(Series)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_GetSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeries'
+type MockQuerier_GetSeries_Call struct {
+	*mock.Call
+}
+
+// GetSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockQuerier_Expecter) GetSeries(ctx interface{}, id interface{}) *MockQuerier_GetSeries_Call {
+	return &MockQuerier_GetSeries_Call{Call: _e.mock.On("GetSeries", ctx, id)}
+}
+
+func (_c *MockQuerier_GetSeries_Call) Run(run func(ctx context.Context, id int64)) *MockQuerier_GetSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetSeries_Call) Return(series Series, err error) *MockQuerier_GetSeries_Call {
+	_c.Call.Return(series, err)
+	return _c
+}
+
+func (_c *MockQuerier_GetSeries_Call) RunAndReturn(run func(ctx context.Context, id int64) (Series, error)) *MockQuerier_GetSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeriesAuthors provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) GetSeriesAuthors(ctx context.Context, seriesID int64) ([]Author, error) {
+	ret := _mock.Called(ctx, seriesID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeriesAuthors")
+	}
+
+	var r0 []Author
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) ([]Author, error)); ok {
+		return returnFunc(ctx, seriesID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) []Author); ok {
+		r0 = returnFunc(ctx, seriesID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Author)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, seriesID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_GetSeriesAuthors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeriesAuthors'
+type MockQuerier_GetSeriesAuthors_Call struct {
+	*mock.Call
+}
+
+// GetSeriesAuthors is a helper method to define mock.On call
+//   - ctx context.Context
+//   - seriesID int64
+func (_e *MockQuerier_Expecter) GetSeriesAuthors(ctx interface{}, seriesID interface{}) *MockQuerier_GetSeriesAuthors_Call {
+	return &MockQuerier_GetSeriesAuthors_Call{Call: _e.mock.On("GetSeriesAuthors", ctx, seriesID)}
+}
+
+func (_c *MockQuerier_GetSeriesAuthors_Call) Run(run func(ctx context.Context, seriesID int64)) *MockQuerier_GetSeriesAuthors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetSeriesAuthors_Call) Return(authors []Author, err error) *MockQuerier_GetSeriesAuthors_Call {
+	_c.Call.Return(authors, err)
+	return _c
+}
+
+func (_c *MockQuerier_GetSeriesAuthors_Call) RunAndReturn(run func(ctx context.Context, seriesID int64) ([]Author, error)) *MockQuerier_GetSeriesAuthors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeriesByGoodreadsID provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) GetSeriesByGoodreadsID(ctx context.Context, seriesID int64) (Series, error) {
+	ret := _mock.Called(ctx, seriesID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeriesByGoodreadsID")
+	}
+
+	var r0 Series
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (Series, error)); ok {
+		return returnFunc(ctx, seriesID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) Series); ok {
+		r0 = returnFunc(ctx, seriesID)
+	} else {
+		r0 = ret.Get(0).(Series)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, seriesID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_GetSeriesByGoodreadsID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeriesByGoodreadsID'
+type MockQuerier_GetSeriesByGoodreadsID_Call struct {
+	*mock.Call
+}
+
+// GetSeriesByGoodreadsID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - seriesID int64
+func (_e *MockQuerier_Expecter) GetSeriesByGoodreadsID(ctx interface{}, seriesID interface{}) *MockQuerier_GetSeriesByGoodreadsID_Call {
+	return &MockQuerier_GetSeriesByGoodreadsID_Call{Call: _e.mock.On("GetSeriesByGoodreadsID", ctx, seriesID)}
+}
+
+func (_c *MockQuerier_GetSeriesByGoodreadsID_Call) Run(run func(ctx context.Context, seriesID int64)) *MockQuerier_GetSeriesByGoodreadsID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetSeriesByGoodreadsID_Call) Return(series Series, err error) *MockQuerier_GetSeriesByGoodreadsID_Call {
+	_c.Call.Return(series, err)
+	return _c
+}
+
+func (_c *MockQuerier_GetSeriesByGoodreadsID_Call) RunAndReturn(run func(ctx context.Context, seriesID int64) (Series, error)) *MockQuerier_GetSeriesByGoodreadsID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeriesBySeriesID provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) GetSeriesBySeriesID(ctx context.Context, seriesID int64) (Series, error) {
+	ret := _mock.Called(ctx, seriesID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeriesBySeriesID")
+	}
+
+	var r0 Series
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (Series, error)); ok {
+		return returnFunc(ctx, seriesID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) Series); ok {
+		r0 = returnFunc(ctx, seriesID)
+	} else {
+		r0 = ret.Get(0).(Series)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, seriesID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_GetSeriesBySeriesID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeriesBySeriesID'
+type MockQuerier_GetSeriesBySeriesID_Call struct {
+	*mock.Call
+}
+
+// GetSeriesBySeriesID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - seriesID int64
+func (_e *MockQuerier_Expecter) GetSeriesBySeriesID(ctx interface{}, seriesID interface{}) *MockQuerier_GetSeriesBySeriesID_Call {
+	return &MockQuerier_GetSeriesBySeriesID_Call{Call: _e.mock.On("GetSeriesBySeriesID", ctx, seriesID)}
+}
+
+func (_c *MockQuerier_GetSeriesBySeriesID_Call) Run(run func(ctx context.Context, seriesID int64)) *MockQuerier_GetSeriesBySeriesID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetSeriesBySeriesID_Call) Return(series Series, err error) *MockQuerier_GetSeriesBySeriesID_Call {
+	_c.Call.Return(series, err)
+	return _c
+}
+
+func (_c *MockQuerier_GetSeriesBySeriesID_Call) RunAndReturn(run func(ctx context.Context, seriesID int64) (Series, error)) *MockQuerier_GetSeriesBySeriesID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeriesCompletionDistribution provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) GetSeriesCompletionDistribution(ctx context.Context) ([]GetSeriesCompletionDistributionRow, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeriesCompletionDistribution")
+	}
+
+	var r0 []GetSeriesCompletionDistributionRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]GetSeriesCompletionDistributionRow, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []GetSeriesCompletionDistributionRow); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetSeriesCompletionDistributionRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_GetSeriesCompletionDistribution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeriesCompletionDistribution'
+type MockQuerier_GetSeriesCompletionDistribution_Call struct {
+	*mock.Call
+}
+
+// GetSeriesCompletionDistribution is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) GetSeriesCompletionDistribution(ctx interface{}) *MockQuerier_GetSeriesCompletionDistribution_Call {
+	return &MockQuerier_GetSeriesCompletionDistribution_Call{Call: _e.mock.On("GetSeriesCompletionDistribution", ctx)}
+}
+
+func (_c *MockQuerier_GetSeriesCompletionDistribution_Call) Run(run func(ctx context.Context)) *MockQuerier_GetSeriesCompletionDistribution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetSeriesCompletionDistribution_Call) Return(getSeriesCompletionDistributionRows []GetSeriesCompletionDistributionRow, err error) *MockQuerier_GetSeriesCompletionDistribution_Call {
+	_c.Call.Return(getSeriesCompletionDistributionRows, err)
+	return _c
+}
+
+func (_c *MockQuerier_GetSeriesCompletionDistribution_Call) RunAndReturn(run func(ctx context.Context) ([]GetSeriesCompletionDistributionRow, error)) *MockQuerier_GetSeriesCompletionDistribution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeriesForAuthor provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) GetSeriesForAuthor(ctx context.Context, authorID int64) ([]GetSeriesForAuthorRow, error) {
+	ret := _mock.Called(ctx, authorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeriesForAuthor")
+	}
+
+	var r0 []GetSeriesForAuthorRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) ([]GetSeriesForAuthorRow, error)); ok {
+		return returnFunc(ctx, authorID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) []GetSeriesForAuthorRow); ok {
+		r0 = returnFunc(ctx, authorID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetSeriesForAuthorRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, authorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_GetSeriesForAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeriesForAuthor'
+type MockQuerier_GetSeriesForAuthor_Call struct {
+	*mock.Call
+}
+
+// GetSeriesForAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorID int64
+func (_e *MockQuerier_Expecter) GetSeriesForAuthor(ctx interface{}, authorID interface{}) *MockQuerier_GetSeriesForAuthor_Call {
+	return &MockQuerier_GetSeriesForAuthor_Call{Call: _e.mock.On("GetSeriesForAuthor", ctx, authorID)}
+}
+
+func (_c *MockQuerier_GetSeriesForAuthor_Call) Run(run func(ctx context.Context, authorID int64)) *MockQuerier_GetSeriesForAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetSeriesForAuthor_Call) Return(getSeriesForAuthorRows []GetSeriesForAuthorRow, err error) *MockQuerier_GetSeriesForAuthor_Call {
+	_c.Call.Return(getSeriesForAuthorRows, err)
+	return _c
+}
+
+func (_c *MockQuerier_GetSeriesForAuthor_Call) RunAndReturn(run func(ctx context.Context, authorID int64) ([]GetSeriesForAuthorRow, error)) *MockQuerier_GetSeriesForAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportBook provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ImportBook(ctx context.Context, arg ImportBookParams) (Book, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportBook")
+	}
+
+	var r0 Book
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ImportBookParams) (Book, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ImportBookParams) Book); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(Book)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ImportBookParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ImportBook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportBook'
+type MockQuerier_ImportBook_Call struct {
+	*mock.Call
+}
+
+// ImportBook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg ImportBookParams
+func (_e *MockQuerier_Expecter) ImportBook(ctx interface{}, arg interface{}) *MockQuerier_ImportBook_Call {
+	return &MockQuerier_ImportBook_Call{Call: _e.mock.On("ImportBook", ctx, arg)}
+}
+
+func (_c *MockQuerier_ImportBook_Call) Run(run func(ctx context.Context, arg ImportBookParams)) *MockQuerier_ImportBook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ImportBookParams
+		if args[1] != nil {
+			arg1 = args[1].(ImportBookParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ImportBook_Call) Return(book Book, err error) *MockQuerier_ImportBook_Call {
+	_c.Call.Return(book, err)
+	return _c
+}
+
+func (_c *MockQuerier_ImportBook_Call) RunAndReturn(run func(ctx context.Context, arg ImportBookParams) (Book, error)) *MockQuerier_ImportBook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportSeries provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ImportSeries(ctx context.Context, arg ImportSeriesParams) (Series, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportSeries")
+	}
+
+	var r0 Series
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ImportSeriesParams) (Series, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ImportSeriesParams) Series); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(Series)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ImportSeriesParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ImportSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportSeries'
+type MockQuerier_ImportSeries_Call struct {
+	*mock.Call
+}
+
+// ImportSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg ImportSeriesParams
+func (_e *MockQuerier_Expecter) ImportSeries(ctx interface{}, arg interface{}) *MockQuerier_ImportSeries_Call {
+	return &MockQuerier_ImportSeries_Call{Call: _e.mock.On("ImportSeries", ctx, arg)}
+}
+
+func (_c *MockQuerier_ImportSeries_Call) Run(run func(ctx context.Context, arg ImportSeriesParams)) *MockQuerier_ImportSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ImportSeriesParams
+		if args[1] != nil {
+			arg1 = args[1].(ImportSeriesParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ImportSeries_Call) Return(series Series, err error) *MockQuerier_ImportSeries_Call {
+	_c.Call.Return(series, err)
+	return _c
+}
+
+func (_c *MockQuerier_ImportSeries_Call) RunAndReturn(run func(ctx context.Context, arg ImportSeriesParams) (Series, error)) *MockQuerier_ImportSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InterruptCompletionRuns provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) InterruptCompletionRuns(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InterruptCompletionRuns")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockQuerier_InterruptCompletionRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InterruptCompletionRuns'
+type MockQuerier_InterruptCompletionRuns_Call struct {
+	*mock.Call
+}
+
+// InterruptCompletionRuns is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) InterruptCompletionRuns(ctx interface{}) *MockQuerier_InterruptCompletionRuns_Call {
+	return &MockQuerier_InterruptCompletionRuns_Call{Call: _e.mock.On("InterruptCompletionRuns", ctx)}
+}
+
+func (_c *MockQuerier_InterruptCompletionRuns_Call) Run(run func(ctx context.Context)) *MockQuerier_InterruptCompletionRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_InterruptCompletionRuns_Call) Return(err error) *MockQuerier_InterruptCompletionRuns_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockQuerier_InterruptCompletionRuns_Call) RunAndReturn(run func(ctx context.Context) error) *MockQuerier_InterruptCompletionRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LinkBookAuthor provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) LinkBookAuthor(ctx context.Context, arg LinkBookAuthorParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkBookAuthor")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, LinkBookAuthorParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockQuerier_LinkBookAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkBookAuthor'
+type MockQuerier_LinkBookAuthor_Call struct {
+	*mock.Call
+}
+
+// LinkBookAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg LinkBookAuthorParams
+func (_e *MockQuerier_Expecter) LinkBookAuthor(ctx interface{}, arg interface{}) *MockQuerier_LinkBookAuthor_Call {
+	return &MockQuerier_LinkBookAuthor_Call{Call: _e.mock.On("LinkBookAuthor", ctx, arg)}
+}
+
+func (_c *MockQuerier_LinkBookAuthor_Call) Run(run func(ctx context.Context, arg LinkBookAuthorParams)) *MockQuerier_LinkBookAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 LinkBookAuthorParams
+		if args[1] != nil {
+			arg1 = args[1].(LinkBookAuthorParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_LinkBookAuthor_Call) Return(err error) *MockQuerier_LinkBookAuthor_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockQuerier_LinkBookAuthor_Call) RunAndReturn(run func(ctx context.Context, arg LinkBookAuthorParams) error) *MockQuerier_LinkBookAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LinkSeriesAuthor provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) LinkSeriesAuthor(ctx context.Context, arg LinkSeriesAuthorParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkSeriesAuthor")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, LinkSeriesAuthorParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockQuerier_LinkSeriesAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkSeriesAuthor'
+type MockQuerier_LinkSeriesAuthor_Call struct {
+	*mock.Call
+}
+
+// LinkSeriesAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg LinkSeriesAuthorParams
+func (_e *MockQuerier_Expecter) LinkSeriesAuthor(ctx interface{}, arg interface{}) *MockQuerier_LinkSeriesAuthor_Call {
+	return &MockQuerier_LinkSeriesAuthor_Call{Call: _e.mock.On("LinkSeriesAuthor", ctx, arg)}
+}
+
+func (_c *MockQuerier_LinkSeriesAuthor_Call) Run(run func(ctx context.Context, arg LinkSeriesAuthorParams)) *MockQuerier_LinkSeriesAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 LinkSeriesAuthorParams
+		if args[1] != nil {
+			arg1 = args[1].(LinkSeriesAuthorParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_LinkSeriesAuthor_Call) Return(err error) *MockQuerier_LinkSeriesAuthor_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockQuerier_LinkSeriesAuthor_Call) RunAndReturn(run func(ctx context.Context, arg LinkSeriesAuthorParams) error) *MockQuerier_LinkSeriesAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAllAuthors provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListAllAuthors(ctx context.Context) ([]Author, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAllAuthors")
+	}
+
+	var r0 []Author
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]Author, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []Author); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Author)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListAllAuthors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAllAuthors'
+type MockQuerier_ListAllAuthors_Call struct {
+	*mock.Call
+}
+
+// ListAllAuthors is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) ListAllAuthors(ctx interface{}) *MockQuerier_ListAllAuthors_Call {
+	return &MockQuerier_ListAllAuthors_Call{Call: _e.mock.On("ListAllAuthors", ctx)}
+}
+
+func (_c *MockQuerier_ListAllAuthors_Call) Run(run func(ctx context.Context)) *MockQuerier_ListAllAuthors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListAllAuthors_Call) Return(authors []Author, err error) *MockQuerier_ListAllAuthors_Call {
+	_c.Call.Return(authors, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListAllAuthors_Call) RunAndReturn(run func(ctx context.Context) ([]Author, error)) *MockQuerier_ListAllAuthors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAllBooks provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListAllBooks(ctx context.Context) ([]Book, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAllBooks")
+	}
+
+	var r0 []Book
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]Book, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []Book); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Book)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListAllBooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAllBooks'
+type MockQuerier_ListAllBooks_Call struct {
+	*mock.Call
+}
+
+// ListAllBooks is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) ListAllBooks(ctx interface{}) *MockQuerier_ListAllBooks_Call {
+	return &MockQuerier_ListAllBooks_Call{Call: _e.mock.On("ListAllBooks", ctx)}
+}
+
+func (_c *MockQuerier_ListAllBooks_Call) Run(run func(ctx context.Context)) *MockQuerier_ListAllBooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListAllBooks_Call) Return(books []Book, err error) *MockQuerier_ListAllBooks_Call {
+	_c.Call.Return(books, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListAllBooks_Call) RunAndReturn(run func(ctx context.Context) ([]Book, error)) *MockQuerier_ListAllBooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAllSeries provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListAllSeries(ctx context.Context) ([]Series, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAllSeries")
+	}
+
+	var r0 []Series
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]Series, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []Series); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Series)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListAllSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAllSeries'
+type MockQuerier_ListAllSeries_Call struct {
+	*mock.Call
+}
+
+// ListAllSeries is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) ListAllSeries(ctx interface{}) *MockQuerier_ListAllSeries_Call {
+	return &MockQuerier_ListAllSeries_Call{Call: _e.mock.On("ListAllSeries", ctx)}
+}
+
+func (_c *MockQuerier_ListAllSeries_Call) Run(run func(ctx context.Context)) *MockQuerier_ListAllSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListAllSeries_Call) Return(seriess []Series, err error) *MockQuerier_ListAllSeries_Call {
+	_c.Call.Return(seriess, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListAllSeries_Call) RunAndReturn(run func(ctx context.Context) ([]Series, error)) *MockQuerier_ListAllSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAuthorsWithBookStats provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListAuthorsWithBookStats(ctx context.Context, arg ListAuthorsWithBookStatsParams) ([]ListAuthorsWithBookStatsRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuthorsWithBookStats")
+	}
+
+	var r0 []ListAuthorsWithBookStatsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListAuthorsWithBookStatsParams) ([]ListAuthorsWithBookStatsRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListAuthorsWithBookStatsParams) []ListAuthorsWithBookStatsRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ListAuthorsWithBookStatsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ListAuthorsWithBookStatsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListAuthorsWithBookStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAuthorsWithBookStats'
+type MockQuerier_ListAuthorsWithBookStats_Call struct {
+	*mock.Call
+}
+
+// ListAuthorsWithBookStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg ListAuthorsWithBookStatsParams
+func (_e *MockQuerier_Expecter) ListAuthorsWithBookStats(ctx interface{}, arg interface{}) *MockQuerier_ListAuthorsWithBookStats_Call {
+	return &MockQuerier_ListAuthorsWithBookStats_Call{Call: _e.mock.On("ListAuthorsWithBookStats", ctx, arg)}
+}
+
+func (_c *MockQuerier_ListAuthorsWithBookStats_Call) Run(run func(ctx context.Context, arg ListAuthorsWithBookStatsParams)) *MockQuerier_ListAuthorsWithBookStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ListAuthorsWithBookStatsParams
+		if args[1] != nil {
+			arg1 = args[1].(ListAuthorsWithBookStatsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListAuthorsWithBookStats_Call) Return(listAuthorsWithBookStatsRows []ListAuthorsWithBookStatsRow, err error) *MockQuerier_ListAuthorsWithBookStats_Call {
+	_c.Call.Return(listAuthorsWithBookStatsRows, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListAuthorsWithBookStats_Call) RunAndReturn(run func(ctx context.Context, arg ListAuthorsWithBookStatsParams) ([]ListAuthorsWithBookStatsRow, error)) *MockQuerier_ListAuthorsWithBookStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAuthorsWithGoodreadsID provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListAuthorsWithGoodreadsID(ctx context.Context) ([]Author, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuthorsWithGoodreadsID")
+	}
+
+	var r0 []Author
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]Author, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []Author); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Author)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListAuthorsWithGoodreadsID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAuthorsWithGoodreadsID'
+type MockQuerier_ListAuthorsWithGoodreadsID_Call struct {
+	*mock.Call
+}
+
+// ListAuthorsWithGoodreadsID is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) ListAuthorsWithGoodreadsID(ctx interface{}) *MockQuerier_ListAuthorsWithGoodreadsID_Call {
+	return &MockQuerier_ListAuthorsWithGoodreadsID_Call{Call: _e.mock.On("ListAuthorsWithGoodreadsID", ctx)}
+}
+
+func (_c *MockQuerier_ListAuthorsWithGoodreadsID_Call) Run(run func(ctx context.Context)) *MockQuerier_ListAuthorsWithGoodreadsID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListAuthorsWithGoodreadsID_Call) Return(authors []Author, err error) *MockQuerier_ListAuthorsWithGoodreadsID_Call {
+	_c.Call.Return(authors, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListAuthorsWithGoodreadsID_Call) RunAndReturn(run func(ctx context.Context) ([]Author, error)) *MockQuerier_ListAuthorsWithGoodreadsID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBookAuthorNames provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListBookAuthorNames(ctx context.Context) ([]ListBookAuthorNamesRow, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBookAuthorNames")
+	}
+
+	var r0 []ListBookAuthorNamesRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]ListBookAuthorNamesRow, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []ListBookAuthorNamesRow); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ListBookAuthorNamesRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListBookAuthorNames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBookAuthorNames'
+type MockQuerier_ListBookAuthorNames_Call struct {
+	*mock.Call
+}
+
+// ListBookAuthorNames is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) ListBookAuthorNames(ctx interface{}) *MockQuerier_ListBookAuthorNames_Call {
+	return &MockQuerier_ListBookAuthorNames_Call{Call: _e.mock.On("ListBookAuthorNames", ctx)}
+}
+
+func (_c *MockQuerier_ListBookAuthorNames_Call) Run(run func(ctx context.Context)) *MockQuerier_ListBookAuthorNames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListBookAuthorNames_Call) Return(listBookAuthorNamesRows []ListBookAuthorNamesRow, err error) *MockQuerier_ListBookAuthorNames_Call {
+	_c.Call.Return(listBookAuthorNamesRows, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListBookAuthorNames_Call) RunAndReturn(run func(ctx context.Context) ([]ListBookAuthorNamesRow, error)) *MockQuerier_ListBookAuthorNames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBooks provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListBooks(ctx context.Context, arg ListBooksParams) ([]Book, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBooks")
+	}
+
+	var r0 []Book
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListBooksParams) ([]Book, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListBooksParams) []Book); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Book)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ListBooksParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListBooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBooks'
+type MockQuerier_ListBooks_Call struct {
+	*mock.Call
+}
+
+// ListBooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg ListBooksParams
+func (_e *MockQuerier_Expecter) ListBooks(ctx interface{}, arg interface{}) *MockQuerier_ListBooks_Call {
+	return &MockQuerier_ListBooks_Call{Call: _e.mock.On("ListBooks", ctx, arg)}
+}
+
+func (_c *MockQuerier_ListBooks_Call) Run(run func(ctx context.Context, arg ListBooksParams)) *MockQuerier_ListBooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ListBooksParams
+		if args[1] != nil {
+			arg1 = args[1].(ListBooksParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListBooks_Call) Return(books []Book, err error) *MockQuerier_ListBooks_Call {
+	_c.Call.Return(books, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListBooks_Call) RunAndReturn(run func(ctx context.Context, arg ListBooksParams) ([]Book, error)) *MockQuerier_ListBooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBooksWithoutGoodreadsID provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListBooksWithoutGoodreadsID(ctx context.Context, arg ListBooksWithoutGoodreadsIDParams) ([]Book, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBooksWithoutGoodreadsID")
+	}
+
+	var r0 []Book
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListBooksWithoutGoodreadsIDParams) ([]Book, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListBooksWithoutGoodreadsIDParams) []Book); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Book)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ListBooksWithoutGoodreadsIDParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListBooksWithoutGoodreadsID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBooksWithoutGoodreadsID'
+type MockQuerier_ListBooksWithoutGoodreadsID_Call struct {
+	*mock.Call
+}
+
+// ListBooksWithoutGoodreadsID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg ListBooksWithoutGoodreadsIDParams
+func (_e *MockQuerier_Expecter) ListBooksWithoutGoodreadsID(ctx interface{}, arg interface{}) *MockQuerier_ListBooksWithoutGoodreadsID_Call {
+	return &MockQuerier_ListBooksWithoutGoodreadsID_Call{Call: _e.mock.On("ListBooksWithoutGoodreadsID", ctx, arg)}
+}
+
+func (_c *MockQuerier_ListBooksWithoutGoodreadsID_Call) Run(run func(ctx context.Context, arg ListBooksWithoutGoodreadsIDParams)) *MockQuerier_ListBooksWithoutGoodreadsID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ListBooksWithoutGoodreadsIDParams
+		if args[1] != nil {
+			arg1 = args[1].(ListBooksWithoutGoodreadsIDParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListBooksWithoutGoodreadsID_Call) Return(books []Book, err error) *MockQuerier_ListBooksWithoutGoodreadsID_Call {
+	_c.Call.Return(books, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListBooksWithoutGoodreadsID_Call) RunAndReturn(run func(ctx context.Context, arg ListBooksWithoutGoodreadsIDParams) ([]Book, error)) *MockQuerier_ListBooksWithoutGoodreadsID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCompletionRunSeries provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListCompletionRunSeries(ctx context.Context, runID int64) ([]ListCompletionRunSeriesRow, error) {
+	ret := _mock.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCompletionRunSeries")
+	}
+
+	var r0 []ListCompletionRunSeriesRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) ([]ListCompletionRunSeriesRow, error)); ok {
+		return returnFunc(ctx, runID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) []ListCompletionRunSeriesRow); ok {
+		r0 = returnFunc(ctx, runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ListCompletionRunSeriesRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListCompletionRunSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCompletionRunSeries'
+type MockQuerier_ListCompletionRunSeries_Call struct {
+	*mock.Call
+}
+
+// ListCompletionRunSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID int64
+func (_e *MockQuerier_Expecter) ListCompletionRunSeries(ctx interface{}, runID interface{}) *MockQuerier_ListCompletionRunSeries_Call {
+	return &MockQuerier_ListCompletionRunSeries_Call{Call: _e.mock.On("ListCompletionRunSeries", ctx, runID)}
+}
+
+func (_c *MockQuerier_ListCompletionRunSeries_Call) Run(run func(ctx context.Context, runID int64)) *MockQuerier_ListCompletionRunSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListCompletionRunSeries_Call) Return(listCompletionRunSeriesRows []ListCompletionRunSeriesRow, err error) *MockQuerier_ListCompletionRunSeries_Call {
+	_c.Call.Return(listCompletionRunSeriesRows, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListCompletionRunSeries_Call) RunAndReturn(run func(ctx context.Context, runID int64) ([]ListCompletionRunSeriesRow, error)) *MockQuerier_ListCompletionRunSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCompletionRuns provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListCompletionRuns(ctx context.Context, limit int64) ([]ListCompletionRunsRow, error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCompletionRuns")
+	}
+
+	var r0 []ListCompletionRunsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) ([]ListCompletionRunsRow, error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) []ListCompletionRunsRow); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ListCompletionRunsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListCompletionRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCompletionRuns'
+type MockQuerier_ListCompletionRuns_Call struct {
+	*mock.Call
+}
+
+// ListCompletionRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int64
+func (_e *MockQuerier_Expecter) ListCompletionRuns(ctx interface{}, limit interface{}) *MockQuerier_ListCompletionRuns_Call {
+	return &MockQuerier_ListCompletionRuns_Call{Call: _e.mock.On("ListCompletionRuns", ctx, limit)}
+}
+
+func (_c *MockQuerier_ListCompletionRuns_Call) Run(run func(ctx context.Context, limit int64)) *MockQuerier_ListCompletionRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListCompletionRuns_Call) Return(listCompletionRunsRows []ListCompletionRunsRow, err error) *MockQuerier_ListCompletionRuns_Call {
+	_c.Call.Return(listCompletionRunsRows, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListCompletionRuns_Call) RunAndReturn(run func(ctx context.Context, limit int64) ([]ListCompletionRunsRow, error)) *MockQuerier_ListCompletionRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListConfig provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListConfig(ctx context.Context) ([]Configuration, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConfig")
+	}
+
+	var r0 []Configuration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]Configuration, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []Configuration); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Configuration)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConfig'
+type MockQuerier_ListConfig_Call struct {
+	*mock.Call
+}
+
+// ListConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) ListConfig(ctx interface{}) *MockQuerier_ListConfig_Call {
+	return &MockQuerier_ListConfig_Call{Call: _e.mock.On("ListConfig", ctx)}
+}
+
+func (_c *MockQuerier_ListConfig_Call) Run(run func(ctx context.Context)) *MockQuerier_ListConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListConfig_Call) Return(configurations []Configuration, err error) *MockQuerier_ListConfig_Call {
+	_c.Call.Return(configurations, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListConfig_Call) RunAndReturn(run func(ctx context.Context) ([]Configuration, error)) *MockQuerier_ListConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListJobs provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListJobs(ctx context.Context, arg ListJobsParams) ([]Job, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListJobs")
+	}
+
+	var r0 []Job
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListJobsParams) ([]Job, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListJobsParams) []Job); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Job)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ListJobsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListJobs'
+type MockQuerier_ListJobs_Call struct {
+	*mock.Call
+}
+
+// ListJobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg ListJobsParams
+func (_e *MockQuerier_Expecter) ListJobs(ctx interface{}, arg interface{}) *MockQuerier_ListJobs_Call {
+	return &MockQuerier_ListJobs_Call{Call: _e.mock.On("ListJobs", ctx, arg)}
+}
+
+func (_c *MockQuerier_ListJobs_Call) Run(run func(ctx context.Context, arg ListJobsParams)) *MockQuerier_ListJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ListJobsParams
+		if args[1] != nil {
+			arg1 = args[1].(ListJobsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListJobs_Call) Return(jobs []Job, err error) *MockQuerier_ListJobs_Call {
+	_c.Call.Return(jobs, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListJobs_Call) RunAndReturn(run func(ctx context.Context, arg ListJobsParams) ([]Job, error)) *MockQuerier_ListJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListLibrarySnapshots provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListLibrarySnapshots(ctx context.Context, takenOn string) ([]LibrarySnapshot, error) {
+	ret := _mock.Called(ctx, takenOn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLibrarySnapshots")
+	}
+
+	var r0 []LibrarySnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]LibrarySnapshot, error)); ok {
+		return returnFunc(ctx, takenOn)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []LibrarySnapshot); ok {
+		r0 = returnFunc(ctx, takenOn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]LibrarySnapshot)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, takenOn)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListLibrarySnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLibrarySnapshots'
+type MockQuerier_ListLibrarySnapshots_Call struct {
+	*mock.Call
+}
+
+// ListLibrarySnapshots is a helper method to define mock.On call
+//   - ctx context.Context
+//   - takenOn string
+func (_e *MockQuerier_Expecter) ListLibrarySnapshots(ctx interface{}, takenOn interface{}) *MockQuerier_ListLibrarySnapshots_Call {
+	return &MockQuerier_ListLibrarySnapshots_Call{Call: _e.mock.On("ListLibrarySnapshots", ctx, takenOn)}
+}
+
+func (_c *MockQuerier_ListLibrarySnapshots_Call) Run(run func(ctx context.Context, takenOn string)) *MockQuerier_ListLibrarySnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListLibrarySnapshots_Call) Return(librarySnapshots []LibrarySnapshot, err error) *MockQuerier_ListLibrarySnapshots_Call {
+	_c.Call.Return(librarySnapshots, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListLibrarySnapshots_Call) RunAndReturn(run func(ctx context.Context, takenOn string) ([]LibrarySnapshot, error)) *MockQuerier_ListLibrarySnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMatchReviews provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListMatchReviews(ctx context.Context, status string) ([]ListMatchReviewsRow, error) {
+	ret := _mock.Called(ctx, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMatchReviews")
+	}
+
+	var r0 []ListMatchReviewsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]ListMatchReviewsRow, error)); ok {
+		return returnFunc(ctx, status)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []ListMatchReviewsRow); ok {
+		r0 = returnFunc(ctx, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ListMatchReviewsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListMatchReviews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMatchReviews'
+type MockQuerier_ListMatchReviews_Call struct {
+	*mock.Call
+}
+
+// ListMatchReviews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status string
+func (_e *MockQuerier_Expecter) ListMatchReviews(ctx interface{}, status interface{}) *MockQuerier_ListMatchReviews_Call {
+	return &MockQuerier_ListMatchReviews_Call{Call: _e.mock.On("ListMatchReviews", ctx, status)}
+}
+
+func (_c *MockQuerier_ListMatchReviews_Call) Run(run func(ctx context.Context, status string)) *MockQuerier_ListMatchReviews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListMatchReviews_Call) Return(listMatchReviewsRows []ListMatchReviewsRow, err error) *MockQuerier_ListMatchReviews_Call {
+	_c.Call.Return(listMatchReviewsRows, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListMatchReviews_Call) RunAndReturn(run func(ctx context.Context, status string) ([]ListMatchReviewsRow, error)) *MockQuerier_ListMatchReviews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMatchReviewsForExport provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListMatchReviewsForExport(ctx context.Context) ([]ListMatchReviewsForExportRow, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMatchReviewsForExport")
+	}
+
+	var r0 []ListMatchReviewsForExportRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]ListMatchReviewsForExportRow, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []ListMatchReviewsForExportRow); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ListMatchReviewsForExportRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListMatchReviewsForExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMatchReviewsForExport'
+type MockQuerier_ListMatchReviewsForExport_Call struct {
+	*mock.Call
+}
+
+// ListMatchReviewsForExport is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) ListMatchReviewsForExport(ctx interface{}) *MockQuerier_ListMatchReviewsForExport_Call {
+	return &MockQuerier_ListMatchReviewsForExport_Call{Call: _e.mock.On("ListMatchReviewsForExport", ctx)}
+}
+
+func (_c *MockQuerier_ListMatchReviewsForExport_Call) Run(run func(ctx context.Context)) *MockQuerier_ListMatchReviewsForExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListMatchReviewsForExport_Call) Return(listMatchReviewsForExportRows []ListMatchReviewsForExportRow, err error) *MockQuerier_ListMatchReviewsForExport_Call {
+	_c.Call.Return(listMatchReviewsForExportRows, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListMatchReviewsForExport_Call) RunAndReturn(run func(ctx context.Context) ([]ListMatchReviewsForExportRow, error)) *MockQuerier_ListMatchReviewsForExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMatchReviewsForSeries provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListMatchReviewsForSeries(ctx context.Context, seriesID int64) ([]MatchReview, error) {
+	ret := _mock.Called(ctx, seriesID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMatchReviewsForSeries")
+	}
+
+	var r0 []MatchReview
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) ([]MatchReview, error)); ok {
+		return returnFunc(ctx, seriesID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) []MatchReview); ok {
+		r0 = returnFunc(ctx, seriesID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]MatchReview)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, seriesID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListMatchReviewsForSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMatchReviewsForSeries'
+type MockQuerier_ListMatchReviewsForSeries_Call struct {
+	*mock.Call
+}
+
+// ListMatchReviewsForSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - seriesID int64
+func (_e *MockQuerier_Expecter) ListMatchReviewsForSeries(ctx interface{}, seriesID interface{}) *MockQuerier_ListMatchReviewsForSeries_Call {
+	return &MockQuerier_ListMatchReviewsForSeries_Call{Call: _e.mock.On("ListMatchReviewsForSeries", ctx, seriesID)}
+}
+
+func (_c *MockQuerier_ListMatchReviewsForSeries_Call) Run(run func(ctx context.Context, seriesID int64)) *MockQuerier_ListMatchReviewsForSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListMatchReviewsForSeries_Call) Return(matchReviews []MatchReview, err error) *MockQuerier_ListMatchReviewsForSeries_Call {
+	_c.Call.Return(matchReviews, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListMatchReviewsForSeries_Call) RunAndReturn(run func(ctx context.Context, seriesID int64) ([]MatchReview, error)) *MockQuerier_ListMatchReviewsForSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMissingBooksForExport provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListMissingBooksForExport(ctx context.Context, arg ListMissingBooksForExportParams) ([]ListMissingBooksForExportRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMissingBooksForExport")
+	}
+
+	var r0 []ListMissingBooksForExportRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListMissingBooksForExportParams) ([]ListMissingBooksForExportRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListMissingBooksForExportParams) []ListMissingBooksForExportRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ListMissingBooksForExportRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ListMissingBooksForExportParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListMissingBooksForExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMissingBooksForExport'
+type MockQuerier_ListMissingBooksForExport_Call struct {
+	*mock.Call
+}
+
+// ListMissingBooksForExport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg ListMissingBooksForExportParams
+func (_e *MockQuerier_Expecter) ListMissingBooksForExport(ctx interface{}, arg interface{}) *MockQuerier_ListMissingBooksForExport_Call {
+	return &MockQuerier_ListMissingBooksForExport_Call{Call: _e.mock.On("ListMissingBooksForExport", ctx, arg)}
+}
+
+func (_c *MockQuerier_ListMissingBooksForExport_Call) Run(run func(ctx context.Context, arg ListMissingBooksForExportParams)) *MockQuerier_ListMissingBooksForExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ListMissingBooksForExportParams
+		if args[1] != nil {
+			arg1 = args[1].(ListMissingBooksForExportParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListMissingBooksForExport_Call) Return(listMissingBooksForExportRows []ListMissingBooksForExportRow, err error) *MockQuerier_ListMissingBooksForExport_Call {
+	_c.Call.Return(listMissingBooksForExportRows, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListMissingBooksForExport_Call) RunAndReturn(run func(ctx context.Context, arg ListMissingBooksForExportParams) ([]ListMissingBooksForExportRow, error)) *MockQuerier_ListMissingBooksForExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMissingBooksToEnrich provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListMissingBooksToEnrich(ctx context.Context, arg ListMissingBooksToEnrichParams) ([]Book, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMissingBooksToEnrich")
+	}
+
+	var r0 []Book
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListMissingBooksToEnrichParams) ([]Book, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListMissingBooksToEnrichParams) []Book); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Book)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ListMissingBooksToEnrichParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListMissingBooksToEnrich_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMissingBooksToEnrich'
+type MockQuerier_ListMissingBooksToEnrich_Call struct {
+	*mock.Call
+}
+
+// ListMissingBooksToEnrich is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg ListMissingBooksToEnrichParams
+func (_e *MockQuerier_Expecter) ListMissingBooksToEnrich(ctx interface{}, arg interface{}) *MockQuerier_ListMissingBooksToEnrich_Call {
+	return &MockQuerier_ListMissingBooksToEnrich_Call{Call: _e.mock.On("ListMissingBooksToEnrich", ctx, arg)}
+}
+
+func (_c *MockQuerier_ListMissingBooksToEnrich_Call) Run(run func(ctx context.Context, arg ListMissingBooksToEnrichParams)) *MockQuerier_ListMissingBooksToEnrich_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ListMissingBooksToEnrichParams
+		if args[1] != nil {
+			arg1 = args[1].(ListMissingBooksToEnrichParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListMissingBooksToEnrich_Call) Return(books []Book, err error) *MockQuerier_ListMissingBooksToEnrich_Call {
+	_c.Call.Return(books, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListMissingBooksToEnrich_Call) RunAndReturn(run func(ctx context.Context, arg ListMissingBooksToEnrichParams) ([]Book, error)) *MockQuerier_ListMissingBooksToEnrich_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPendingCompletionRunSeries provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListPendingCompletionRunSeries(ctx context.Context, runID int64) ([]int64, error) {
+	ret := _mock.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPendingCompletionRunSeries")
+	}
+
+	var r0 []int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) ([]int64, error)); ok {
+		return returnFunc(ctx, runID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) []int64); ok {
+		r0 = returnFunc(ctx, runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListPendingCompletionRunSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPendingCompletionRunSeries'
+type MockQuerier_ListPendingCompletionRunSeries_Call struct {
+	*mock.Call
+}
+
+// ListPendingCompletionRunSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID int64
+func (_e *MockQuerier_Expecter) ListPendingCompletionRunSeries(ctx interface{}, runID interface{}) *MockQuerier_ListPendingCompletionRunSeries_Call {
+	return &MockQuerier_ListPendingCompletionRunSeries_Call{Call: _e.mock.On("ListPendingCompletionRunSeries", ctx, runID)}
+}
+
+func (_c *MockQuerier_ListPendingCompletionRunSeries_Call) Run(run func(ctx context.Context, runID int64)) *MockQuerier_ListPendingCompletionRunSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListPendingCompletionRunSeries_Call) Return(ns []int64, err error) *MockQuerier_ListPendingCompletionRunSeries_Call {
+	_c.Call.Return(ns, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListPendingCompletionRunSeries_Call) RunAndReturn(run func(ctx context.Context, runID int64) ([]int64, error)) *MockQuerier_ListPendingCompletionRunSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRecentWorkIDLookups provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListRecentWorkIDLookups(ctx context.Context, arg ListRecentWorkIDLookupsParams) ([]int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRecentWorkIDLookups")
+	}
+
+	var r0 []int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListRecentWorkIDLookupsParams) ([]int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListRecentWorkIDLookupsParams) []int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ListRecentWorkIDLookupsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListRecentWorkIDLookups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRecentWorkIDLookups'
+type MockQuerier_ListRecentWorkIDLookups_Call struct {
+	*mock.Call
+}
+
+// ListRecentWorkIDLookups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg ListRecentWorkIDLookupsParams
+func (_e *MockQuerier_Expecter) ListRecentWorkIDLookups(ctx interface{}, arg interface{}) *MockQuerier_ListRecentWorkIDLookups_Call {
+	return &MockQuerier_ListRecentWorkIDLookups_Call{Call: _e.mock.On("ListRecentWorkIDLookups", ctx, arg)}
+}
+
+func (_c *MockQuerier_ListRecentWorkIDLookups_Call) Run(run func(ctx context.Context, arg ListRecentWorkIDLookupsParams)) *MockQuerier_ListRecentWorkIDLookups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ListRecentWorkIDLookupsParams
+		if args[1] != nil {
+			arg1 = args[1].(ListRecentWorkIDLookupsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListRecentWorkIDLookups_Call) Return(ns []int64, err error) *MockQuerier_ListRecentWorkIDLookups_Call {
+	_c.Call.Return(ns, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListRecentWorkIDLookups_Call) RunAndReturn(run func(ctx context.Context, arg ListRecentWorkIDLookupsParams) ([]int64, error)) *MockQuerier_ListRecentWorkIDLookups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRecentlyDiscoveredBooks provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListRecentlyDiscoveredBooks(ctx context.Context, limit int64) ([]Book, error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRecentlyDiscoveredBooks")
+	}
+
+	var r0 []Book
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) ([]Book, error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) []Book); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Book)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListRecentlyDiscoveredBooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRecentlyDiscoveredBooks'
+type MockQuerier_ListRecentlyDiscoveredBooks_Call struct {
+	*mock.Call
+}
+
+// ListRecentlyDiscoveredBooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int64
+func (_e *MockQuerier_Expecter) ListRecentlyDiscoveredBooks(ctx interface{}, limit interface{}) *MockQuerier_ListRecentlyDiscoveredBooks_Call {
+	return &MockQuerier_ListRecentlyDiscoveredBooks_Call{Call: _e.mock.On("ListRecentlyDiscoveredBooks", ctx, limit)}
+}
+
+func (_c *MockQuerier_ListRecentlyDiscoveredBooks_Call) Run(run func(ctx context.Context, limit int64)) *MockQuerier_ListRecentlyDiscoveredBooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListRecentlyDiscoveredBooks_Call) Return(books []Book, err error) *MockQuerier_ListRecentlyDiscoveredBooks_Call {
+	_c.Call.Return(books, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListRecentlyDiscoveredBooks_Call) RunAndReturn(run func(ctx context.Context, limit int64) ([]Book, error)) *MockQuerier_ListRecentlyDiscoveredBooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRecentlyDiscoveredUpcomingBooks provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListRecentlyDiscoveredUpcomingBooks(ctx context.Context, limit int64) ([]Book, error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRecentlyDiscoveredUpcomingBooks")
+	}
+
+	var r0 []Book
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) ([]Book, error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) []Book); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Book)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListRecentlyDiscoveredUpcomingBooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRecentlyDiscoveredUpcomingBooks'
+type MockQuerier_ListRecentlyDiscoveredUpcomingBooks_Call struct {
+	*mock.Call
+}
+
+// ListRecentlyDiscoveredUpcomingBooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int64
+func (_e *MockQuerier_Expecter) ListRecentlyDiscoveredUpcomingBooks(ctx interface{}, limit interface{}) *MockQuerier_ListRecentlyDiscoveredUpcomingBooks_Call {
+	return &MockQuerier_ListRecentlyDiscoveredUpcomingBooks_Call{Call: _e.mock.On("ListRecentlyDiscoveredUpcomingBooks", ctx, limit)}
+}
+
+func (_c *MockQuerier_ListRecentlyDiscoveredUpcomingBooks_Call) Run(run func(ctx context.Context, limit int64)) *MockQuerier_ListRecentlyDiscoveredUpcomingBooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListRecentlyDiscoveredUpcomingBooks_Call) Return(books []Book, err error) *MockQuerier_ListRecentlyDiscoveredUpcomingBooks_Call {
+	_c.Call.Return(books, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListRecentlyDiscoveredUpcomingBooks_Call) RunAndReturn(run func(ctx context.Context, limit int64) ([]Book, error)) *MockQuerier_ListRecentlyDiscoveredUpcomingBooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSeries provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListSeries(ctx context.Context, arg ListSeriesParams) ([]Series, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSeries")
+	}
+
+	var r0 []Series
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListSeriesParams) ([]Series, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListSeriesParams) []Series); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Series)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ListSeriesParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSeries'
+type MockQuerier_ListSeries_Call struct {
+	*mock.Call
+}
+
+// ListSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg ListSeriesParams
+func (_e *MockQuerier_Expecter) ListSeries(ctx interface{}, arg interface{}) *MockQuerier_ListSeries_Call {
+	return &MockQuerier_ListSeries_Call{Call: _e.mock.On("ListSeries", ctx, arg)}
+}
+
+func (_c *MockQuerier_ListSeries_Call) Run(run func(ctx context.Context, arg ListSeriesParams)) *MockQuerier_ListSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ListSeriesParams
+		if args[1] != nil {
+			arg1 = args[1].(ListSeriesParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListSeries_Call) Return(seriess []Series, err error) *MockQuerier_ListSeries_Call {
+	_c.Call.Return(seriess, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListSeries_Call) RunAndReturn(run func(ctx context.Context, arg ListSeriesParams) ([]Series, error)) *MockQuerier_ListSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSeriesAuthorNames provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListSeriesAuthorNames(ctx context.Context) ([]ListSeriesAuthorNamesRow, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSeriesAuthorNames")
+	}
+
+	var r0 []ListSeriesAuthorNamesRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]ListSeriesAuthorNamesRow, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []ListSeriesAuthorNamesRow); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ListSeriesAuthorNamesRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListSeriesAuthorNames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSeriesAuthorNames'
+type MockQuerier_ListSeriesAuthorNames_Call struct {
+	*mock.Call
+}
+
+// ListSeriesAuthorNames is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) ListSeriesAuthorNames(ctx interface{}) *MockQuerier_ListSeriesAuthorNames_Call {
+	return &MockQuerier_ListSeriesAuthorNames_Call{Call: _e.mock.On("ListSeriesAuthorNames", ctx)}
+}
+
+func (_c *MockQuerier_ListSeriesAuthorNames_Call) Run(run func(ctx context.Context)) *MockQuerier_ListSeriesAuthorNames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListSeriesAuthorNames_Call) Return(listSeriesAuthorNamesRows []ListSeriesAuthorNamesRow, err error) *MockQuerier_ListSeriesAuthorNames_Call {
+	_c.Call.Return(listSeriesAuthorNamesRows, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListSeriesAuthorNames_Call) RunAndReturn(run func(ctx context.Context) ([]ListSeriesAuthorNamesRow, error)) *MockQuerier_ListSeriesAuthorNames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSeriesChanges provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListSeriesChanges(ctx context.Context, arg ListSeriesChangesParams) ([]SeriesChange, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSeriesChanges")
+	}
+
+	var r0 []SeriesChange
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListSeriesChangesParams) ([]SeriesChange, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListSeriesChangesParams) []SeriesChange); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]SeriesChange)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ListSeriesChangesParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListSeriesChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSeriesChanges'
+type MockQuerier_ListSeriesChanges_Call struct {
+	*mock.Call
+}
+
+// ListSeriesChanges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg ListSeriesChangesParams
+func (_e *MockQuerier_Expecter) ListSeriesChanges(ctx interface{}, arg interface{}) *MockQuerier_ListSeriesChanges_Call {
+	return &MockQuerier_ListSeriesChanges_Call{Call: _e.mock.On("ListSeriesChanges", ctx, arg)}
+}
+
+func (_c *MockQuerier_ListSeriesChanges_Call) Run(run func(ctx context.Context, arg ListSeriesChangesParams)) *MockQuerier_ListSeriesChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ListSeriesChangesParams
+		if args[1] != nil {
+			arg1 = args[1].(ListSeriesChangesParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListSeriesChanges_Call) Return(seriesChanges []SeriesChange, err error) *MockQuerier_ListSeriesChanges_Call {
+	_c.Call.Return(seriesChanges, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListSeriesChanges_Call) RunAndReturn(run func(ctx context.Context, arg ListSeriesChangesParams) ([]SeriesChange, error)) *MockQuerier_ListSeriesChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSeriesIdentifiers provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListSeriesIdentifiers(ctx context.Context) ([]ListSeriesIdentifiersRow, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSeriesIdentifiers")
+	}
+
+	var r0 []ListSeriesIdentifiersRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]ListSeriesIdentifiersRow, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []ListSeriesIdentifiersRow); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ListSeriesIdentifiersRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListSeriesIdentifiers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSeriesIdentifiers'
+type MockQuerier_ListSeriesIdentifiers_Call struct {
+	*mock.Call
+}
+
+// ListSeriesIdentifiers is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockQuerier_Expecter) GetBook(ctx interface{}, id interface{}) *MockQuerier_GetBook_Call {
-	return &MockQuerier_GetBook_Call{Call: _e.mock.On("GetBook", ctx, id)}
+func (_e *MockQuerier_Expecter) ListSeriesIdentifiers(ctx interface{}) *MockQuerier_ListSeriesIdentifiers_Call {
+	return &MockQuerier_ListSeriesIdentifiers_Call{Call: _e.mock.On("ListSeriesIdentifiers", ctx)}
 }
 
-func (_c *MockQuerier_GetBook_Call) Run(run func(ctx context.Context, id int64)) *MockQuerier_GetBook_Call {
+func (_c *MockQuerier_ListSeriesIdentifiers_Call) Run(run func(ctx context.Context)) *MockQuerier_ListSeriesIdentifiers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListSeriesIdentifiers_Call) Return(listSeriesIdentifiersRows []ListSeriesIdentifiersRow, err error) *MockQuerier_ListSeriesIdentifiers_Call {
+	_c.Call.Return(listSeriesIdentifiersRows, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListSeriesIdentifiers_Call) RunAndReturn(run func(ctx context.Context) ([]ListSeriesIdentifiersRow, error)) *MockQuerier_ListSeriesIdentifiers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSeriesWithBookStats provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListSeriesWithBookStats(ctx context.Context, arg ListSeriesWithBookStatsParams) ([]ListSeriesWithBookStatsRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSeriesWithBookStats")
+	}
+
+	var r0 []ListSeriesWithBookStatsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListSeriesWithBookStatsParams) ([]ListSeriesWithBookStatsRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListSeriesWithBookStatsParams) []ListSeriesWithBookStatsRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ListSeriesWithBookStatsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ListSeriesWithBookStatsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListSeriesWithBookStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSeriesWithBookStats'
+type MockQuerier_ListSeriesWithBookStats_Call struct {
+	*mock.Call
+}
+
+// ListSeriesWithBookStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg ListSeriesWithBookStatsParams
+func (_e *MockQuerier_Expecter) ListSeriesWithBookStats(ctx interface{}, arg interface{}) *MockQuerier_ListSeriesWithBookStats_Call {
+	return &MockQuerier_ListSeriesWithBookStats_Call{Call: _e.mock.On("ListSeriesWithBookStats", ctx, arg)}
+}
+
+func (_c *MockQuerier_ListSeriesWithBookStats_Call) Run(run func(ctx context.Context, arg ListSeriesWithBookStatsParams)) *MockQuerier_ListSeriesWithBookStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ListSeriesWithBookStatsParams
+		if args[1] != nil {
+			arg1 = args[1].(ListSeriesWithBookStatsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListSeriesWithBookStats_Call) Return(listSeriesWithBookStatsRows []ListSeriesWithBookStatsRow, err error) *MockQuerier_ListSeriesWithBookStats_Call {
+	_c.Call.Return(listSeriesWithBookStatsRows, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListSeriesWithBookStats_Call) RunAndReturn(run func(ctx context.Context, arg ListSeriesWithBookStatsParams) ([]ListSeriesWithBookStatsRow, error)) *MockQuerier_ListSeriesWithBookStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTopAuthorsByMissingBooks provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListTopAuthorsByMissingBooks(ctx context.Context, limit int64) ([]ListTopAuthorsByMissingBooksRow, error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTopAuthorsByMissingBooks")
+	}
+
+	var r0 []ListTopAuthorsByMissingBooksRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) ([]ListTopAuthorsByMissingBooksRow, error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) []ListTopAuthorsByMissingBooksRow); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ListTopAuthorsByMissingBooksRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_ListTopAuthorsByMissingBooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTopAuthorsByMissingBooks'
+type MockQuerier_ListTopAuthorsByMissingBooks_Call struct {
+	*mock.Call
+}
+
+// ListTopAuthorsByMissingBooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int64
+func (_e *MockQuerier_Expecter) ListTopAuthorsByMissingBooks(ctx interface{}, limit interface{}) *MockQuerier_ListTopAuthorsByMissingBooks_Call {
+	return &MockQuerier_ListTopAuthorsByMissingBooks_Call{Call: _e.mock.On("ListTopAuthorsByMissingBooks", ctx, limit)}
+}
+
+func (_c *MockQuerier_ListTopAuthorsByMissingBooks_Call) Run(run func(ctx context.Context, limit int64)) *MockQuerier_ListTopAuthorsByMissingBooks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -613,55 +4904,165 @@ func (_c *MockQuerier_GetBook_Call) Run(run func(ctx context.Context, id int64))
 	return _c
 }
 
-func (_c *MockQuerier_GetBook_Call) Return(book Book, err error) *MockQuerier_GetBook_Call {
-	_c.Call.Return(book, err)
+func (_c *MockQuerier_ListTopAuthorsByMissingBooks_Call) Return(listTopAuthorsByMissingBooksRows []ListTopAuthorsByMissingBooksRow, err error) *MockQuerier_ListTopAuthorsByMissingBooks_Call {
+	_c.Call.Return(listTopAuthorsByMissingBooksRows, err)
 	return _c
 }
 
-func (_c *MockQuerier_GetBook_Call) RunAndReturn(run func(ctx context.Context, id int64) (Book, error)) *MockQuerier_GetBook_Call {
+func (_c *MockQuerier_ListTopAuthorsByMissingBooks_Call) RunAndReturn(run func(ctx context.Context, limit int64) ([]ListTopAuthorsByMissingBooksRow, error)) *MockQuerier_ListTopAuthorsByMissingBooks_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetBookByBookID provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) GetBookByBookID(ctx context.Context, bookID int64) (Book, error) {
-	ret := _mock.Called(ctx, bookID)
+// ListUpcomingBooks provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ListUpcomingBooks(ctx context.Context) ([]Book, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetBookByBookID")
+		panic("no return value specified for ListUpcomingBooks")
 	}
 
-	var r0 Book
+	var r0 []Book
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (Book, error)); ok {
-		return returnFunc(ctx, bookID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]Book, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) Book); ok {
-		r0 = returnFunc(ctx, bookID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []Book); ok {
+		r0 = returnFunc(ctx)
 	} else {
-		r0 = ret.Get(0).(Book)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Book)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, bookID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockQuerier_GetBookByBookID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBookByBookID'
-type MockQuerier_GetBookByBookID_Call struct {
+// MockQuerier_ListUpcomingBooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUpcomingBooks'
+type MockQuerier_ListUpcomingBooks_Call struct {
 	*mock.Call
 }
 
-// GetBookByBookID is a helper method to define mock.On call
+// ListUpcomingBooks is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) ListUpcomingBooks(ctx interface{}) *MockQuerier_ListUpcomingBooks_Call {
+	return &MockQuerier_ListUpcomingBooks_Call{Call: _e.mock.On("ListUpcomingBooks", ctx)}
+}
+
+func (_c *MockQuerier_ListUpcomingBooks_Call) Run(run func(ctx context.Context)) *MockQuerier_ListUpcomingBooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ListUpcomingBooks_Call) Return(books []Book, err error) *MockQuerier_ListUpcomingBooks_Call {
+	_c.Call.Return(books, err)
+	return _c
+}
+
+func (_c *MockQuerier_ListUpcomingBooks_Call) RunAndReturn(run func(ctx context.Context) ([]Book, error)) *MockQuerier_ListUpcomingBooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkEnrichLookup provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) MarkEnrichLookup(ctx context.Context, bookID int64) error {
+	ret := _mock.Called(ctx, bookID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkEnrichLookup")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, bookID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockQuerier_MarkEnrichLookup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkEnrichLookup'
+type MockQuerier_MarkEnrichLookup_Call struct {
+	*mock.Call
+}
+
+// MarkEnrichLookup is a helper method to define mock.On call
 //   - ctx context.Context
 //   - bookID int64
-func (_e *MockQuerier_Expecter) GetBookByBookID(ctx interface{}, bookID interface{}) *MockQuerier_GetBookByBookID_Call {
-	return &MockQuerier_GetBookByBookID_Call{Call: _e.mock.On("GetBookByBookID", ctx, bookID)}
+func (_e *MockQuerier_Expecter) MarkEnrichLookup(ctx interface{}, bookID interface{}) *MockQuerier_MarkEnrichLookup_Call {
+	return &MockQuerier_MarkEnrichLookup_Call{Call: _e.mock.On("MarkEnrichLookup", ctx, bookID)}
+}
+
+func (_c *MockQuerier_MarkEnrichLookup_Call) Run(run func(ctx context.Context, bookID int64)) *MockQuerier_MarkEnrichLookup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_MarkEnrichLookup_Call) Return(err error) *MockQuerier_MarkEnrichLookup_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockQuerier_MarkEnrichLookup_Call) RunAndReturn(run func(ctx context.Context, bookID int64) error) *MockQuerier_MarkEnrichLookup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkGoodreadsIDLookup provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) MarkGoodreadsIDLookup(ctx context.Context, bookID int64) error {
+	ret := _mock.Called(ctx, bookID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkGoodreadsIDLookup")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, bookID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockQuerier_MarkGoodreadsIDLookup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkGoodreadsIDLookup'
+type MockQuerier_MarkGoodreadsIDLookup_Call struct {
+	*mock.Call
+}
+
+// MarkGoodreadsIDLookup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bookID int64
+func (_e *MockQuerier_Expecter) MarkGoodreadsIDLookup(ctx interface{}, bookID interface{}) *MockQuerier_MarkGoodreadsIDLookup_Call {
+	return &MockQuerier_MarkGoodreadsIDLookup_Call{Call: _e.mock.On("MarkGoodreadsIDLookup", ctx, bookID)}
 }
 
-func (_c *MockQuerier_GetBookByBookID_Call) Run(run func(ctx context.Context, bookID int64)) *MockQuerier_GetBookByBookID_Call {
+func (_c *MockQuerier_MarkGoodreadsIDLookup_Call) Run(run func(ctx context.Context, bookID int64)) *MockQuerier_MarkGoodreadsIDLookup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -679,65 +5080,54 @@ func (_c *MockQuerier_GetBookByBookID_Call) Run(run func(ctx context.Context, bo
 	return _c
 }
 
-func (_c *MockQuerier_GetBookByBookID_Call) Return(book Book, err error) *MockQuerier_GetBookByBookID_Call {
-	_c.Call.Return(book, err)
+func (_c *MockQuerier_MarkGoodreadsIDLookup_Call) Return(err error) *MockQuerier_MarkGoodreadsIDLookup_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockQuerier_GetBookByBookID_Call) RunAndReturn(run func(ctx context.Context, bookID int64) (Book, error)) *MockQuerier_GetBookByBookID_Call {
+func (_c *MockQuerier_MarkGoodreadsIDLookup_Call) RunAndReturn(run func(ctx context.Context, bookID int64) error) *MockQuerier_MarkGoodreadsIDLookup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetBooksBySeries provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) GetBooksBySeries(ctx context.Context, seriesID *int64) ([]Book, error) {
-	ret := _mock.Called(ctx, seriesID)
+// MarkWorkIDLookup provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) MarkWorkIDLookup(ctx context.Context, bookID int64) error {
+	ret := _mock.Called(ctx, bookID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetBooksBySeries")
+		panic("no return value specified for MarkWorkIDLookup")
 	}
 
-	var r0 []Book
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *int64) ([]Book, error)); ok {
-		return returnFunc(ctx, seriesID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *int64) []Book); ok {
-		r0 = returnFunc(ctx, seriesID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]Book)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *int64) error); ok {
-		r1 = returnFunc(ctx, seriesID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, bookID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockQuerier_GetBooksBySeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBooksBySeries'
-type MockQuerier_GetBooksBySeries_Call struct {
+// MockQuerier_MarkWorkIDLookup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkWorkIDLookup'
+type MockQuerier_MarkWorkIDLookup_Call struct {
 	*mock.Call
 }
 
-// GetBooksBySeries is a helper method to define mock.On call
+// MarkWorkIDLookup is a helper method to define mock.On call
 //   - ctx context.Context
-//   - seriesID *int64
-func (_e *MockQuerier_Expecter) GetBooksBySeries(ctx interface{}, seriesID interface{}) *MockQuerier_GetBooksBySeries_Call {
-	return &MockQuerier_GetBooksBySeries_Call{Call: _e.mock.On("GetBooksBySeries", ctx, seriesID)}
+//   - bookID int64
+func (_e *MockQuerier_Expecter) MarkWorkIDLookup(ctx interface{}, bookID interface{}) *MockQuerier_MarkWorkIDLookup_Call {
+	return &MockQuerier_MarkWorkIDLookup_Call{Call: _e.mock.On("MarkWorkIDLookup", ctx, bookID)}
 }
 
-func (_c *MockQuerier_GetBooksBySeries_Call) Run(run func(ctx context.Context, seriesID *int64)) *MockQuerier_GetBooksBySeries_Call {
+func (_c *MockQuerier_MarkWorkIDLookup_Call) Run(run func(ctx context.Context, bookID int64)) *MockQuerier_MarkWorkIDLookup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *int64
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(*int64)
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
@@ -747,63 +5137,63 @@ func (_c *MockQuerier_GetBooksBySeries_Call) Run(run func(ctx context.Context, s
 	return _c
 }
 
-func (_c *MockQuerier_GetBooksBySeries_Call) Return(books []Book, err error) *MockQuerier_GetBooksBySeries_Call {
-	_c.Call.Return(books, err)
+func (_c *MockQuerier_MarkWorkIDLookup_Call) Return(err error) *MockQuerier_MarkWorkIDLookup_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockQuerier_GetBooksBySeries_Call) RunAndReturn(run func(ctx context.Context, seriesID *int64) ([]Book, error)) *MockQuerier_GetBooksBySeries_Call {
+func (_c *MockQuerier_MarkWorkIDLookup_Call) RunAndReturn(run func(ctx context.Context, bookID int64) error) *MockQuerier_MarkWorkIDLookup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetConfig provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) GetConfig(ctx context.Context, key string) (string, error) {
-	ret := _mock.Called(ctx, key)
+// ReleaseJob provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ReleaseJob(ctx context.Context, arg ReleaseJobParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetConfig")
+		panic("no return value specified for ReleaseJob")
 	}
 
-	var r0 string
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
-		return returnFunc(ctx, key)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ReleaseJobParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = returnFunc(ctx, key)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ReleaseJobParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, key)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ReleaseJobParams) error); ok {
+		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockQuerier_GetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfig'
-type MockQuerier_GetConfig_Call struct {
+// MockQuerier_ReleaseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseJob'
+type MockQuerier_ReleaseJob_Call struct {
 	*mock.Call
 }
 
-// GetConfig is a helper method to define mock.On call
+// ReleaseJob is a helper method to define mock.On call
 //   - ctx context.Context
-//   - key string
-func (_e *MockQuerier_Expecter) GetConfig(ctx interface{}, key interface{}) *MockQuerier_GetConfig_Call {
-	return &MockQuerier_GetConfig_Call{Call: _e.mock.On("GetConfig", ctx, key)}
+//   - arg ReleaseJobParams
+func (_e *MockQuerier_Expecter) ReleaseJob(ctx interface{}, arg interface{}) *MockQuerier_ReleaseJob_Call {
+	return &MockQuerier_ReleaseJob_Call{Call: _e.mock.On("ReleaseJob", ctx, arg)}
 }
 
-func (_c *MockQuerier_GetConfig_Call) Run(run func(ctx context.Context, key string)) *MockQuerier_GetConfig_Call {
+func (_c *MockQuerier_ReleaseJob_Call) Run(run func(ctx context.Context, arg ReleaseJobParams)) *MockQuerier_ReleaseJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 ReleaseJobParams
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(ReleaseJobParams)
 		}
 		run(
 			arg0,
@@ -813,65 +5203,63 @@ func (_c *MockQuerier_GetConfig_Call) Run(run func(ctx context.Context, key stri
 	return _c
 }
 
-func (_c *MockQuerier_GetConfig_Call) Return(s string, err error) *MockQuerier_GetConfig_Call {
-	_c.Call.Return(s, err)
+func (_c *MockQuerier_ReleaseJob_Call) Return(n int64, err error) *MockQuerier_ReleaseJob_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockQuerier_GetConfig_Call) RunAndReturn(run func(ctx context.Context, key string) (string, error)) *MockQuerier_GetConfig_Call {
+func (_c *MockQuerier_ReleaseJob_Call) RunAndReturn(run func(ctx context.Context, arg ReleaseJobParams) (int64, error)) *MockQuerier_ReleaseJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetMultipleConfig provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) GetMultipleConfig(ctx context.Context, keys []string) ([]Configuration, error) {
-	ret := _mock.Called(ctx, keys)
+// RequeueJob provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) RequeueJob(ctx context.Context, id int64) (int64, error) {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetMultipleConfig")
+		panic("no return value specified for RequeueJob")
 	}
 
-	var r0 []Configuration
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]Configuration, error)); ok {
-		return returnFunc(ctx, keys)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []Configuration); ok {
-		r0 = returnFunc(ctx, keys)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]Configuration)
-		}
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
-		r1 = returnFunc(ctx, keys)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockQuerier_GetMultipleConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMultipleConfig'
-type MockQuerier_GetMultipleConfig_Call struct {
+// MockQuerier_RequeueJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequeueJob'
+type MockQuerier_RequeueJob_Call struct {
 	*mock.Call
 }
 
-// GetMultipleConfig is a helper method to define mock.On call
+// RequeueJob is a helper method to define mock.On call
 //   - ctx context.Context
-//   - keys []string
-func (_e *MockQuerier_Expecter) GetMultipleConfig(ctx interface{}, keys interface{}) *MockQuerier_GetMultipleConfig_Call {
-	return &MockQuerier_GetMultipleConfig_Call{Call: _e.mock.On("GetMultipleConfig", ctx, keys)}
+//   - id int64
+func (_e *MockQuerier_Expecter) RequeueJob(ctx interface{}, id interface{}) *MockQuerier_RequeueJob_Call {
+	return &MockQuerier_RequeueJob_Call{Call: _e.mock.On("RequeueJob", ctx, id)}
 }
 
-func (_c *MockQuerier_GetMultipleConfig_Call) Run(run func(ctx context.Context, keys []string)) *MockQuerier_GetMultipleConfig_Call {
+func (_c *MockQuerier_RequeueJob_Call) Run(run func(ctx context.Context, id int64)) *MockQuerier_RequeueJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 []string
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].([]string)
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
@@ -881,55 +5269,46 @@ func (_c *MockQuerier_GetMultipleConfig_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockQuerier_GetMultipleConfig_Call) Return(configurations []Configuration, err error) *MockQuerier_GetMultipleConfig_Call {
-	_c.Call.Return(configurations, err)
+func (_c *MockQuerier_RequeueJob_Call) Return(n int64, err error) *MockQuerier_RequeueJob_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockQuerier_GetMultipleConfig_Call) RunAndReturn(run func(ctx context.Context, keys []string) ([]Configuration, error)) *MockQuerier_GetMultipleConfig_Call {
+func (_c *MockQuerier_RequeueJob_Call) RunAndReturn(run func(ctx context.Context, id int64) (int64, error)) *MockQuerier_RequeueJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSeries provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) GetSeries(ctx context.Context, id int64) (Series, error) {
+// ResumeCompletionRun provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) ResumeCompletionRun(ctx context.Context, id int64) error {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSeries")
+		panic("no return value specified for ResumeCompletionRun")
 	}
 
-	var r0 Series
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (Series, error)); ok {
-		return returnFunc(ctx, id)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) Series); ok {
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
 		r0 = returnFunc(ctx, id)
 	} else {
-		r0 = ret.Get(0).(Series)
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, id)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockQuerier_GetSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeries'
-type MockQuerier_GetSeries_Call struct {
+// MockQuerier_ResumeCompletionRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeCompletionRun'
+type MockQuerier_ResumeCompletionRun_Call struct {
 	*mock.Call
 }
 
-// GetSeries is a helper method to define mock.On call
+// ResumeCompletionRun is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int64
-func (_e *MockQuerier_Expecter) GetSeries(ctx interface{}, id interface{}) *MockQuerier_GetSeries_Call {
-	return &MockQuerier_GetSeries_Call{Call: _e.mock.On("GetSeries", ctx, id)}
+func (_e *MockQuerier_Expecter) ResumeCompletionRun(ctx interface{}, id interface{}) *MockQuerier_ResumeCompletionRun_Call {
+	return &MockQuerier_ResumeCompletionRun_Call{Call: _e.mock.On("ResumeCompletionRun", ctx, id)}
 }
 
-func (_c *MockQuerier_GetSeries_Call) Run(run func(ctx context.Context, id int64)) *MockQuerier_GetSeries_Call {
+func (_c *MockQuerier_ResumeCompletionRun_Call) Run(run func(ctx context.Context, id int64)) *MockQuerier_ResumeCompletionRun_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -947,65 +5326,63 @@ func (_c *MockQuerier_GetSeries_Call) Run(run func(ctx context.Context, id int64
 	return _c
 }
 
-func (_c *MockQuerier_GetSeries_Call) Return(series Series, err error) *MockQuerier_GetSeries_Call {
-	_c.Call.Return(series, err)
+func (_c *MockQuerier_ResumeCompletionRun_Call) Return(err error) *MockQuerier_ResumeCompletionRun_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockQuerier_GetSeries_Call) RunAndReturn(run func(ctx context.Context, id int64) (Series, error)) *MockQuerier_GetSeries_Call {
+func (_c *MockQuerier_ResumeCompletionRun_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockQuerier_ResumeCompletionRun_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSeriesAuthors provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) GetSeriesAuthors(ctx context.Context, seriesID int64) ([]Author, error) {
-	ret := _mock.Called(ctx, seriesID)
+// RetryJob provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) RetryJob(ctx context.Context, arg RetryJobParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSeriesAuthors")
+		panic("no return value specified for RetryJob")
 	}
 
-	var r0 []Author
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) ([]Author, error)); ok {
-		return returnFunc(ctx, seriesID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, RetryJobParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) []Author); ok {
-		r0 = returnFunc(ctx, seriesID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, RetryJobParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]Author)
-		}
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, seriesID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, RetryJobParams) error); ok {
+		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockQuerier_GetSeriesAuthors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeriesAuthors'
-type MockQuerier_GetSeriesAuthors_Call struct {
+// MockQuerier_RetryJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryJob'
+type MockQuerier_RetryJob_Call struct {
 	*mock.Call
 }
 
-// GetSeriesAuthors is a helper method to define mock.On call
+// RetryJob is a helper method to define mock.On call
 //   - ctx context.Context
-//   - seriesID int64
-func (_e *MockQuerier_Expecter) GetSeriesAuthors(ctx interface{}, seriesID interface{}) *MockQuerier_GetSeriesAuthors_Call {
-	return &MockQuerier_GetSeriesAuthors_Call{Call: _e.mock.On("GetSeriesAuthors", ctx, seriesID)}
+//   - arg RetryJobParams
+func (_e *MockQuerier_Expecter) RetryJob(ctx interface{}, arg interface{}) *MockQuerier_RetryJob_Call {
+	return &MockQuerier_RetryJob_Call{Call: _e.mock.On("RetryJob", ctx, arg)}
 }
 
-func (_c *MockQuerier_GetSeriesAuthors_Call) Run(run func(ctx context.Context, seriesID int64)) *MockQuerier_GetSeriesAuthors_Call {
+func (_c *MockQuerier_RetryJob_Call) Run(run func(ctx context.Context, arg RetryJobParams)) *MockQuerier_RetryJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int64
+		var arg1 RetryJobParams
 		if args[1] != nil {
-			arg1 = args[1].(int64)
+			arg1 = args[1].(RetryJobParams)
 		}
 		run(
 			arg0,
@@ -1015,63 +5392,65 @@ func (_c *MockQuerier_GetSeriesAuthors_Call) Run(run func(ctx context.Context, s
 	return _c
 }
 
-func (_c *MockQuerier_GetSeriesAuthors_Call) Return(authors []Author, err error) *MockQuerier_GetSeriesAuthors_Call {
-	_c.Call.Return(authors, err)
+func (_c *MockQuerier_RetryJob_Call) Return(n int64, err error) *MockQuerier_RetryJob_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockQuerier_GetSeriesAuthors_Call) RunAndReturn(run func(ctx context.Context, seriesID int64) ([]Author, error)) *MockQuerier_GetSeriesAuthors_Call {
+func (_c *MockQuerier_RetryJob_Call) RunAndReturn(run func(ctx context.Context, arg RetryJobParams) (int64, error)) *MockQuerier_RetryJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSeriesByGoodreadsID provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) GetSeriesByGoodreadsID(ctx context.Context, seriesID int64) (Series, error) {
-	ret := _mock.Called(ctx, seriesID)
+// SearchLibrary provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) SearchLibrary(ctx context.Context, arg SearchLibraryParams) ([]SearchLibraryRow, error) {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSeriesByGoodreadsID")
+		panic("no return value specified for SearchLibrary")
 	}
 
-	var r0 Series
+	var r0 []SearchLibraryRow
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (Series, error)); ok {
-		return returnFunc(ctx, seriesID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SearchLibraryParams) ([]SearchLibraryRow, error)); ok {
+		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) Series); ok {
-		r0 = returnFunc(ctx, seriesID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SearchLibraryParams) []SearchLibraryRow); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(Series)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]SearchLibraryRow)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, seriesID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, SearchLibraryParams) error); ok {
+		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockQuerier_GetSeriesByGoodreadsID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeriesByGoodreadsID'
-type MockQuerier_GetSeriesByGoodreadsID_Call struct {
+// MockQuerier_SearchLibrary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchLibrary'
+type MockQuerier_SearchLibrary_Call struct {
 	*mock.Call
 }
 
-// GetSeriesByGoodreadsID is a helper method to define mock.On call
+// SearchLibrary is a helper method to define mock.On call
 //   - ctx context.Context
-//   - seriesID int64
-func (_e *MockQuerier_Expecter) GetSeriesByGoodreadsID(ctx interface{}, seriesID interface{}) *MockQuerier_GetSeriesByGoodreadsID_Call {
-	return &MockQuerier_GetSeriesByGoodreadsID_Call{Call: _e.mock.On("GetSeriesByGoodreadsID", ctx, seriesID)}
+//   - arg SearchLibraryParams
+func (_e *MockQuerier_Expecter) SearchLibrary(ctx interface{}, arg interface{}) *MockQuerier_SearchLibrary_Call {
+	return &MockQuerier_SearchLibrary_Call{Call: _e.mock.On("SearchLibrary", ctx, arg)}
 }
 
-func (_c *MockQuerier_GetSeriesByGoodreadsID_Call) Run(run func(ctx context.Context, seriesID int64)) *MockQuerier_GetSeriesByGoodreadsID_Call {
+func (_c *MockQuerier_SearchLibrary_Call) Run(run func(ctx context.Context, arg SearchLibraryParams)) *MockQuerier_SearchLibrary_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int64
+		var arg1 SearchLibraryParams
 		if args[1] != nil {
-			arg1 = args[1].(int64)
+			arg1 = args[1].(SearchLibraryParams)
 		}
 		run(
 			arg0,
@@ -1081,63 +5460,54 @@ func (_c *MockQuerier_GetSeriesByGoodreadsID_Call) Run(run func(ctx context.Cont
 	return _c
 }
 
-func (_c *MockQuerier_GetSeriesByGoodreadsID_Call) Return(series Series, err error) *MockQuerier_GetSeriesByGoodreadsID_Call {
-	_c.Call.Return(series, err)
+func (_c *MockQuerier_SearchLibrary_Call) Return(searchLibraryRows []SearchLibraryRow, err error) *MockQuerier_SearchLibrary_Call {
+	_c.Call.Return(searchLibraryRows, err)
 	return _c
 }
 
-func (_c *MockQuerier_GetSeriesByGoodreadsID_Call) RunAndReturn(run func(ctx context.Context, seriesID int64) (Series, error)) *MockQuerier_GetSeriesByGoodreadsID_Call {
+func (_c *MockQuerier_SearchLibrary_Call) RunAndReturn(run func(ctx context.Context, arg SearchLibraryParams) ([]SearchLibraryRow, error)) *MockQuerier_SearchLibrary_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSeriesBySeriesID provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) GetSeriesBySeriesID(ctx context.Context, seriesID int64) (Series, error) {
-	ret := _mock.Called(ctx, seriesID)
+// SetAuthorGoodreadsID provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) SetAuthorGoodreadsID(ctx context.Context, arg SetAuthorGoodreadsIDParams) error {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSeriesBySeriesID")
+		panic("no return value specified for SetAuthorGoodreadsID")
 	}
 
-	var r0 Series
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (Series, error)); ok {
-		return returnFunc(ctx, seriesID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) Series); ok {
-		r0 = returnFunc(ctx, seriesID)
-	} else {
-		r0 = ret.Get(0).(Series)
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, seriesID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SetAuthorGoodreadsIDParams) error); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockQuerier_GetSeriesBySeriesID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeriesBySeriesID'
-type MockQuerier_GetSeriesBySeriesID_Call struct {
+// MockQuerier_SetAuthorGoodreadsID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAuthorGoodreadsID'
+type MockQuerier_SetAuthorGoodreadsID_Call struct {
 	*mock.Call
 }
 
-// GetSeriesBySeriesID is a helper method to define mock.On call
+// SetAuthorGoodreadsID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - seriesID int64
-func (_e *MockQuerier_Expecter) GetSeriesBySeriesID(ctx interface{}, seriesID interface{}) *MockQuerier_GetSeriesBySeriesID_Call {
-	return &MockQuerier_GetSeriesBySeriesID_Call{Call: _e.mock.On("GetSeriesBySeriesID", ctx, seriesID)}
+//   - arg SetAuthorGoodreadsIDParams
+func (_e *MockQuerier_Expecter) SetAuthorGoodreadsID(ctx interface{}, arg interface{}) *MockQuerier_SetAuthorGoodreadsID_Call {
+	return &MockQuerier_SetAuthorGoodreadsID_Call{Call: _e.mock.On("SetAuthorGoodreadsID", ctx, arg)}
 }
 
-func (_c *MockQuerier_GetSeriesBySeriesID_Call) Run(run func(ctx context.Context, seriesID int64)) *MockQuerier_GetSeriesBySeriesID_Call {
+func (_c *MockQuerier_SetAuthorGoodreadsID_Call) Run(run func(ctx context.Context, arg SetAuthorGoodreadsIDParams)) *MockQuerier_SetAuthorGoodreadsID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int64
+		var arg1 SetAuthorGoodreadsIDParams
 		if args[1] != nil {
-			arg1 = args[1].(int64)
+			arg1 = args[1].(SetAuthorGoodreadsIDParams)
 		}
 		run(
 			arg0,
@@ -1147,26 +5517,26 @@ func (_c *MockQuerier_GetSeriesBySeriesID_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *MockQuerier_GetSeriesBySeriesID_Call) Return(series Series, err error) *MockQuerier_GetSeriesBySeriesID_Call {
-	_c.Call.Return(series, err)
+func (_c *MockQuerier_SetAuthorGoodreadsID_Call) Return(err error) *MockQuerier_SetAuthorGoodreadsID_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockQuerier_GetSeriesBySeriesID_Call) RunAndReturn(run func(ctx context.Context, seriesID int64) (Series, error)) *MockQuerier_GetSeriesBySeriesID_Call {
+func (_c *MockQuerier_SetAuthorGoodreadsID_Call) RunAndReturn(run func(ctx context.Context, arg SetAuthorGoodreadsIDParams) error) *MockQuerier_SetAuthorGoodreadsID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LinkBookAuthor provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) LinkBookAuthor(ctx context.Context, arg LinkBookAuthorParams) error {
+// SetBookGoodreadsID provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) SetBookGoodreadsID(ctx context.Context, arg SetBookGoodreadsIDParams) error {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LinkBookAuthor")
+		panic("no return value specified for SetBookGoodreadsID")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, LinkBookAuthorParams) error); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SetBookGoodreadsIDParams) error); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
 		r0 = ret.Error(0)
@@ -1174,27 +5544,27 @@ func (_mock *MockQuerier) LinkBookAuthor(ctx context.Context, arg LinkBookAuthor
 	return r0
 }
 
-// MockQuerier_LinkBookAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkBookAuthor'
-type MockQuerier_LinkBookAuthor_Call struct {
+// MockQuerier_SetBookGoodreadsID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetBookGoodreadsID'
+type MockQuerier_SetBookGoodreadsID_Call struct {
 	*mock.Call
 }
 
-// LinkBookAuthor is a helper method to define mock.On call
+// SetBookGoodreadsID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg LinkBookAuthorParams
-func (_e *MockQuerier_Expecter) LinkBookAuthor(ctx interface{}, arg interface{}) *MockQuerier_LinkBookAuthor_Call {
-	return &MockQuerier_LinkBookAuthor_Call{Call: _e.mock.On("LinkBookAuthor", ctx, arg)}
+//   - arg SetBookGoodreadsIDParams
+func (_e *MockQuerier_Expecter) SetBookGoodreadsID(ctx interface{}, arg interface{}) *MockQuerier_SetBookGoodreadsID_Call {
+	return &MockQuerier_SetBookGoodreadsID_Call{Call: _e.mock.On("SetBookGoodreadsID", ctx, arg)}
 }
 
-func (_c *MockQuerier_LinkBookAuthor_Call) Run(run func(ctx context.Context, arg LinkBookAuthorParams)) *MockQuerier_LinkBookAuthor_Call {
+func (_c *MockQuerier_SetBookGoodreadsID_Call) Run(run func(ctx context.Context, arg SetBookGoodreadsIDParams)) *MockQuerier_SetBookGoodreadsID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 LinkBookAuthorParams
+		var arg1 SetBookGoodreadsIDParams
 		if args[1] != nil {
-			arg1 = args[1].(LinkBookAuthorParams)
+			arg1 = args[1].(SetBookGoodreadsIDParams)
 		}
 		run(
 			arg0,
@@ -1204,26 +5574,26 @@ func (_c *MockQuerier_LinkBookAuthor_Call) Run(run func(ctx context.Context, arg
 	return _c
 }
 
-func (_c *MockQuerier_LinkBookAuthor_Call) Return(err error) *MockQuerier_LinkBookAuthor_Call {
+func (_c *MockQuerier_SetBookGoodreadsID_Call) Return(err error) *MockQuerier_SetBookGoodreadsID_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockQuerier_LinkBookAuthor_Call) RunAndReturn(run func(ctx context.Context, arg LinkBookAuthorParams) error) *MockQuerier_LinkBookAuthor_Call {
+func (_c *MockQuerier_SetBookGoodreadsID_Call) RunAndReturn(run func(ctx context.Context, arg SetBookGoodreadsIDParams) error) *MockQuerier_SetBookGoodreadsID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LinkSeriesAuthor provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) LinkSeriesAuthor(ctx context.Context, arg LinkSeriesAuthorParams) error {
+// SetBookGoodreadsWorkID provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) SetBookGoodreadsWorkID(ctx context.Context, arg SetBookGoodreadsWorkIDParams) error {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LinkSeriesAuthor")
+		panic("no return value specified for SetBookGoodreadsWorkID")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, LinkSeriesAuthorParams) error); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SetBookGoodreadsWorkIDParams) error); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
 		r0 = ret.Error(0)
@@ -1231,27 +5601,27 @@ func (_mock *MockQuerier) LinkSeriesAuthor(ctx context.Context, arg LinkSeriesAu
 	return r0
 }
 
-// MockQuerier_LinkSeriesAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkSeriesAuthor'
-type MockQuerier_LinkSeriesAuthor_Call struct {
+// MockQuerier_SetBookGoodreadsWorkID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetBookGoodreadsWorkID'
+type MockQuerier_SetBookGoodreadsWorkID_Call struct {
 	*mock.Call
 }
 
-// LinkSeriesAuthor is a helper method to define mock.On call
+// SetBookGoodreadsWorkID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg LinkSeriesAuthorParams
-func (_e *MockQuerier_Expecter) LinkSeriesAuthor(ctx interface{}, arg interface{}) *MockQuerier_LinkSeriesAuthor_Call {
-	return &MockQuerier_LinkSeriesAuthor_Call{Call: _e.mock.On("LinkSeriesAuthor", ctx, arg)}
+//   - arg SetBookGoodreadsWorkIDParams
+func (_e *MockQuerier_Expecter) SetBookGoodreadsWorkID(ctx interface{}, arg interface{}) *MockQuerier_SetBookGoodreadsWorkID_Call {
+	return &MockQuerier_SetBookGoodreadsWorkID_Call{Call: _e.mock.On("SetBookGoodreadsWorkID", ctx, arg)}
 }
 
-func (_c *MockQuerier_LinkSeriesAuthor_Call) Run(run func(ctx context.Context, arg LinkSeriesAuthorParams)) *MockQuerier_LinkSeriesAuthor_Call {
+func (_c *MockQuerier_SetBookGoodreadsWorkID_Call) Run(run func(ctx context.Context, arg SetBookGoodreadsWorkIDParams)) *MockQuerier_SetBookGoodreadsWorkID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 LinkSeriesAuthorParams
+		var arg1 SetBookGoodreadsWorkIDParams
 		if args[1] != nil {
-			arg1 = args[1].(LinkSeriesAuthorParams)
+			arg1 = args[1].(SetBookGoodreadsWorkIDParams)
 		}
 		run(
 			arg0,
@@ -1261,65 +5631,54 @@ func (_c *MockQuerier_LinkSeriesAuthor_Call) Run(run func(ctx context.Context, a
 	return _c
 }
 
-func (_c *MockQuerier_LinkSeriesAuthor_Call) Return(err error) *MockQuerier_LinkSeriesAuthor_Call {
+func (_c *MockQuerier_SetBookGoodreadsWorkID_Call) Return(err error) *MockQuerier_SetBookGoodreadsWorkID_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockQuerier_LinkSeriesAuthor_Call) RunAndReturn(run func(ctx context.Context, arg LinkSeriesAuthorParams) error) *MockQuerier_LinkSeriesAuthor_Call {
+func (_c *MockQuerier_SetBookGoodreadsWorkID_Call) RunAndReturn(run func(ctx context.Context, arg SetBookGoodreadsWorkIDParams) error) *MockQuerier_SetBookGoodreadsWorkID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListBooks provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) ListBooks(ctx context.Context, arg ListBooksParams) ([]Book, error) {
+// SetConfig provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) SetConfig(ctx context.Context, arg SetConfigParams) error {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListBooks")
+		panic("no return value specified for SetConfig")
 	}
 
-	var r0 []Book
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, ListBooksParams) ([]Book, error)); ok {
-		return returnFunc(ctx, arg)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, ListBooksParams) []Book); ok {
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SetConfigParams) error); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]Book)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, ListBooksParams) error); ok {
-		r1 = returnFunc(ctx, arg)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockQuerier_ListBooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBooks'
-type MockQuerier_ListBooks_Call struct {
+// MockQuerier_SetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetConfig'
+type MockQuerier_SetConfig_Call struct {
 	*mock.Call
 }
 
-// ListBooks is a helper method to define mock.On call
+// SetConfig is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg ListBooksParams
-func (_e *MockQuerier_Expecter) ListBooks(ctx interface{}, arg interface{}) *MockQuerier_ListBooks_Call {
-	return &MockQuerier_ListBooks_Call{Call: _e.mock.On("ListBooks", ctx, arg)}
+//   - arg SetConfigParams
+func (_e *MockQuerier_Expecter) SetConfig(ctx interface{}, arg interface{}) *MockQuerier_SetConfig_Call {
+	return &MockQuerier_SetConfig_Call{Call: _e.mock.On("SetConfig", ctx, arg)}
 }
 
-func (_c *MockQuerier_ListBooks_Call) Run(run func(ctx context.Context, arg ListBooksParams)) *MockQuerier_ListBooks_Call {
+func (_c *MockQuerier_SetConfig_Call) Run(run func(ctx context.Context, arg SetConfigParams)) *MockQuerier_SetConfig_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 ListBooksParams
+		var arg1 SetConfigParams
 		if args[1] != nil {
-			arg1 = args[1].(ListBooksParams)
+			arg1 = args[1].(SetConfigParams)
 		}
 		run(
 			arg0,
@@ -1329,65 +5688,54 @@ func (_c *MockQuerier_ListBooks_Call) Run(run func(ctx context.Context, arg List
 	return _c
 }
 
-func (_c *MockQuerier_ListBooks_Call) Return(books []Book, err error) *MockQuerier_ListBooks_Call {
-	_c.Call.Return(books, err)
+func (_c *MockQuerier_SetConfig_Call) Return(err error) *MockQuerier_SetConfig_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockQuerier_ListBooks_Call) RunAndReturn(run func(ctx context.Context, arg ListBooksParams) ([]Book, error)) *MockQuerier_ListBooks_Call {
+func (_c *MockQuerier_SetConfig_Call) RunAndReturn(run func(ctx context.Context, arg SetConfigParams) error) *MockQuerier_SetConfig_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListSeries provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) ListSeries(ctx context.Context, arg ListSeriesParams) ([]Series, error) {
+// SetMatchReviewStatus provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) SetMatchReviewStatus(ctx context.Context, arg SetMatchReviewStatusParams) error {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListSeries")
+		panic("no return value specified for SetMatchReviewStatus")
 	}
 
-	var r0 []Series
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, ListSeriesParams) ([]Series, error)); ok {
-		return returnFunc(ctx, arg)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, ListSeriesParams) []Series); ok {
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SetMatchReviewStatusParams) error); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]Series)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, ListSeriesParams) error); ok {
-		r1 = returnFunc(ctx, arg)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockQuerier_ListSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSeries'
-type MockQuerier_ListSeries_Call struct {
+// MockQuerier_SetMatchReviewStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMatchReviewStatus'
+type MockQuerier_SetMatchReviewStatus_Call struct {
 	*mock.Call
 }
 
-// ListSeries is a helper method to define mock.On call
+// SetMatchReviewStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg ListSeriesParams
-func (_e *MockQuerier_Expecter) ListSeries(ctx interface{}, arg interface{}) *MockQuerier_ListSeries_Call {
-	return &MockQuerier_ListSeries_Call{Call: _e.mock.On("ListSeries", ctx, arg)}
+//   - arg SetMatchReviewStatusParams
+func (_e *MockQuerier_Expecter) SetMatchReviewStatus(ctx interface{}, arg interface{}) *MockQuerier_SetMatchReviewStatus_Call {
+	return &MockQuerier_SetMatchReviewStatus_Call{Call: _e.mock.On("SetMatchReviewStatus", ctx, arg)}
 }
 
-func (_c *MockQuerier_ListSeries_Call) Run(run func(ctx context.Context, arg ListSeriesParams)) *MockQuerier_ListSeries_Call {
+func (_c *MockQuerier_SetMatchReviewStatus_Call) Run(run func(ctx context.Context, arg SetMatchReviewStatusParams)) *MockQuerier_SetMatchReviewStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 ListSeriesParams
+		var arg1 SetMatchReviewStatusParams
 		if args[1] != nil {
-			arg1 = args[1].(ListSeriesParams)
+			arg1 = args[1].(SetMatchReviewStatusParams)
 		}
 		run(
 			arg0,
@@ -1397,65 +5745,54 @@ func (_c *MockQuerier_ListSeries_Call) Run(run func(ctx context.Context, arg Lis
 	return _c
 }
 
-func (_c *MockQuerier_ListSeries_Call) Return(seriess []Series, err error) *MockQuerier_ListSeries_Call {
-	_c.Call.Return(seriess, err)
+func (_c *MockQuerier_SetMatchReviewStatus_Call) Return(err error) *MockQuerier_SetMatchReviewStatus_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockQuerier_ListSeries_Call) RunAndReturn(run func(ctx context.Context, arg ListSeriesParams) ([]Series, error)) *MockQuerier_ListSeries_Call {
+func (_c *MockQuerier_SetMatchReviewStatus_Call) RunAndReturn(run func(ctx context.Context, arg SetMatchReviewStatusParams) error) *MockQuerier_SetMatchReviewStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListSeriesWithBookStats provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) ListSeriesWithBookStats(ctx context.Context, arg ListSeriesWithBookStatsParams) ([]ListSeriesWithBookStatsRow, error) {
+// SetSeriesCheckResult provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) SetSeriesCheckResult(ctx context.Context, arg SetSeriesCheckResultParams) error {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListSeriesWithBookStats")
+		panic("no return value specified for SetSeriesCheckResult")
 	}
 
-	var r0 []ListSeriesWithBookStatsRow
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, ListSeriesWithBookStatsParams) ([]ListSeriesWithBookStatsRow, error)); ok {
-		return returnFunc(ctx, arg)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, ListSeriesWithBookStatsParams) []ListSeriesWithBookStatsRow); ok {
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SetSeriesCheckResultParams) error); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]ListSeriesWithBookStatsRow)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, ListSeriesWithBookStatsParams) error); ok {
-		r1 = returnFunc(ctx, arg)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockQuerier_ListSeriesWithBookStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSeriesWithBookStats'
-type MockQuerier_ListSeriesWithBookStats_Call struct {
+// MockQuerier_SetSeriesCheckResult_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSeriesCheckResult'
+type MockQuerier_SetSeriesCheckResult_Call struct {
 	*mock.Call
 }
 
-// ListSeriesWithBookStats is a helper method to define mock.On call
+// SetSeriesCheckResult is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg ListSeriesWithBookStatsParams
-func (_e *MockQuerier_Expecter) ListSeriesWithBookStats(ctx interface{}, arg interface{}) *MockQuerier_ListSeriesWithBookStats_Call {
-	return &MockQuerier_ListSeriesWithBookStats_Call{Call: _e.mock.On("ListSeriesWithBookStats", ctx, arg)}
+//   - arg SetSeriesCheckResultParams
+func (_e *MockQuerier_Expecter) SetSeriesCheckResult(ctx interface{}, arg interface{}) *MockQuerier_SetSeriesCheckResult_Call {
+	return &MockQuerier_SetSeriesCheckResult_Call{Call: _e.mock.On("SetSeriesCheckResult", ctx, arg)}
 }
 
-func (_c *MockQuerier_ListSeriesWithBookStats_Call) Run(run func(ctx context.Context, arg ListSeriesWithBookStatsParams)) *MockQuerier_ListSeriesWithBookStats_Call {
+func (_c *MockQuerier_SetSeriesCheckResult_Call) Run(run func(ctx context.Context, arg SetSeriesCheckResultParams)) *MockQuerier_SetSeriesCheckResult_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 ListSeriesWithBookStatsParams
+		var arg1 SetSeriesCheckResultParams
 		if args[1] != nil {
-			arg1 = args[1].(ListSeriesWithBookStatsParams)
+			arg1 = args[1].(SetSeriesCheckResultParams)
 		}
 		run(
 			arg0,
@@ -1465,54 +5802,63 @@ func (_c *MockQuerier_ListSeriesWithBookStats_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *MockQuerier_ListSeriesWithBookStats_Call) Return(listSeriesWithBookStatsRows []ListSeriesWithBookStatsRow, err error) *MockQuerier_ListSeriesWithBookStats_Call {
-	_c.Call.Return(listSeriesWithBookStatsRows, err)
+func (_c *MockQuerier_SetSeriesCheckResult_Call) Return(err error) *MockQuerier_SetSeriesCheckResult_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockQuerier_ListSeriesWithBookStats_Call) RunAndReturn(run func(ctx context.Context, arg ListSeriesWithBookStatsParams) ([]ListSeriesWithBookStatsRow, error)) *MockQuerier_ListSeriesWithBookStats_Call {
+func (_c *MockQuerier_SetSeriesCheckResult_Call) RunAndReturn(run func(ctx context.Context, arg SetSeriesCheckResultParams) error) *MockQuerier_SetSeriesCheckResult_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetConfig provides a mock function for the type MockQuerier
-func (_mock *MockQuerier) SetConfig(ctx context.Context, arg SetConfigParams) error {
+// SetSeriesFollowed provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) SetSeriesFollowed(ctx context.Context, arg SetSeriesFollowedParams) (int64, error) {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetConfig")
+		panic("no return value specified for SetSeriesFollowed")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, SetConfigParams) error); ok {
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SetSeriesFollowedParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SetSeriesFollowedParams) int64); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, SetSeriesFollowedParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockQuerier_SetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetConfig'
-type MockQuerier_SetConfig_Call struct {
+// MockQuerier_SetSeriesFollowed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSeriesFollowed'
+type MockQuerier_SetSeriesFollowed_Call struct {
 	*mock.Call
 }
 
-// SetConfig is a helper method to define mock.On call
+// SetSeriesFollowed is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg SetConfigParams
-func (_e *MockQuerier_Expecter) SetConfig(ctx interface{}, arg interface{}) *MockQuerier_SetConfig_Call {
-	return &MockQuerier_SetConfig_Call{Call: _e.mock.On("SetConfig", ctx, arg)}
+//   - arg SetSeriesFollowedParams
+func (_e *MockQuerier_Expecter) SetSeriesFollowed(ctx interface{}, arg interface{}) *MockQuerier_SetSeriesFollowed_Call {
+	return &MockQuerier_SetSeriesFollowed_Call{Call: _e.mock.On("SetSeriesFollowed", ctx, arg)}
 }
 
-func (_c *MockQuerier_SetConfig_Call) Run(run func(ctx context.Context, arg SetConfigParams)) *MockQuerier_SetConfig_Call {
+func (_c *MockQuerier_SetSeriesFollowed_Call) Run(run func(ctx context.Context, arg SetSeriesFollowedParams)) *MockQuerier_SetSeriesFollowed_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 SetConfigParams
+		var arg1 SetSeriesFollowedParams
 		if args[1] != nil {
-			arg1 = args[1].(SetConfigParams)
+			arg1 = args[1].(SetSeriesFollowedParams)
 		}
 		run(
 			arg0,
@@ -1522,12 +5868,12 @@ func (_c *MockQuerier_SetConfig_Call) Run(run func(ctx context.Context, arg SetC
 	return _c
 }
 
-func (_c *MockQuerier_SetConfig_Call) Return(err error) *MockQuerier_SetConfig_Call {
-	_c.Call.Return(err)
+func (_c *MockQuerier_SetSeriesFollowed_Call) Return(n int64, err error) *MockQuerier_SetSeriesFollowed_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockQuerier_SetConfig_Call) RunAndReturn(run func(ctx context.Context, arg SetConfigParams) error) *MockQuerier_SetConfig_Call {
+func (_c *MockQuerier_SetSeriesFollowed_Call) RunAndReturn(run func(ctx context.Context, arg SetSeriesFollowedParams) (int64, error)) *MockQuerier_SetSeriesFollowed_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1721,6 +6067,138 @@ func (_c *MockQuerier_UpsertBook_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// UpsertLibrarySnapshot provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) UpsertLibrarySnapshot(ctx context.Context, arg UpsertLibrarySnapshotParams) (LibrarySnapshot, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertLibrarySnapshot")
+	}
+
+	var r0 LibrarySnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, UpsertLibrarySnapshotParams) (LibrarySnapshot, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, UpsertLibrarySnapshotParams) LibrarySnapshot); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(LibrarySnapshot)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, UpsertLibrarySnapshotParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_UpsertLibrarySnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertLibrarySnapshot'
+type MockQuerier_UpsertLibrarySnapshot_Call struct {
+	*mock.Call
+}
+
+// UpsertLibrarySnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg UpsertLibrarySnapshotParams
+func (_e *MockQuerier_Expecter) UpsertLibrarySnapshot(ctx interface{}, arg interface{}) *MockQuerier_UpsertLibrarySnapshot_Call {
+	return &MockQuerier_UpsertLibrarySnapshot_Call{Call: _e.mock.On("UpsertLibrarySnapshot", ctx, arg)}
+}
+
+func (_c *MockQuerier_UpsertLibrarySnapshot_Call) Run(run func(ctx context.Context, arg UpsertLibrarySnapshotParams)) *MockQuerier_UpsertLibrarySnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 UpsertLibrarySnapshotParams
+		if args[1] != nil {
+			arg1 = args[1].(UpsertLibrarySnapshotParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpsertLibrarySnapshot_Call) Return(librarySnapshot LibrarySnapshot, err error) *MockQuerier_UpsertLibrarySnapshot_Call {
+	_c.Call.Return(librarySnapshot, err)
+	return _c
+}
+
+func (_c *MockQuerier_UpsertLibrarySnapshot_Call) RunAndReturn(run func(ctx context.Context, arg UpsertLibrarySnapshotParams) (LibrarySnapshot, error)) *MockQuerier_UpsertLibrarySnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertMatchReview provides a mock function for the type MockQuerier
+func (_mock *MockQuerier) UpsertMatchReview(ctx context.Context, arg UpsertMatchReviewParams) (MatchReview, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertMatchReview")
+	}
+
+	var r0 MatchReview
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, UpsertMatchReviewParams) (MatchReview, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, UpsertMatchReviewParams) MatchReview); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(MatchReview)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, UpsertMatchReviewParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuerier_UpsertMatchReview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertMatchReview'
+type MockQuerier_UpsertMatchReview_Call struct {
+	*mock.Call
+}
+
+// UpsertMatchReview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg UpsertMatchReviewParams
+func (_e *MockQuerier_Expecter) UpsertMatchReview(ctx interface{}, arg interface{}) *MockQuerier_UpsertMatchReview_Call {
+	return &MockQuerier_UpsertMatchReview_Call{Call: _e.mock.On("UpsertMatchReview", ctx, arg)}
+}
+
+func (_c *MockQuerier_UpsertMatchReview_Call) Run(run func(ctx context.Context, arg UpsertMatchReviewParams)) *MockQuerier_UpsertMatchReview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 UpsertMatchReviewParams
+		if args[1] != nil {
+			arg1 = args[1].(UpsertMatchReviewParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpsertMatchReview_Call) Return(matchReview MatchReview, err error) *MockQuerier_UpsertMatchReview_Call {
+	_c.Call.Return(matchReview, err)
+	return _c
+}
+
+func (_c *MockQuerier_UpsertMatchReview_Call) RunAndReturn(run func(ctx context.Context, arg UpsertMatchReviewParams) (MatchReview, error)) *MockQuerier_UpsertMatchReview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertSeries provides a mock function for the type MockQuerier
 func (_mock *MockQuerier) UpsertSeries(ctx context.Context, arg UpsertSeriesParams) (Series, error) {
 	ret := _mock.Called(ctx, arg)
@@ -4,29 +4,41 @@
 
 package db
 
+import (
+	"time"
+)
+
 type Author struct {
-	ID   int64  `json:"id"`
-	Name string `json:"name"`
+	ID          int64   `json:"id"`
+	Name        string  `json:"name"`
+	GoodreadsID *string `json:"goodreads_id"`
 }
 
 type Book struct {
-	ID              int64       `json:"id"`
-	BookID          int64       `json:"book_id"`
-	Title           string      `json:"title"`
-	Description     string      `json:"description"`
-	SeriesName      *string     `json:"series_name"`
-	SeriesNumber    *float64    `json:"series_number"`
-	Asin            *string     `json:"asin"`
-	Isbn10          *string     `json:"isbn10"`
-	Isbn13          *string     `json:"isbn13"`
-	Language        *string     `json:"language"`
-	HardcoverID     *string     `json:"hardcover_id"`
-	HardcoverBookID *int64      `json:"hardcover_book_id"`
-	GoodreadsID     *string     `json:"goodreads_id"`
-	GoogleID        *string     `json:"google_id"`
-	Data            interface{} `json:"data"`
-	SeriesID        *int64      `json:"series_id"`
-	IsMissing       *bool       `json:"is_missing"`
+	ID                  int64       `json:"id"`
+	BookID              int64       `json:"book_id"`
+	Title               string      `json:"title"`
+	Description         string      `json:"description"`
+	SeriesName          *string     `json:"series_name"`
+	SeriesNumber        *float64    `json:"series_number"`
+	Asin                *string     `json:"asin"`
+	Isbn10              *string     `json:"isbn10"`
+	Isbn13              *string     `json:"isbn13"`
+	Language            *string     `json:"language"`
+	HardcoverID         *string     `json:"hardcover_id"`
+	HardcoverBookID     *int64      `json:"hardcover_book_id"`
+	GoodreadsID         *string     `json:"goodreads_id"`
+	GoogleID            *string     `json:"google_id"`
+	Data                interface{} `json:"data"`
+	SeriesID            *int64      `json:"series_id"`
+	IsMissing           *bool       `json:"is_missing"`
+	PublicationDate     *string     `json:"publication_date"`
+	ToBePublished       *bool       `json:"to_be_published"`
+	MetadataSource      *string     `json:"metadata_source"`
+	CoverUrl            *string     `json:"cover_url"`
+	GoodreadsWorkID     *string     `json:"goodreads_work_id"`
+	DescriptionMarkdown *string     `json:"description_markdown"`
+	DiscoveredAt        *time.Time  `json:"discovered_at"`
 }
 
 type BookAuthor struct {
@@ -34,25 +46,115 @@ type BookAuthor struct {
 	AuthorID int64 `json:"author_id"`
 }
 
+type BookLookup struct {
+	BookID             int64      `json:"book_id"`
+	GoodreadsCheckedAt *time.Time `json:"goodreads_checked_at"`
+	EnrichCheckedAt    *time.Time `json:"enrich_checked_at"`
+	WorkIDCheckedAt    *time.Time `json:"work_id_checked_at"`
+}
+
+type CompletionRun struct {
+	ID          int64      `json:"id"`
+	Status      string     `json:"status"`
+	Provider    string     `json:"provider"`
+	StaleDays   int64      `json:"stale_days"`
+	Concurrency int64      `json:"concurrency"`
+	CreatedAt   time.Time  `json:"created_at"`
+	FinishedAt  *time.Time `json:"finished_at"`
+}
+
+type CompletionRunSeries struct {
+	RunID           int64      `json:"run_id"`
+	SeriesID        int64      `json:"series_id"`
+	Status          string     `json:"status"`
+	NewMissingBooks int64      `json:"new_missing_books"`
+	ReviewBooks     int64      `json:"review_books"`
+	Error           *string    `json:"error"`
+	CheckedAt       *time.Time `json:"checked_at"`
+}
+
 type Configuration struct {
 	Key   string `json:"key"`
 	Value string `json:"value"`
 }
 
+type Job struct {
+	ID             int64      `json:"id"`
+	Kind           string     `json:"kind"`
+	Payload        string     `json:"payload"`
+	Status         string     `json:"status"`
+	Attempts       int64      `json:"attempts"`
+	MaxAttempts    int64      `json:"max_attempts"`
+	RunAt          time.Time  `json:"run_at"`
+	LeaseExpiresAt *time.Time `json:"lease_expires_at"`
+	LastError      *string    `json:"last_error"`
+	CreatedAt      time.Time  `json:"created_at"`
+	FinishedAt     *time.Time `json:"finished_at"`
+}
+
+type LibrarySnapshot struct {
+	ID                      int64     `json:"id"`
+	TakenOn                 string    `json:"taken_on"`
+	TotalBooks              int64     `json:"total_books"`
+	OwnedBooks              int64     `json:"owned_books"`
+	MissingBooks            int64     `json:"missing_books"`
+	UnreleasedBooks         int64     `json:"unreleased_books"`
+	TotalSeries             int64     `json:"total_series"`
+	CompleteSeries          int64     `json:"complete_series"`
+	IncompleteSeries        int64     `json:"incomplete_series"`
+	BooksWithoutIdentifiers int64     `json:"books_without_identifiers"`
+	TakenAt                 time.Time `json:"taken_at"`
+}
+
+type MatchReview struct {
+	ID              int64   `json:"id"`
+	SeriesID        int64   `json:"series_id"`
+	OwnedBookID     int64   `json:"owned_book_id"`
+	CandidateBookID int64   `json:"candidate_book_id"`
+	CandidateTitle  string  `json:"candidate_title"`
+	Candidate       string  `json:"candidate"`
+	Score           float64 `json:"score"`
+	Status          string  `json:"status"`
+}
+
 type SchemaMigration struct {
 	Version string `json:"version"`
 }
 
 type Series struct {
-	ID          int64       `json:"id"`
-	SeriesID    int64       `json:"series_id"`
-	Name        string      `json:"name"`
-	Description *string     `json:"description"`
-	Url         *string     `json:"url"`
-	Data        interface{} `json:"data"`
+	ID              int64       `json:"id"`
+	SeriesID        int64       `json:"series_id"`
+	Name            string      `json:"name"`
+	Description     *string     `json:"description"`
+	Url             *string     `json:"url"`
+	Data            interface{} `json:"data"`
+	LastCheckedAt   *time.Time  `json:"last_checked_at"`
+	LastCheckStatus *string     `json:"last_check_status"`
+	LastError       *string     `json:"last_error"`
+	Followed        bool        `json:"followed"`
 }
 
 type SeriesAuthor struct {
 	SeriesID int64 `json:"series_id"`
 	AuthorID int64 `json:"author_id"`
 }
+
+type SeriesChange struct {
+	ID          int64     `json:"id"`
+	SeriesID    int64     `json:"series_id"`
+	SnapshotID  int64     `json:"snapshot_id"`
+	Kind        string    `json:"kind"`
+	Title       string    `json:"title"`
+	GoodreadsID *string   `json:"goodreads_id"`
+	OldPosition *string   `json:"old_position"`
+	NewPosition *string   `json:"new_position"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type SeriesSnapshot struct {
+	ID        int64     `json:"id"`
+	SeriesID  int64     `json:"series_id"`
+	Provider  string    `json:"provider"`
+	Entries   string    `json:"entries"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -9,32 +9,102 @@ import (
 )
 
 type Querier interface {
-	CountBooks(ctx context.Context) (int64, error)
-	CountSeries(ctx context.Context) (int64, error)
+	AddCompletionRunSeries(ctx context.Context, arg AddCompletionRunSeriesParams) (int64, error)
+	ClaimJob(ctx context.Context, leaseSeconds int64) (Job, error)
+	CompleteJob(ctx context.Context, arg CompleteJobParams) (int64, error)
+	CountAuthors(ctx context.Context) (int64, error)
+	CountBooks(ctx context.Context, arg CountBooksParams) (int64, error)
+	CountJobsByStatus(ctx context.Context) ([]CountJobsByStatusRow, error)
+	CountSeries(ctx context.Context, arg CountSeriesParams) (int64, error)
+	CountStaleSeries(ctx context.Context, staleDays int64) (int64, error)
 	CreateBook(ctx context.Context, arg CreateBookParams) (Book, error)
+	CreateCompletionRun(ctx context.Context, arg CreateCompletionRunParams) (CompletionRun, error)
 	CreateMissingBook(ctx context.Context, arg CreateMissingBookParams) (Book, error)
 	CreateSeries(ctx context.Context, arg CreateSeriesParams) (Series, error)
+	CreateSeriesChange(ctx context.Context, arg CreateSeriesChangeParams) error
+	CreateSeriesSnapshot(ctx context.Context, arg CreateSeriesSnapshotParams) (SeriesSnapshot, error)
+	DeadLetterJob(ctx context.Context, arg DeadLetterJobParams) (int64, error)
+	DecideMatchReview(ctx context.Context, arg DecideMatchReviewParams) (int64, error)
+	EnqueueJob(ctx context.Context, arg EnqueueJobParams) (Job, error)
+	EnrichBook(ctx context.Context, arg EnrichBookParams) error
+	ExtendJobLease(ctx context.Context, arg ExtendJobLeaseParams) (int64, error)
+	FinishCompletionRun(ctx context.Context, arg FinishCompletionRunParams) error
+	FinishCompletionRunSeries(ctx context.Context, arg FinishCompletionRunSeriesParams) error
+	GetAuthor(ctx context.Context, id int64) (Author, error)
 	GetAuthorByName(ctx context.Context, name string) (Author, error)
 	GetAuthorsForBook(ctx context.Context, bookID int64) ([]Author, error)
 	GetAuthorsForMultipleSeries(ctx context.Context, seriesIds []int64) ([]GetAuthorsForMultipleSeriesRow, error)
 	GetBook(ctx context.Context, id int64) (Book, error)
 	GetBookByBookID(ctx context.Context, bookID int64) (Book, error)
+	GetBooksByAuthor(ctx context.Context, authorID int64) ([]Book, error)
 	GetBooksBySeries(ctx context.Context, seriesID *int64) ([]Book, error)
+	GetCompletionRun(ctx context.Context, id int64) (CompletionRun, error)
 	GetConfig(ctx context.Context, key string) (string, error)
+	GetJob(ctx context.Context, id int64) (Job, error)
+	GetLatestSeriesSnapshot(ctx context.Context, arg GetLatestSeriesSnapshotParams) (SeriesSnapshot, error)
+	GetLibraryStats(ctx context.Context) (GetLibraryStatsRow, error)
+	GetMatchReview(ctx context.Context, id int64) (MatchReview, error)
 	GetMultipleConfig(ctx context.Context, keys []string) ([]Configuration, error)
 	GetSeries(ctx context.Context, id int64) (Series, error)
 	GetSeriesAuthors(ctx context.Context, seriesID int64) ([]Author, error)
 	GetSeriesByGoodreadsID(ctx context.Context, seriesID int64) (Series, error)
 	GetSeriesBySeriesID(ctx context.Context, seriesID int64) (Series, error)
+	GetSeriesCompletionDistribution(ctx context.Context) ([]GetSeriesCompletionDistributionRow, error)
+	GetSeriesForAuthor(ctx context.Context, authorID int64) ([]GetSeriesForAuthorRow, error)
+	ImportBook(ctx context.Context, arg ImportBookParams) (Book, error)
+	ImportSeries(ctx context.Context, arg ImportSeriesParams) (Series, error)
+	InterruptCompletionRuns(ctx context.Context) error
 	LinkBookAuthor(ctx context.Context, arg LinkBookAuthorParams) error
 	LinkSeriesAuthor(ctx context.Context, arg LinkSeriesAuthorParams) error
+	ListAllAuthors(ctx context.Context) ([]Author, error)
+	ListAllBooks(ctx context.Context) ([]Book, error)
+	ListAllSeries(ctx context.Context) ([]Series, error)
+	ListAuthorsWithBookStats(ctx context.Context, arg ListAuthorsWithBookStatsParams) ([]ListAuthorsWithBookStatsRow, error)
+	ListAuthorsWithGoodreadsID(ctx context.Context) ([]Author, error)
+	ListBookAuthorNames(ctx context.Context) ([]ListBookAuthorNamesRow, error)
 	ListBooks(ctx context.Context, arg ListBooksParams) ([]Book, error)
+	ListBooksWithoutGoodreadsID(ctx context.Context, arg ListBooksWithoutGoodreadsIDParams) ([]Book, error)
+	ListCompletionRunSeries(ctx context.Context, runID int64) ([]ListCompletionRunSeriesRow, error)
+	ListCompletionRuns(ctx context.Context, limit int64) ([]ListCompletionRunsRow, error)
+	ListConfig(ctx context.Context) ([]Configuration, error)
+	ListJobs(ctx context.Context, arg ListJobsParams) ([]Job, error)
+	ListLibrarySnapshots(ctx context.Context, takenOn string) ([]LibrarySnapshot, error)
+	ListMatchReviews(ctx context.Context, status string) ([]ListMatchReviewsRow, error)
+	ListMatchReviewsForExport(ctx context.Context) ([]ListMatchReviewsForExportRow, error)
+	ListMatchReviewsForSeries(ctx context.Context, seriesID int64) ([]MatchReview, error)
+	ListMissingBooksForExport(ctx context.Context, arg ListMissingBooksForExportParams) ([]ListMissingBooksForExportRow, error)
+	ListMissingBooksToEnrich(ctx context.Context, arg ListMissingBooksToEnrichParams) ([]Book, error)
+	ListPendingCompletionRunSeries(ctx context.Context, runID int64) ([]int64, error)
+	ListRecentWorkIDLookups(ctx context.Context, arg ListRecentWorkIDLookupsParams) ([]int64, error)
+	ListRecentlyDiscoveredBooks(ctx context.Context, limit int64) ([]Book, error)
+	ListRecentlyDiscoveredUpcomingBooks(ctx context.Context, limit int64) ([]Book, error)
 	ListSeries(ctx context.Context, arg ListSeriesParams) ([]Series, error)
+	ListSeriesAuthorNames(ctx context.Context) ([]ListSeriesAuthorNamesRow, error)
+	ListSeriesChanges(ctx context.Context, arg ListSeriesChangesParams) ([]SeriesChange, error)
+	ListSeriesIdentifiers(ctx context.Context) ([]ListSeriesIdentifiersRow, error)
 	ListSeriesWithBookStats(ctx context.Context, arg ListSeriesWithBookStatsParams) ([]ListSeriesWithBookStatsRow, error)
+	ListTopAuthorsByMissingBooks(ctx context.Context, limit int64) ([]ListTopAuthorsByMissingBooksRow, error)
+	ListUpcomingBooks(ctx context.Context) ([]Book, error)
+	MarkEnrichLookup(ctx context.Context, bookID int64) error
+	MarkGoodreadsIDLookup(ctx context.Context, bookID int64) error
+	MarkWorkIDLookup(ctx context.Context, bookID int64) error
+	ReleaseJob(ctx context.Context, arg ReleaseJobParams) (int64, error)
+	RequeueJob(ctx context.Context, id int64) (int64, error)
+	ResumeCompletionRun(ctx context.Context, id int64) error
+	RetryJob(ctx context.Context, arg RetryJobParams) (int64, error)
+	SearchLibrary(ctx context.Context, arg SearchLibraryParams) ([]SearchLibraryRow, error)
+	SetAuthorGoodreadsID(ctx context.Context, arg SetAuthorGoodreadsIDParams) error
+	SetBookGoodreadsID(ctx context.Context, arg SetBookGoodreadsIDParams) error
+	SetBookGoodreadsWorkID(ctx context.Context, arg SetBookGoodreadsWorkIDParams) error
 	SetConfig(ctx context.Context, arg SetConfigParams) error
+	SetMatchReviewStatus(ctx context.Context, arg SetMatchReviewStatusParams) error
+	SetSeriesCheckResult(ctx context.Context, arg SetSeriesCheckResultParams) error
+	SetSeriesFollowed(ctx context.Context, arg SetSeriesFollowedParams) (int64, error)
 	UpdateBookSeries(ctx context.Context, arg UpdateBookSeriesParams) error
 	UpsertAuthor(ctx context.Context, name string) (Author, error)
 	UpsertBook(ctx context.Context, arg UpsertBookParams) (Book, error)
+	UpsertLibrarySnapshot(ctx context.Context, arg UpsertLibrarySnapshotParams) (LibrarySnapshot, error)
+	UpsertMatchReview(ctx context.Context, arg UpsertMatchReviewParams) (MatchReview, error)
 	UpsertSeries(ctx context.Context, arg UpsertSeriesParams) (Series, error)
 }
 
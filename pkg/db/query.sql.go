@@ -8,25 +8,205 @@ package db
 import (
 	"context"
 	"strings"
+	"time"
 )
 
+const addCompletionRunSeries = `-- name: AddCompletionRunSeries :execrows
+INSERT INTO completion_run_series (run_id, series_id)
+SELECT ?, s.id FROM series s
+WHERE s.series_id != 0
+  AND (s.last_checked_at IS NULL OR s.last_checked_at <= datetime('now', '-' || ? || ' days'))
+`
+
+type AddCompletionRunSeriesParams struct {
+	RunID     int64 `json:"run_id"`
+	StaleDays int64 `json:"stale_days"`
+}
+
+func (q *Queries) AddCompletionRunSeries(ctx context.Context, arg AddCompletionRunSeriesParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, addCompletionRunSeries, arg.RunID, arg.StaleDays)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const claimJob = `-- name: ClaimJob :one
+UPDATE jobs
+SET status = 'running',
+    attempts = attempts + 1,
+    lease_expires_at = datetime('now', '+' || ? || ' seconds')
+WHERE id = (
+    SELECT id FROM jobs
+    WHERE (status = 'queued' AND run_at <= CURRENT_TIMESTAMP)
+       OR (status = 'running' AND lease_expires_at <= CURRENT_TIMESTAMP)
+    ORDER BY run_at ASC, id ASC
+    LIMIT 1
+)
+RETURNING id, kind, payload, status, attempts, max_attempts, run_at, lease_expires_at, last_error, created_at, finished_at
+`
+
+func (q *Queries) ClaimJob(ctx context.Context, leaseSeconds int64) (Job, error) {
+	row := q.db.QueryRowContext(ctx, claimJob, leaseSeconds)
+	var i Job
+	err := row.Scan(
+		&i.ID,
+		&i.Kind,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.RunAt,
+		&i.LeaseExpiresAt,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const completeJob = `-- name: CompleteJob :execrows
+UPDATE jobs
+SET status = 'succeeded', lease_expires_at = NULL, last_error = NULL, finished_at = CURRENT_TIMESTAMP
+WHERE id = ? AND status = 'running' AND attempts = ?
+`
+
+type CompleteJobParams struct {
+	ID       int64 `json:"id"`
+	Attempts int64 `json:"attempts"`
+}
+
+func (q *Queries) CompleteJob(ctx context.Context, arg CompleteJobParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, completeJob, arg.ID, arg.Attempts)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const countAuthors = `-- name: CountAuthors :one
+SELECT COUNT(*) AS count FROM authors
+`
+
+func (q *Queries) CountAuthors(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countAuthors)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countBooks = `-- name: CountBooks :one
 SELECT COUNT(*) AS count FROM books
+WHERE (? IS NULL OR COALESCE(is_missing, 0) = ?)
+  AND (? IS NULL OR series_id = ?)
+  AND (? IS NULL OR id IN (SELECT ba.book_id FROM book_authors ba WHERE ba.author_id = ?))
+  AND (? IS NULL OR language = ?)
+  AND (? IS NULL OR (COALESCE(goodreads_id, '') != '') = ?)
+  AND (? IS NULL OR title LIKE '%' || ? || '%')
 `
 
-func (q *Queries) CountBooks(ctx context.Context) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countBooks)
+type CountBooksParams struct {
+	Missing        *bool   `json:"missing"`
+	SeriesID       *int64  `json:"series_id"`
+	AuthorID       *int64  `json:"author_id"`
+	Language       *string `json:"language"`
+	HasGoodreadsID *bool   `json:"has_goodreads_id"`
+	Title          *string `json:"title"`
+}
+
+func (q *Queries) CountBooks(ctx context.Context, arg CountBooksParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countBooks,
+		arg.Missing,
+		arg.Missing,
+		arg.SeriesID,
+		arg.SeriesID,
+		arg.AuthorID,
+		arg.AuthorID,
+		arg.Language,
+		arg.Language,
+		arg.HasGoodreadsID,
+		arg.HasGoodreadsID,
+		arg.Title,
+		arg.Title,
+	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
+const countJobsByStatus = `-- name: CountJobsByStatus :many
+SELECT status, COUNT(*) AS count FROM jobs
+GROUP BY status
+ORDER BY status ASC
+`
+
+type CountJobsByStatusRow struct {
+	Status string `json:"status"`
+	Count  int64  `json:"count"`
+}
+
+func (q *Queries) CountJobsByStatus(ctx context.Context) ([]CountJobsByStatusRow, error) {
+	rows, err := q.db.QueryContext(ctx, countJobsByStatus)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountJobsByStatusRow
+	for rows.Next() {
+		var i CountJobsByStatusRow
+		if err := rows.Scan(&i.Status, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const countSeries = `-- name: CountSeries :one
+SELECT COUNT(*) AS count FROM series s
+WHERE (? IS NULL OR EXISTS (SELECT 1 FROM books mb WHERE mb.series_id = s.id AND mb.is_missing = 1) = ?)
+  AND (? IS NULL OR s.id IN (SELECT sa.series_id FROM series_authors sa WHERE sa.author_id = ?))
+  AND (? IS NULL OR (s.series_id = 0) = ?)
+  AND (? IS NULL OR s.name LIKE '%' || ? || '%')
+`
+
+type CountSeriesParams struct {
+	Incomplete *bool   `json:"incomplete"`
+	AuthorID   *int64  `json:"author_id"`
+	Unmapped   *bool   `json:"unmapped"`
+	Name       *string `json:"name"`
+}
+
+func (q *Queries) CountSeries(ctx context.Context, arg CountSeriesParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countSeries,
+		arg.Incomplete,
+		arg.Incomplete,
+		arg.AuthorID,
+		arg.AuthorID,
+		arg.Unmapped,
+		arg.Unmapped,
+		arg.Name,
+		arg.Name,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countStaleSeries = `-- name: CountStaleSeries :one
 SELECT COUNT(*) AS count FROM series
+WHERE series_id != 0
+  AND (last_checked_at IS NULL OR last_checked_at <= datetime('now', '-' || ? || ' days'))
 `
 
-func (q *Queries) CountSeries(ctx context.Context) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countSeries)
+func (q *Queries) CountStaleSeries(ctx context.Context, staleDays int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countStaleSeries, staleDays)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -35,7 +215,7 @@ func (q *Queries) CountSeries(ctx context.Context) (int64, error) {
 const createBook = `-- name: CreateBook :one
 INSERT INTO books (book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, is_missing)
 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, series_id, is_missing
+RETURNING id, book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, series_id, is_missing, publication_date, to_be_published, metadata_source, cover_url, goodreads_work_id, description_markdown, discovered_at
 `
 
 type CreateBookParams struct {
@@ -93,32 +273,91 @@ func (q *Queries) CreateBook(ctx context.Context, arg CreateBookParams) (Book, e
 		&i.Data,
 		&i.SeriesID,
 		&i.IsMissing,
+		&i.PublicationDate,
+		&i.ToBePublished,
+		&i.MetadataSource,
+		&i.CoverUrl,
+		&i.GoodreadsWorkID,
+		&i.DescriptionMarkdown,
+		&i.DiscoveredAt,
+	)
+	return i, err
+}
+
+const createCompletionRun = `-- name: CreateCompletionRun :one
+INSERT INTO completion_runs (provider, stale_days, concurrency)
+VALUES (?, ?, ?)
+RETURNING id, status, provider, stale_days, concurrency, created_at, finished_at
+`
+
+type CreateCompletionRunParams struct {
+	Provider    string `json:"provider"`
+	StaleDays   int64  `json:"stale_days"`
+	Concurrency int64  `json:"concurrency"`
+}
+
+func (q *Queries) CreateCompletionRun(ctx context.Context, arg CreateCompletionRunParams) (CompletionRun, error) {
+	row := q.db.QueryRowContext(ctx, createCompletionRun, arg.Provider, arg.StaleDays, arg.Concurrency)
+	var i CompletionRun
+	err := row.Scan(
+		&i.ID,
+		&i.Status,
+		&i.Provider,
+		&i.StaleDays,
+		&i.Concurrency,
+		&i.CreatedAt,
+		&i.FinishedAt,
 	)
 	return i, err
 }
 
 const createMissingBook = `-- name: CreateMissingBook :one
-INSERT INTO books (book_id, title, description, series_name, series_number, goodreads_id, series_id, is_missing)
-VALUES (?, ?, ?, ?, ?, ?, ?, 1)
+INSERT INTO books (book_id, title, description, description_markdown, series_name, series_number, asin, isbn10, isbn13, hardcover_id, hardcover_book_id, goodreads_id, google_id, series_id, publication_date, to_be_published, metadata_source, cover_url, goodreads_work_id, is_missing, discovered_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 1, CURRENT_TIMESTAMP)
 ON CONFLICT(book_id) DO UPDATE SET
     title = excluded.title,
     description = excluded.description,
+    description_markdown = excluded.description_markdown,
     series_name = excluded.series_name,
     series_number = excluded.series_number,
+    asin = excluded.asin,
+    isbn10 = excluded.isbn10,
+    isbn13 = excluded.isbn13,
+    hardcover_id = excluded.hardcover_id,
+    hardcover_book_id = excluded.hardcover_book_id,
     goodreads_id = excluded.goodreads_id,
+    google_id = excluded.google_id,
     series_id = excluded.series_id,
-    is_missing = 1
-RETURNING id, book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, series_id, is_missing
+    publication_date = excluded.publication_date,
+    to_be_published = excluded.to_be_published,
+    metadata_source = excluded.metadata_source,
+    cover_url = excluded.cover_url,
+    goodreads_work_id = COALESCE(excluded.goodreads_work_id, goodreads_work_id),
+    is_missing = 1,
+    discovered_at = COALESCE(discovered_at, excluded.discovered_at)
+RETURNING id, book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, series_id, is_missing, publication_date, to_be_published, metadata_source, cover_url, goodreads_work_id, description_markdown, discovered_at
 `
 
 type CreateMissingBookParams struct {
-	BookID       int64    `json:"book_id"`
-	Title        string   `json:"title"`
-	Description  string   `json:"description"`
-	SeriesName   *string  `json:"series_name"`
-	SeriesNumber *float64 `json:"series_number"`
-	GoodreadsID  *string  `json:"goodreads_id"`
-	SeriesID     *int64   `json:"series_id"`
+	BookID              int64    `json:"book_id"`
+	Title               string   `json:"title"`
+	Description         string   `json:"description"`
+	DescriptionMarkdown *string  `json:"description_markdown"`
+	SeriesName          *string  `json:"series_name"`
+	SeriesNumber        *float64 `json:"series_number"`
+	Asin                *string  `json:"asin"`
+	Isbn10              *string  `json:"isbn10"`
+	Isbn13              *string  `json:"isbn13"`
+	HardcoverID         *string  `json:"hardcover_id"`
+	HardcoverBookID     *int64   `json:"hardcover_book_id"`
+	GoodreadsID         *string  `json:"goodreads_id"`
+	GoogleID            *string  `json:"google_id"`
+	SeriesID            *int64   `json:"series_id"`
+	PublicationDate     *string  `json:"publication_date"`
+	ToBePublished       *bool    `json:"to_be_published"`
+	MetadataSource      *string  `json:"metadata_source"`
+	CoverUrl            *string  `json:"cover_url"`
+	GoodreadsWorkID     *string  `json:"goodreads_work_id"`
 }
 
 func (q *Queries) CreateMissingBook(ctx context.Context, arg CreateMissingBookParams) (Book, error) {
@@ -126,10 +365,22 @@ func (q *Queries) CreateMissingBook(ctx context.Context, arg CreateMissingBookPa
 		arg.BookID,
 		arg.Title,
 		arg.Description,
+		arg.DescriptionMarkdown,
 		arg.SeriesName,
 		arg.SeriesNumber,
+		arg.Asin,
+		arg.Isbn10,
+		arg.Isbn13,
+		arg.HardcoverID,
+		arg.HardcoverBookID,
 		arg.GoodreadsID,
+		arg.GoogleID,
 		arg.SeriesID,
+		arg.PublicationDate,
+		arg.ToBePublished,
+		arg.MetadataSource,
+		arg.CoverUrl,
+		arg.GoodreadsWorkID,
 	)
 	var i Book
 	err := row.Scan(
@@ -150,6 +401,13 @@ func (q *Queries) CreateMissingBook(ctx context.Context, arg CreateMissingBookPa
 		&i.Data,
 		&i.SeriesID,
 		&i.IsMissing,
+		&i.PublicationDate,
+		&i.ToBePublished,
+		&i.MetadataSource,
+		&i.CoverUrl,
+		&i.GoodreadsWorkID,
+		&i.DescriptionMarkdown,
+		&i.DiscoveredAt,
 	)
 	return i, err
 }
@@ -157,7 +415,7 @@ func (q *Queries) CreateMissingBook(ctx context.Context, arg CreateMissingBookPa
 const createSeries = `-- name: CreateSeries :one
 INSERT INTO series (series_id, name, description, url, data)
 VALUES (?, ?, ?, ?, ?)
-RETURNING id, series_id, name, description, url, data
+RETURNING id, series_id, name, description, url, data, last_checked_at, last_check_status, last_error, followed
 `
 
 type CreateSeriesParams struct {
@@ -184,24 +442,263 @@ func (q *Queries) CreateSeries(ctx context.Context, arg CreateSeriesParams) (Ser
 		&i.Description,
 		&i.Url,
 		&i.Data,
+		&i.LastCheckedAt,
+		&i.LastCheckStatus,
+		&i.LastError,
+		&i.Followed,
+	)
+	return i, err
+}
+
+const createSeriesChange = `-- name: CreateSeriesChange :exec
+INSERT INTO series_changes (series_id, snapshot_id, kind, title, goodreads_id, old_position, new_position)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateSeriesChangeParams struct {
+	SeriesID    int64   `json:"series_id"`
+	SnapshotID  int64   `json:"snapshot_id"`
+	Kind        string  `json:"kind"`
+	Title       string  `json:"title"`
+	GoodreadsID *string `json:"goodreads_id"`
+	OldPosition *string `json:"old_position"`
+	NewPosition *string `json:"new_position"`
+}
+
+func (q *Queries) CreateSeriesChange(ctx context.Context, arg CreateSeriesChangeParams) error {
+	_, err := q.db.ExecContext(ctx, createSeriesChange,
+		arg.SeriesID,
+		arg.SnapshotID,
+		arg.Kind,
+		arg.Title,
+		arg.GoodreadsID,
+		arg.OldPosition,
+		arg.NewPosition,
+	)
+	return err
+}
+
+const createSeriesSnapshot = `-- name: CreateSeriesSnapshot :one
+INSERT INTO series_snapshots (series_id, provider, entries)
+VALUES (?, ?, ?)
+RETURNING id, series_id, provider, entries, created_at
+`
+
+type CreateSeriesSnapshotParams struct {
+	SeriesID int64  `json:"series_id"`
+	Provider string `json:"provider"`
+	Entries  string `json:"entries"`
+}
+
+func (q *Queries) CreateSeriesSnapshot(ctx context.Context, arg CreateSeriesSnapshotParams) (SeriesSnapshot, error) {
+	row := q.db.QueryRowContext(ctx, createSeriesSnapshot, arg.SeriesID, arg.Provider, arg.Entries)
+	var i SeriesSnapshot
+	err := row.Scan(
+		&i.ID,
+		&i.SeriesID,
+		&i.Provider,
+		&i.Entries,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deadLetterJob = `-- name: DeadLetterJob :execrows
+UPDATE jobs
+SET status = 'dead', lease_expires_at = NULL, last_error = ?, finished_at = CURRENT_TIMESTAMP
+WHERE id = ? AND status = 'running' AND attempts = ?
+`
+
+type DeadLetterJobParams struct {
+	LastError *string `json:"last_error"`
+	ID        int64   `json:"id"`
+	Attempts  int64   `json:"attempts"`
+}
+
+func (q *Queries) DeadLetterJob(ctx context.Context, arg DeadLetterJobParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deadLetterJob, arg.LastError, arg.ID, arg.Attempts)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const decideMatchReview = `-- name: DecideMatchReview :execrows
+UPDATE match_reviews
+SET status = ?
+WHERE id = ? AND status = 'pending'
+`
+
+type DecideMatchReviewParams struct {
+	Status string `json:"status"`
+	ID     int64  `json:"id"`
+}
+
+func (q *Queries) DecideMatchReview(ctx context.Context, arg DecideMatchReviewParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, decideMatchReview, arg.Status, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const enqueueJob = `-- name: EnqueueJob :one
+INSERT INTO jobs (kind, payload, max_attempts, run_at)
+VALUES (?, ?, ?, datetime('now', '+' || ? || ' seconds'))
+RETURNING id, kind, payload, status, attempts, max_attempts, run_at, lease_expires_at, last_error, created_at, finished_at
+`
+
+type EnqueueJobParams struct {
+	Kind         string `json:"kind"`
+	Payload      string `json:"payload"`
+	MaxAttempts  int64  `json:"max_attempts"`
+	DelaySeconds int64  `json:"delay_seconds"`
+}
+
+func (q *Queries) EnqueueJob(ctx context.Context, arg EnqueueJobParams) (Job, error) {
+	row := q.db.QueryRowContext(ctx, enqueueJob,
+		arg.Kind,
+		arg.Payload,
+		arg.MaxAttempts,
+		arg.DelaySeconds,
+	)
+	var i Job
+	err := row.Scan(
+		&i.ID,
+		&i.Kind,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.RunAt,
+		&i.LeaseExpiresAt,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const enrichBook = `-- name: EnrichBook :exec
+UPDATE books
+SET cover_url = COALESCE(cover_url, ?),
+    description = CASE WHEN description = '' THEN ? ELSE description END,
+    description_markdown = CASE WHEN description = '' THEN ? ELSE description_markdown END,
+    publication_date = COALESCE(publication_date, ?),
+    goodreads_id = COALESCE(NULLIF(goodreads_id, ''), ?)
+WHERE id = ?
+`
+
+type EnrichBookParams struct {
+	CoverUrl            *string `json:"cover_url"`
+	Description         string  `json:"description"`
+	DescriptionMarkdown *string `json:"description_markdown"`
+	PublicationDate     *string `json:"publication_date"`
+	GoodreadsID         *string `json:"goodreads_id"`
+	ID                  int64   `json:"id"`
+}
+
+func (q *Queries) EnrichBook(ctx context.Context, arg EnrichBookParams) error {
+	_, err := q.db.ExecContext(ctx, enrichBook,
+		arg.CoverUrl,
+		arg.Description,
+		arg.DescriptionMarkdown,
+		arg.PublicationDate,
+		arg.GoodreadsID,
+		arg.ID,
+	)
+	return err
+}
+
+const extendJobLease = `-- name: ExtendJobLease :execrows
+UPDATE jobs
+SET lease_expires_at = datetime('now', '+' || ? || ' seconds')
+WHERE id = ? AND status = 'running' AND attempts = ?
+`
+
+type ExtendJobLeaseParams struct {
+	LeaseSeconds int64 `json:"lease_seconds"`
+	ID           int64 `json:"id"`
+	Attempts     int64 `json:"attempts"`
+}
+
+func (q *Queries) ExtendJobLease(ctx context.Context, arg ExtendJobLeaseParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, extendJobLease, arg.LeaseSeconds, arg.ID, arg.Attempts)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const finishCompletionRun = `-- name: FinishCompletionRun :exec
+UPDATE completion_runs
+SET status = ?, finished_at = CURRENT_TIMESTAMP
+WHERE id = ?
+`
+
+type FinishCompletionRunParams struct {
+	Status string `json:"status"`
+	ID     int64  `json:"id"`
+}
+
+func (q *Queries) FinishCompletionRun(ctx context.Context, arg FinishCompletionRunParams) error {
+	_, err := q.db.ExecContext(ctx, finishCompletionRun, arg.Status, arg.ID)
+	return err
+}
+
+const finishCompletionRunSeries = `-- name: FinishCompletionRunSeries :exec
+UPDATE completion_run_series
+SET status = ?, new_missing_books = ?, review_books = ?, error = ?, checked_at = CURRENT_TIMESTAMP
+WHERE run_id = ? AND series_id = ?
+`
+
+type FinishCompletionRunSeriesParams struct {
+	Status          string  `json:"status"`
+	NewMissingBooks int64   `json:"new_missing_books"`
+	ReviewBooks     int64   `json:"review_books"`
+	Error           *string `json:"error"`
+	RunID           int64   `json:"run_id"`
+	SeriesID        int64   `json:"series_id"`
+}
+
+func (q *Queries) FinishCompletionRunSeries(ctx context.Context, arg FinishCompletionRunSeriesParams) error {
+	_, err := q.db.ExecContext(ctx, finishCompletionRunSeries,
+		arg.Status,
+		arg.NewMissingBooks,
+		arg.ReviewBooks,
+		arg.Error,
+		arg.RunID,
+		arg.SeriesID,
 	)
+	return err
+}
+
+const getAuthor = `-- name: GetAuthor :one
+SELECT id, name, goodreads_id FROM authors
+WHERE id = ? LIMIT 1
+`
+
+func (q *Queries) GetAuthor(ctx context.Context, id int64) (Author, error) {
+	row := q.db.QueryRowContext(ctx, getAuthor, id)
+	var i Author
+	err := row.Scan(&i.ID, &i.Name, &i.GoodreadsID)
 	return i, err
 }
 
 const getAuthorByName = `-- name: GetAuthorByName :one
-SELECT id, name FROM authors
+SELECT id, name, goodreads_id FROM authors
 WHERE name = ? LIMIT 1
 `
 
 func (q *Queries) GetAuthorByName(ctx context.Context, name string) (Author, error) {
 	row := q.db.QueryRowContext(ctx, getAuthorByName, name)
 	var i Author
-	err := row.Scan(&i.ID, &i.Name)
+	err := row.Scan(&i.ID, &i.Name, &i.GoodreadsID)
 	return i, err
 }
 
 const getAuthorsForBook = `-- name: GetAuthorsForBook :many
-SELECT a.id, a.name FROM authors a
+SELECT a.id, a.name, a.goodreads_id FROM authors a
 JOIN book_authors ba ON a.id = ba.author_id
 WHERE ba.book_id = ?
 ORDER BY a.name ASC
@@ -216,7 +713,7 @@ func (q *Queries) GetAuthorsForBook(ctx context.Context, bookID int64) ([]Author
 	var items []Author
 	for rows.Next() {
 		var i Author
-		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+		if err := rows.Scan(&i.ID, &i.Name, &i.GoodreadsID); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -277,7 +774,7 @@ func (q *Queries) GetAuthorsForMultipleSeries(ctx context.Context, seriesIds []i
 }
 
 const getBook = `-- name: GetBook :one
-SELECT id, book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, series_id, is_missing FROM books
+SELECT id, book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, series_id, is_missing, publication_date, to_be_published, metadata_source, cover_url, goodreads_work_id, description_markdown, discovered_at FROM books
 WHERE id = ? LIMIT 1
 `
 
@@ -302,12 +799,19 @@ func (q *Queries) GetBook(ctx context.Context, id int64) (Book, error) {
 		&i.Data,
 		&i.SeriesID,
 		&i.IsMissing,
+		&i.PublicationDate,
+		&i.ToBePublished,
+		&i.MetadataSource,
+		&i.CoverUrl,
+		&i.GoodreadsWorkID,
+		&i.DescriptionMarkdown,
+		&i.DiscoveredAt,
 	)
 	return i, err
 }
 
 const getBookByBookID = `-- name: GetBookByBookID :one
-SELECT id, book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, series_id, is_missing FROM books
+SELECT id, book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, series_id, is_missing, publication_date, to_be_published, metadata_source, cover_url, goodreads_work_id, description_markdown, discovered_at FROM books
 WHERE book_id = ? LIMIT 1
 `
 
@@ -332,18 +836,26 @@ func (q *Queries) GetBookByBookID(ctx context.Context, bookID int64) (Book, erro
 		&i.Data,
 		&i.SeriesID,
 		&i.IsMissing,
+		&i.PublicationDate,
+		&i.ToBePublished,
+		&i.MetadataSource,
+		&i.CoverUrl,
+		&i.GoodreadsWorkID,
+		&i.DescriptionMarkdown,
+		&i.DiscoveredAt,
 	)
 	return i, err
 }
 
-const getBooksBySeries = `-- name: GetBooksBySeries :many
-SELECT id, book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, series_id, is_missing FROM books
-WHERE series_id = ?
-ORDER BY series_number ASC
+const getBooksByAuthor = `-- name: GetBooksByAuthor :many
+SELECT b.id, b.book_id, b.title, b.description, b.series_name, b.series_number, b.asin, b.isbn10, b.isbn13, b.language, b.hardcover_id, b.hardcover_book_id, b.goodreads_id, b.google_id, b.data, b.series_id, b.is_missing, b.publication_date, b.to_be_published, b.metadata_source, b.cover_url, b.goodreads_work_id, b.description_markdown, b.discovered_at FROM books b
+JOIN book_authors ba ON ba.book_id = b.id
+WHERE ba.author_id = ?
+ORDER BY b.series_name COLLATE NOCASE ASC, b.series_number ASC, b.title COLLATE NOCASE ASC
 `
 
-func (q *Queries) GetBooksBySeries(ctx context.Context, seriesID *int64) ([]Book, error) {
-	rows, err := q.db.QueryContext(ctx, getBooksBySeries, seriesID)
+func (q *Queries) GetBooksByAuthor(ctx context.Context, authorID int64) ([]Book, error) {
+	rows, err := q.db.QueryContext(ctx, getBooksByAuthor, authorID)
 	if err != nil {
 		return nil, err
 	}
@@ -369,6 +881,13 @@ func (q *Queries) GetBooksBySeries(ctx context.Context, seriesID *int64) ([]Book
 			&i.Data,
 			&i.SeriesID,
 			&i.IsMissing,
+			&i.PublicationDate,
+			&i.ToBePublished,
+			&i.MetadataSource,
+			&i.CoverUrl,
+			&i.GoodreadsWorkID,
+			&i.DescriptionMarkdown,
+			&i.DiscoveredAt,
 		); err != nil {
 			return nil, err
 		}
@@ -383,43 +902,47 @@ func (q *Queries) GetBooksBySeries(ctx context.Context, seriesID *int64) ([]Book
 	return items, nil
 }
 
-const getConfig = `-- name: GetConfig :one
-SELECT value FROM configuration
-WHERE key = ? LIMIT 1
-`
-
-func (q *Queries) GetConfig(ctx context.Context, key string) (string, error) {
-	row := q.db.QueryRowContext(ctx, getConfig, key)
-	var value string
-	err := row.Scan(&value)
-	return value, err
-}
-
-const getMultipleConfig = `-- name: GetMultipleConfig :many
-SELECT key, value FROM configuration
-WHERE key IN (/*SLICE:keys*/?)
+const getBooksBySeries = `-- name: GetBooksBySeries :many
+SELECT id, book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, series_id, is_missing, publication_date, to_be_published, metadata_source, cover_url, goodreads_work_id, description_markdown, discovered_at FROM books
+WHERE series_id = ?
+ORDER BY series_number ASC
 `
 
-func (q *Queries) GetMultipleConfig(ctx context.Context, keys []string) ([]Configuration, error) {
-	query := getMultipleConfig
-	var queryParams []interface{}
-	if len(keys) > 0 {
-		for _, v := range keys {
-			queryParams = append(queryParams, v)
-		}
-		query = strings.Replace(query, "/*SLICE:keys*/?", strings.Repeat(",?", len(keys))[1:], 1)
-	} else {
-		query = strings.Replace(query, "/*SLICE:keys*/?", "NULL", 1)
-	}
-	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+func (q *Queries) GetBooksBySeries(ctx context.Context, seriesID *int64) ([]Book, error) {
+	rows, err := q.db.QueryContext(ctx, getBooksBySeries, seriesID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Configuration
+	var items []Book
 	for rows.Next() {
-		var i Configuration
-		if err := rows.Scan(&i.Key, &i.Value); err != nil {
+		var i Book
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.Title,
+			&i.Description,
+			&i.SeriesName,
+			&i.SeriesNumber,
+			&i.Asin,
+			&i.Isbn10,
+			&i.Isbn13,
+			&i.Language,
+			&i.HardcoverID,
+			&i.HardcoverBookID,
+			&i.GoodreadsID,
+			&i.GoogleID,
+			&i.Data,
+			&i.SeriesID,
+			&i.IsMissing,
+			&i.PublicationDate,
+			&i.ToBePublished,
+			&i.MetadataSource,
+			&i.CoverUrl,
+			&i.GoodreadsWorkID,
+			&i.DescriptionMarkdown,
+			&i.DiscoveredAt,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -433,14 +956,210 @@ func (q *Queries) GetMultipleConfig(ctx context.Context, keys []string) ([]Confi
 	return items, nil
 }
 
-const getSeries = `-- name: GetSeries :one
-SELECT id, series_id, name, description, url, data FROM series
+const getCompletionRun = `-- name: GetCompletionRun :one
+SELECT id, status, provider, stale_days, concurrency, created_at, finished_at FROM completion_runs
 WHERE id = ? LIMIT 1
 `
 
-func (q *Queries) GetSeries(ctx context.Context, id int64) (Series, error) {
-	row := q.db.QueryRowContext(ctx, getSeries, id)
-	var i Series
+func (q *Queries) GetCompletionRun(ctx context.Context, id int64) (CompletionRun, error) {
+	row := q.db.QueryRowContext(ctx, getCompletionRun, id)
+	var i CompletionRun
+	err := row.Scan(
+		&i.ID,
+		&i.Status,
+		&i.Provider,
+		&i.StaleDays,
+		&i.Concurrency,
+		&i.CreatedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const getConfig = `-- name: GetConfig :one
+SELECT value FROM configuration
+WHERE key = ? LIMIT 1
+`
+
+func (q *Queries) GetConfig(ctx context.Context, key string) (string, error) {
+	row := q.db.QueryRowContext(ctx, getConfig, key)
+	var value string
+	err := row.Scan(&value)
+	return value, err
+}
+
+const getJob = `-- name: GetJob :one
+SELECT id, kind, payload, status, attempts, max_attempts, run_at, lease_expires_at, last_error, created_at, finished_at FROM jobs
+WHERE id = ? LIMIT 1
+`
+
+func (q *Queries) GetJob(ctx context.Context, id int64) (Job, error) {
+	row := q.db.QueryRowContext(ctx, getJob, id)
+	var i Job
+	err := row.Scan(
+		&i.ID,
+		&i.Kind,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.RunAt,
+		&i.LeaseExpiresAt,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const getLatestSeriesSnapshot = `-- name: GetLatestSeriesSnapshot :one
+SELECT id, series_id, provider, entries, created_at FROM series_snapshots
+WHERE series_id = ? AND provider = ?
+ORDER BY id DESC
+LIMIT 1
+`
+
+type GetLatestSeriesSnapshotParams struct {
+	SeriesID int64  `json:"series_id"`
+	Provider string `json:"provider"`
+}
+
+func (q *Queries) GetLatestSeriesSnapshot(ctx context.Context, arg GetLatestSeriesSnapshotParams) (SeriesSnapshot, error) {
+	row := q.db.QueryRowContext(ctx, getLatestSeriesSnapshot, arg.SeriesID, arg.Provider)
+	var i SeriesSnapshot
+	err := row.Scan(
+		&i.ID,
+		&i.SeriesID,
+		&i.Provider,
+		&i.Entries,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLibraryStats = `-- name: GetLibraryStats :one
+SELECT
+    (SELECT COUNT(*) FROM books) AS total_books,
+    (SELECT COUNT(*) FROM books WHERE COALESCE(is_missing, 0) = 0) AS owned_books,
+    (SELECT COUNT(*) FROM books WHERE is_missing = 1) AS missing_books,
+    (SELECT COUNT(*) FROM books WHERE is_missing = 1 AND to_be_published = 1) AS unreleased_books,
+    (SELECT COUNT(*) FROM series) AS total_series,
+    (SELECT COUNT(*) FROM series s WHERE EXISTS (SELECT 1 FROM books b WHERE b.series_id = s.id)
+        AND NOT EXISTS (SELECT 1 FROM books b WHERE b.series_id = s.id AND b.is_missing = 1)) AS complete_series,
+    (SELECT COUNT(*) FROM series s WHERE EXISTS (SELECT 1 FROM books b WHERE b.series_id = s.id AND b.is_missing = 1)) AS incomplete_series,
+    (SELECT COUNT(*) FROM series s WHERE NOT EXISTS (SELECT 1 FROM books b WHERE b.series_id = s.id)) AS empty_series,
+    (SELECT COUNT(*) FROM series WHERE followed = 1) AS followed_series,
+    (SELECT COUNT(*) FROM series WHERE series_id = 0) AS unmapped_series,
+    (SELECT COUNT(*) FROM books WHERE COALESCE(is_missing, 0) = 0 AND COALESCE(goodreads_id, '') = '') AS books_without_goodreads_id,
+    (SELECT COUNT(*) FROM books WHERE COALESCE(is_missing, 0) = 0 AND COALESCE(isbn10, '') = '' AND COALESCE(isbn13, '') = '') AS books_without_isbn,
+    (SELECT COUNT(*) FROM books WHERE COALESCE(is_missing, 0) = 0 AND COALESCE(goodreads_id, '') = '' AND COALESCE(isbn10, '') = ''
+        AND COALESCE(isbn13, '') = '' AND COALESCE(asin, '') = '' AND COALESCE(hardcover_id, '') = '' AND COALESCE(google_id, '') = '') AS books_without_identifiers
+`
+
+type GetLibraryStatsRow struct {
+	TotalBooks              int64 `json:"total_books"`
+	OwnedBooks              int64 `json:"owned_books"`
+	MissingBooks            int64 `json:"missing_books"`
+	UnreleasedBooks         int64 `json:"unreleased_books"`
+	TotalSeries             int64 `json:"total_series"`
+	CompleteSeries          int64 `json:"complete_series"`
+	IncompleteSeries        int64 `json:"incomplete_series"`
+	EmptySeries             int64 `json:"empty_series"`
+	FollowedSeries          int64 `json:"followed_series"`
+	UnmappedSeries          int64 `json:"unmapped_series"`
+	BooksWithoutGoodreadsID int64 `json:"books_without_goodreads_id"`
+	BooksWithoutIsbn        int64 `json:"books_without_isbn"`
+	BooksWithoutIdentifiers int64 `json:"books_without_identifiers"`
+}
+
+func (q *Queries) GetLibraryStats(ctx context.Context) (GetLibraryStatsRow, error) {
+	row := q.db.QueryRowContext(ctx, getLibraryStats)
+	var i GetLibraryStatsRow
+	err := row.Scan(
+		&i.TotalBooks,
+		&i.OwnedBooks,
+		&i.MissingBooks,
+		&i.UnreleasedBooks,
+		&i.TotalSeries,
+		&i.CompleteSeries,
+		&i.IncompleteSeries,
+		&i.EmptySeries,
+		&i.FollowedSeries,
+		&i.UnmappedSeries,
+		&i.BooksWithoutGoodreadsID,
+		&i.BooksWithoutIsbn,
+		&i.BooksWithoutIdentifiers,
+	)
+	return i, err
+}
+
+const getMatchReview = `-- name: GetMatchReview :one
+SELECT id, series_id, owned_book_id, candidate_book_id, candidate_title, candidate, score, status FROM match_reviews
+WHERE id = ? LIMIT 1
+`
+
+func (q *Queries) GetMatchReview(ctx context.Context, id int64) (MatchReview, error) {
+	row := q.db.QueryRowContext(ctx, getMatchReview, id)
+	var i MatchReview
+	err := row.Scan(
+		&i.ID,
+		&i.SeriesID,
+		&i.OwnedBookID,
+		&i.CandidateBookID,
+		&i.CandidateTitle,
+		&i.Candidate,
+		&i.Score,
+		&i.Status,
+	)
+	return i, err
+}
+
+const getMultipleConfig = `-- name: GetMultipleConfig :many
+SELECT key, value FROM configuration
+WHERE key IN (/*SLICE:keys*/?)
+`
+
+func (q *Queries) GetMultipleConfig(ctx context.Context, keys []string) ([]Configuration, error) {
+	query := getMultipleConfig
+	var queryParams []interface{}
+	if len(keys) > 0 {
+		for _, v := range keys {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:keys*/?", strings.Repeat(",?", len(keys))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:keys*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Configuration
+	for rows.Next() {
+		var i Configuration
+		if err := rows.Scan(&i.Key, &i.Value); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSeries = `-- name: GetSeries :one
+SELECT id, series_id, name, description, url, data, last_checked_at, last_check_status, last_error, followed FROM series
+WHERE id = ? LIMIT 1
+`
+
+func (q *Queries) GetSeries(ctx context.Context, id int64) (Series, error) {
+	row := q.db.QueryRowContext(ctx, getSeries, id)
+	var i Series
 	err := row.Scan(
 		&i.ID,
 		&i.SeriesID,
@@ -448,12 +1167,16 @@ func (q *Queries) GetSeries(ctx context.Context, id int64) (Series, error) {
 		&i.Description,
 		&i.Url,
 		&i.Data,
+		&i.LastCheckedAt,
+		&i.LastCheckStatus,
+		&i.LastError,
+		&i.Followed,
 	)
 	return i, err
 }
 
 const getSeriesAuthors = `-- name: GetSeriesAuthors :many
-SELECT a.id, a.name FROM authors a
+SELECT a.id, a.name, a.goodreads_id FROM authors a
 JOIN series_authors sa ON a.id = sa.author_id
 WHERE sa.series_id = ?
 ORDER BY a.name ASC
@@ -468,7 +1191,7 @@ func (q *Queries) GetSeriesAuthors(ctx context.Context, seriesID int64) ([]Autho
 	var items []Author
 	for rows.Next() {
 		var i Author
-		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+		if err := rows.Scan(&i.ID, &i.Name, &i.GoodreadsID); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -483,7 +1206,7 @@ func (q *Queries) GetSeriesAuthors(ctx context.Context, seriesID int64) ([]Autho
 }
 
 const getSeriesByGoodreadsID = `-- name: GetSeriesByGoodreadsID :one
-SELECT id, series_id, name, description, url, data FROM series
+SELECT id, series_id, name, description, url, data, last_checked_at, last_check_status, last_error, followed FROM series
 WHERE series_id = ? LIMIT 1
 `
 
@@ -497,12 +1220,16 @@ func (q *Queries) GetSeriesByGoodreadsID(ctx context.Context, seriesID int64) (S
 		&i.Description,
 		&i.Url,
 		&i.Data,
+		&i.LastCheckedAt,
+		&i.LastCheckStatus,
+		&i.LastError,
+		&i.Followed,
 	)
 	return i, err
 }
 
 const getSeriesBySeriesID = `-- name: GetSeriesBySeriesID :one
-SELECT id, series_id, name, description, url, data FROM series
+SELECT id, series_id, name, description, url, data, last_checked_at, last_check_status, last_error, followed FROM series
 WHERE series_id = ? LIMIT 1
 `
 
@@ -516,10 +1243,291 @@ func (q *Queries) GetSeriesBySeriesID(ctx context.Context, seriesID int64) (Seri
 		&i.Description,
 		&i.Url,
 		&i.Data,
+		&i.LastCheckedAt,
+		&i.LastCheckStatus,
+		&i.LastError,
+		&i.Followed,
+	)
+	return i, err
+}
+
+const getSeriesCompletionDistribution = `-- name: GetSeriesCompletionDistribution :many
+SELECT bucket, COUNT(*) AS series_count FROM (
+    SELECT
+        CASE
+            WHEN missing = 0 THEN '100'
+            WHEN (total - missing) * 4 < total THEN '0-24'
+            WHEN (total - missing) * 2 < total THEN '25-49'
+            WHEN (total - missing) * 4 < total * 3 THEN '50-74'
+            ELSE '75-99'
+        END AS bucket
+    FROM (
+        SELECT COUNT(*) AS total, COUNT(CASE WHEN is_missing = 1 THEN 1 END) AS missing
+        FROM books
+        WHERE series_id IS NOT NULL
+        GROUP BY series_id
+    )
+)
+GROUP BY bucket
+`
+
+type GetSeriesCompletionDistributionRow struct {
+	Bucket      string `json:"bucket"`
+	SeriesCount int64  `json:"series_count"`
+}
+
+func (q *Queries) GetSeriesCompletionDistribution(ctx context.Context) ([]GetSeriesCompletionDistributionRow, error) {
+	rows, err := q.db.QueryContext(ctx, getSeriesCompletionDistribution)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSeriesCompletionDistributionRow
+	for rows.Next() {
+		var i GetSeriesCompletionDistributionRow
+		if err := rows.Scan(&i.Bucket, &i.SeriesCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSeriesForAuthor = `-- name: GetSeriesForAuthor :many
+SELECT
+    s.id,
+    s.series_id,
+    s.name,
+    COUNT(b.id) AS total_books,
+    COUNT(CASE WHEN b.is_missing = 1 THEN 1 END) AS missing_books
+FROM series s
+JOIN series_authors sa ON sa.series_id = s.id
+LEFT JOIN books b ON b.series_id = s.id
+WHERE sa.author_id = ?
+GROUP BY s.id, s.series_id, s.name
+ORDER BY s.name COLLATE NOCASE ASC
+`
+
+type GetSeriesForAuthorRow struct {
+	ID           int64  `json:"id"`
+	SeriesID     int64  `json:"series_id"`
+	Name         string `json:"name"`
+	TotalBooks   int64  `json:"total_books"`
+	MissingBooks int64  `json:"missing_books"`
+}
+
+func (q *Queries) GetSeriesForAuthor(ctx context.Context, authorID int64) ([]GetSeriesForAuthorRow, error) {
+	rows, err := q.db.QueryContext(ctx, getSeriesForAuthor, authorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSeriesForAuthorRow
+	for rows.Next() {
+		var i GetSeriesForAuthorRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SeriesID,
+			&i.Name,
+			&i.TotalBooks,
+			&i.MissingBooks,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const importBook = `-- name: ImportBook :one
+INSERT INTO books (book_id, title, description, description_markdown, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, goodreads_work_id, google_id, data, series_id, is_missing, publication_date, to_be_published, metadata_source, cover_url, discovered_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(book_id) DO UPDATE SET
+    title = excluded.title,
+    description = excluded.description,
+    description_markdown = excluded.description_markdown,
+    series_name = excluded.series_name,
+    series_number = excluded.series_number,
+    asin = excluded.asin,
+    isbn10 = excluded.isbn10,
+    isbn13 = excluded.isbn13,
+    language = excluded.language,
+    hardcover_id = excluded.hardcover_id,
+    hardcover_book_id = excluded.hardcover_book_id,
+    goodreads_id = excluded.goodreads_id,
+    goodreads_work_id = excluded.goodreads_work_id,
+    google_id = excluded.google_id,
+    data = excluded.data,
+    series_id = excluded.series_id,
+    is_missing = excluded.is_missing,
+    publication_date = excluded.publication_date,
+    to_be_published = excluded.to_be_published,
+    metadata_source = excluded.metadata_source,
+    cover_url = excluded.cover_url,
+    discovered_at = COALESCE(books.discovered_at, excluded.discovered_at)
+RETURNING id, book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, series_id, is_missing, publication_date, to_be_published, metadata_source, cover_url, goodreads_work_id, description_markdown, discovered_at
+`
+
+type ImportBookParams struct {
+	BookID              int64       `json:"book_id"`
+	Title               string      `json:"title"`
+	Description         string      `json:"description"`
+	DescriptionMarkdown *string     `json:"description_markdown"`
+	SeriesName          *string     `json:"series_name"`
+	SeriesNumber        *float64    `json:"series_number"`
+	Asin                *string     `json:"asin"`
+	Isbn10              *string     `json:"isbn10"`
+	Isbn13              *string     `json:"isbn13"`
+	Language            *string     `json:"language"`
+	HardcoverID         *string     `json:"hardcover_id"`
+	HardcoverBookID     *int64      `json:"hardcover_book_id"`
+	GoodreadsID         *string     `json:"goodreads_id"`
+	GoodreadsWorkID     *string     `json:"goodreads_work_id"`
+	GoogleID            *string     `json:"google_id"`
+	Data                interface{} `json:"data"`
+	SeriesID            *int64      `json:"series_id"`
+	IsMissing           *bool       `json:"is_missing"`
+	PublicationDate     *string     `json:"publication_date"`
+	ToBePublished       *bool       `json:"to_be_published"`
+	MetadataSource      *string     `json:"metadata_source"`
+	CoverUrl            *string     `json:"cover_url"`
+	DiscoveredAt        *time.Time  `json:"discovered_at"`
+}
+
+func (q *Queries) ImportBook(ctx context.Context, arg ImportBookParams) (Book, error) {
+	row := q.db.QueryRowContext(ctx, importBook,
+		arg.BookID,
+		arg.Title,
+		arg.Description,
+		arg.DescriptionMarkdown,
+		arg.SeriesName,
+		arg.SeriesNumber,
+		arg.Asin,
+		arg.Isbn10,
+		arg.Isbn13,
+		arg.Language,
+		arg.HardcoverID,
+		arg.HardcoverBookID,
+		arg.GoodreadsID,
+		arg.GoodreadsWorkID,
+		arg.GoogleID,
+		arg.Data,
+		arg.SeriesID,
+		arg.IsMissing,
+		arg.PublicationDate,
+		arg.ToBePublished,
+		arg.MetadataSource,
+		arg.CoverUrl,
+		arg.DiscoveredAt,
+	)
+	var i Book
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.Title,
+		&i.Description,
+		&i.SeriesName,
+		&i.SeriesNumber,
+		&i.Asin,
+		&i.Isbn10,
+		&i.Isbn13,
+		&i.Language,
+		&i.HardcoverID,
+		&i.HardcoverBookID,
+		&i.GoodreadsID,
+		&i.GoogleID,
+		&i.Data,
+		&i.SeriesID,
+		&i.IsMissing,
+		&i.PublicationDate,
+		&i.ToBePublished,
+		&i.MetadataSource,
+		&i.CoverUrl,
+		&i.GoodreadsWorkID,
+		&i.DescriptionMarkdown,
+		&i.DiscoveredAt,
+	)
+	return i, err
+}
+
+const importSeries = `-- name: ImportSeries :one
+INSERT INTO series (series_id, name, description, url, data, last_checked_at, last_check_status, last_error, followed)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(series_id) DO UPDATE SET
+    name = excluded.name,
+    description = excluded.description,
+    url = excluded.url,
+    data = excluded.data,
+    last_checked_at = excluded.last_checked_at,
+    last_check_status = excluded.last_check_status,
+    last_error = excluded.last_error,
+    followed = excluded.followed
+RETURNING id, series_id, name, description, url, data, last_checked_at, last_check_status, last_error, followed
+`
+
+type ImportSeriesParams struct {
+	SeriesID        int64       `json:"series_id"`
+	Name            string      `json:"name"`
+	Description     *string     `json:"description"`
+	Url             *string     `json:"url"`
+	Data            interface{} `json:"data"`
+	LastCheckedAt   *time.Time  `json:"last_checked_at"`
+	LastCheckStatus *string     `json:"last_check_status"`
+	LastError       *string     `json:"last_error"`
+	Followed        bool        `json:"followed"`
+}
+
+func (q *Queries) ImportSeries(ctx context.Context, arg ImportSeriesParams) (Series, error) {
+	row := q.db.QueryRowContext(ctx, importSeries,
+		arg.SeriesID,
+		arg.Name,
+		arg.Description,
+		arg.Url,
+		arg.Data,
+		arg.LastCheckedAt,
+		arg.LastCheckStatus,
+		arg.LastError,
+		arg.Followed,
+	)
+	var i Series
+	err := row.Scan(
+		&i.ID,
+		&i.SeriesID,
+		&i.Name,
+		&i.Description,
+		&i.Url,
+		&i.Data,
+		&i.LastCheckedAt,
+		&i.LastCheckStatus,
+		&i.LastError,
+		&i.Followed,
 	)
 	return i, err
 }
 
+const interruptCompletionRuns = `-- name: InterruptCompletionRuns :exec
+UPDATE completion_runs
+SET status = 'interrupted', finished_at = CURRENT_TIMESTAMP
+WHERE status = 'running'
+`
+
+func (q *Queries) InterruptCompletionRuns(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, interruptCompletionRuns)
+	return err
+}
+
 const linkBookAuthor = `-- name: LinkBookAuthor :exec
 INSERT INTO book_authors (book_id, author_id)
 VALUES (?, ?)
@@ -552,19 +1560,373 @@ func (q *Queries) LinkSeriesAuthor(ctx context.Context, arg LinkSeriesAuthorPara
 	return err
 }
 
-const listBooks = `-- name: ListBooks :many
-SELECT id, book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, series_id, is_missing FROM books
-ORDER BY title ASC
-LIMIT ? OFFSET ?
+const listAllAuthors = `-- name: ListAllAuthors :many
+SELECT id, name, goodreads_id FROM authors
+ORDER BY name ASC
 `
 
-type ListBooksParams struct {
-	Limit  int64 `json:"limit"`
-	Offset int64 `json:"offset"`
+func (q *Queries) ListAllAuthors(ctx context.Context) ([]Author, error) {
+	rows, err := q.db.QueryContext(ctx, listAllAuthors)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Author
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(&i.ID, &i.Name, &i.GoodreadsID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAllBooks = `-- name: ListAllBooks :many
+SELECT id, book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, series_id, is_missing, publication_date, to_be_published, metadata_source, cover_url, goodreads_work_id, description_markdown, discovered_at FROM books
+ORDER BY book_id ASC
+`
+
+func (q *Queries) ListAllBooks(ctx context.Context) ([]Book, error) {
+	rows, err := q.db.QueryContext(ctx, listAllBooks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Book
+	for rows.Next() {
+		var i Book
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.Title,
+			&i.Description,
+			&i.SeriesName,
+			&i.SeriesNumber,
+			&i.Asin,
+			&i.Isbn10,
+			&i.Isbn13,
+			&i.Language,
+			&i.HardcoverID,
+			&i.HardcoverBookID,
+			&i.GoodreadsID,
+			&i.GoogleID,
+			&i.Data,
+			&i.SeriesID,
+			&i.IsMissing,
+			&i.PublicationDate,
+			&i.ToBePublished,
+			&i.MetadataSource,
+			&i.CoverUrl,
+			&i.GoodreadsWorkID,
+			&i.DescriptionMarkdown,
+			&i.DiscoveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAllSeries = `-- name: ListAllSeries :many
+SELECT id, series_id, name, description, url, data, last_checked_at, last_check_status, last_error, followed FROM series
+ORDER BY series_id ASC
+`
+
+func (q *Queries) ListAllSeries(ctx context.Context) ([]Series, error) {
+	rows, err := q.db.QueryContext(ctx, listAllSeries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Series
+	for rows.Next() {
+		var i Series
+		if err := rows.Scan(
+			&i.ID,
+			&i.SeriesID,
+			&i.Name,
+			&i.Description,
+			&i.Url,
+			&i.Data,
+			&i.LastCheckedAt,
+			&i.LastCheckStatus,
+			&i.LastError,
+			&i.Followed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuthorsWithBookStats = `-- name: ListAuthorsWithBookStats :many
+SELECT
+    a.id,
+    a.name,
+    a.goodreads_id,
+    (SELECT COUNT(*) FROM series_authors sa WHERE sa.author_id = a.id) AS series_count,
+    COUNT(CASE WHEN b.id IS NOT NULL AND COALESCE(b.is_missing, 0) = 0 THEN 1 END) AS owned_books,
+    COUNT(CASE WHEN b.is_missing = 1 THEN 1 END) AS missing_books
+FROM authors a
+LEFT JOIN book_authors ba ON ba.author_id = a.id
+LEFT JOIN books b ON b.id = ba.book_id
+GROUP BY a.id, a.name, a.goodreads_id
+ORDER BY a.name COLLATE NOCASE ASC
+LIMIT ? OFFSET ?
+`
+
+type ListAuthorsWithBookStatsParams struct {
+	Limit  int64 `json:"limit"`
+	Offset int64 `json:"offset"`
+}
+
+type ListAuthorsWithBookStatsRow struct {
+	ID           int64   `json:"id"`
+	Name         string  `json:"name"`
+	GoodreadsID  *string `json:"goodreads_id"`
+	SeriesCount  int64   `json:"series_count"`
+	OwnedBooks   int64   `json:"owned_books"`
+	MissingBooks int64   `json:"missing_books"`
+}
+
+func (q *Queries) ListAuthorsWithBookStats(ctx context.Context, arg ListAuthorsWithBookStatsParams) ([]ListAuthorsWithBookStatsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAuthorsWithBookStats, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAuthorsWithBookStatsRow
+	for rows.Next() {
+		var i ListAuthorsWithBookStatsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.GoodreadsID,
+			&i.SeriesCount,
+			&i.OwnedBooks,
+			&i.MissingBooks,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuthorsWithGoodreadsID = `-- name: ListAuthorsWithGoodreadsID :many
+SELECT id, name, goodreads_id FROM authors
+WHERE goodreads_id IS NOT NULL AND goodreads_id != ''
+ORDER BY name ASC
+`
+
+func (q *Queries) ListAuthorsWithGoodreadsID(ctx context.Context) ([]Author, error) {
+	rows, err := q.db.QueryContext(ctx, listAuthorsWithGoodreadsID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Author
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(&i.ID, &i.Name, &i.GoodreadsID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBookAuthorNames = `-- name: ListBookAuthorNames :many
+SELECT b.book_id, a.name FROM book_authors ba
+JOIN books b ON b.id = ba.book_id
+JOIN authors a ON a.id = ba.author_id
+ORDER BY b.book_id ASC, a.name ASC
+`
+
+type ListBookAuthorNamesRow struct {
+	BookID int64  `json:"book_id"`
+	Name   string `json:"name"`
+}
+
+func (q *Queries) ListBookAuthorNames(ctx context.Context) ([]ListBookAuthorNamesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listBookAuthorNames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListBookAuthorNamesRow
+	for rows.Next() {
+		var i ListBookAuthorNamesRow
+		if err := rows.Scan(&i.BookID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBooks = `-- name: ListBooks :many
+SELECT id, book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, series_id, is_missing, publication_date, to_be_published, metadata_source, cover_url, goodreads_work_id, description_markdown, discovered_at FROM books
+WHERE (? IS NULL OR COALESCE(is_missing, 0) = ?)
+  AND (? IS NULL OR series_id = ?)
+  AND (? IS NULL OR id IN (SELECT ba.book_id FROM book_authors ba WHERE ba.author_id = ?))
+  AND (? IS NULL OR language = ?)
+  AND (? IS NULL OR (COALESCE(goodreads_id, '') != '') = ?)
+  AND (? IS NULL OR title LIKE '%' || ? || '%')
+ORDER BY
+    CASE WHEN ? = 'title' THEN title COLLATE NOCASE END ASC,
+    CASE WHEN ? = 'title_desc' THEN title COLLATE NOCASE END DESC,
+    CASE WHEN ? = 'series' THEN series_name COLLATE NOCASE END ASC,
+    CASE WHEN ? = 'series' THEN series_number END ASC,
+    CASE WHEN ? = 'series_desc' THEN series_name COLLATE NOCASE END DESC,
+    CASE WHEN ? = 'series_desc' THEN series_number END DESC,
+    CASE WHEN ? = 'published' THEN publication_date END ASC,
+    CASE WHEN ? = 'published_desc' THEN publication_date END DESC,
+    CASE WHEN ? = 'added_desc' THEN id END DESC,
+    id ASC
+LIMIT ? OFFSET ?
+`
+
+type ListBooksParams struct {
+	Missing        *bool   `json:"missing"`
+	SeriesID       *int64  `json:"series_id"`
+	AuthorID       *int64  `json:"author_id"`
+	Language       *string `json:"language"`
+	HasGoodreadsID *bool   `json:"has_goodreads_id"`
+	Title          *string `json:"title"`
+	Sort           string  `json:"sort"`
+	Limit          int64   `json:"limit"`
+	Offset         int64   `json:"offset"`
 }
 
 func (q *Queries) ListBooks(ctx context.Context, arg ListBooksParams) ([]Book, error) {
-	rows, err := q.db.QueryContext(ctx, listBooks, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listBooks,
+		arg.Missing,
+		arg.Missing,
+		arg.SeriesID,
+		arg.SeriesID,
+		arg.AuthorID,
+		arg.AuthorID,
+		arg.Language,
+		arg.Language,
+		arg.HasGoodreadsID,
+		arg.HasGoodreadsID,
+		arg.Title,
+		arg.Title,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Book
+	for rows.Next() {
+		var i Book
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.Title,
+			&i.Description,
+			&i.SeriesName,
+			&i.SeriesNumber,
+			&i.Asin,
+			&i.Isbn10,
+			&i.Isbn13,
+			&i.Language,
+			&i.HardcoverID,
+			&i.HardcoverBookID,
+			&i.GoodreadsID,
+			&i.GoogleID,
+			&i.Data,
+			&i.SeriesID,
+			&i.IsMissing,
+			&i.PublicationDate,
+			&i.ToBePublished,
+			&i.MetadataSource,
+			&i.CoverUrl,
+			&i.GoodreadsWorkID,
+			&i.DescriptionMarkdown,
+			&i.DiscoveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBooksWithoutGoodreadsID = `-- name: ListBooksWithoutGoodreadsID :many
+SELECT b.id, b.book_id, b.title, b.description, b.series_name, b.series_number, b.asin, b.isbn10, b.isbn13, b.language, b.hardcover_id, b.hardcover_book_id, b.goodreads_id, b.google_id, b.data, b.series_id, b.is_missing, b.publication_date, b.to_be_published, b.metadata_source, b.cover_url, b.goodreads_work_id, b.description_markdown, b.discovered_at FROM books b
+LEFT JOIN book_lookups l ON l.book_id = b.id
+WHERE (b.goodreads_id IS NULL OR b.goodreads_id = '')
+  AND (COALESCE(b.isbn13, '') != '' OR COALESCE(b.isbn10, '') != '')
+  AND (l.goodreads_checked_at IS NULL OR l.goodreads_checked_at < datetime('now', '-' || ? || ' days'))
+ORDER BY l.goodreads_checked_at IS NOT NULL, l.goodreads_checked_at ASC, b.id ASC
+LIMIT ?
+`
+
+type ListBooksWithoutGoodreadsIDParams struct {
+	RetryDays int64 `json:"retry_days"`
+	Limit     int64 `json:"limit"`
+}
+
+func (q *Queries) ListBooksWithoutGoodreadsID(ctx context.Context, arg ListBooksWithoutGoodreadsIDParams) ([]Book, error) {
+	rows, err := q.db.QueryContext(ctx, listBooksWithoutGoodreadsID, arg.RetryDays, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -589,7 +1951,877 @@ func (q *Queries) ListBooks(ctx context.Context, arg ListBooksParams) ([]Book, e
 			&i.GoogleID,
 			&i.Data,
 			&i.SeriesID,
-			&i.IsMissing,
+			&i.IsMissing,
+			&i.PublicationDate,
+			&i.ToBePublished,
+			&i.MetadataSource,
+			&i.CoverUrl,
+			&i.GoodreadsWorkID,
+			&i.DescriptionMarkdown,
+			&i.DiscoveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCompletionRunSeries = `-- name: ListCompletionRunSeries :many
+SELECT c.run_id, c.series_id, c.status, c.new_missing_books, c.review_books, c.error, c.checked_at,
+    s.name AS series_name
+FROM completion_run_series c
+JOIN series s ON s.id = c.series_id
+WHERE c.run_id = ?
+ORDER BY s.name ASC
+`
+
+type ListCompletionRunSeriesRow struct {
+	RunID           int64      `json:"run_id"`
+	SeriesID        int64      `json:"series_id"`
+	Status          string     `json:"status"`
+	NewMissingBooks int64      `json:"new_missing_books"`
+	ReviewBooks     int64      `json:"review_books"`
+	Error           *string    `json:"error"`
+	CheckedAt       *time.Time `json:"checked_at"`
+	SeriesName      string     `json:"series_name"`
+}
+
+func (q *Queries) ListCompletionRunSeries(ctx context.Context, runID int64) ([]ListCompletionRunSeriesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCompletionRunSeries, runID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCompletionRunSeriesRow
+	for rows.Next() {
+		var i ListCompletionRunSeriesRow
+		if err := rows.Scan(
+			&i.RunID,
+			&i.SeriesID,
+			&i.Status,
+			&i.NewMissingBooks,
+			&i.ReviewBooks,
+			&i.Error,
+			&i.CheckedAt,
+			&i.SeriesName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCompletionRuns = `-- name: ListCompletionRuns :many
+SELECT r.id, r.status, r.provider, r.stale_days, r.concurrency, r.created_at, r.finished_at,
+    (SELECT COUNT(*) FROM completion_run_series c WHERE c.run_id = r.id) AS total,
+    (SELECT COUNT(*) FROM completion_run_series c WHERE c.run_id = r.id AND c.status = 'done') AS done,
+    (SELECT COUNT(*) FROM completion_run_series c WHERE c.run_id = r.id AND c.status = 'failed') AS failed
+FROM completion_runs r
+ORDER BY r.id DESC
+LIMIT ?
+`
+
+type ListCompletionRunsRow struct {
+	ID          int64      `json:"id"`
+	Status      string     `json:"status"`
+	Provider    string     `json:"provider"`
+	StaleDays   int64      `json:"stale_days"`
+	Concurrency int64      `json:"concurrency"`
+	CreatedAt   time.Time  `json:"created_at"`
+	FinishedAt  *time.Time `json:"finished_at"`
+	Total       int64      `json:"total"`
+	Done        int64      `json:"done"`
+	Failed      int64      `json:"failed"`
+}
+
+func (q *Queries) ListCompletionRuns(ctx context.Context, limit int64) ([]ListCompletionRunsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCompletionRuns, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCompletionRunsRow
+	for rows.Next() {
+		var i ListCompletionRunsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Status,
+			&i.Provider,
+			&i.StaleDays,
+			&i.Concurrency,
+			&i.CreatedAt,
+			&i.FinishedAt,
+			&i.Total,
+			&i.Done,
+			&i.Failed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listConfig = `-- name: ListConfig :many
+SELECT key, value FROM configuration
+ORDER BY key ASC
+`
+
+func (q *Queries) ListConfig(ctx context.Context) ([]Configuration, error) {
+	rows, err := q.db.QueryContext(ctx, listConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Configuration
+	for rows.Next() {
+		var i Configuration
+		if err := rows.Scan(&i.Key, &i.Value); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listJobs = `-- name: ListJobs :many
+SELECT id, kind, payload, status, attempts, max_attempts, run_at, lease_expires_at, last_error, created_at, finished_at FROM jobs
+WHERE status = COALESCE(?, status)
+  AND kind = COALESCE(?, kind)
+ORDER BY id DESC
+LIMIT ?
+`
+
+type ListJobsParams struct {
+	Status *string `json:"status"`
+	Kind   *string `json:"kind"`
+	Limit  int64   `json:"limit"`
+}
+
+func (q *Queries) ListJobs(ctx context.Context, arg ListJobsParams) ([]Job, error) {
+	rows, err := q.db.QueryContext(ctx, listJobs, arg.Status, arg.Kind, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Job
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.MaxAttempts,
+			&i.RunAt,
+			&i.LeaseExpiresAt,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.FinishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLibrarySnapshots = `-- name: ListLibrarySnapshots :many
+SELECT id, taken_on, total_books, owned_books, missing_books, unreleased_books, total_series, complete_series, incomplete_series, books_without_identifiers, taken_at FROM library_snapshots
+WHERE taken_on >= ?
+ORDER BY taken_on ASC
+`
+
+func (q *Queries) ListLibrarySnapshots(ctx context.Context, takenOn string) ([]LibrarySnapshot, error) {
+	rows, err := q.db.QueryContext(ctx, listLibrarySnapshots, takenOn)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LibrarySnapshot
+	for rows.Next() {
+		var i LibrarySnapshot
+		if err := rows.Scan(
+			&i.ID,
+			&i.TakenOn,
+			&i.TotalBooks,
+			&i.OwnedBooks,
+			&i.MissingBooks,
+			&i.UnreleasedBooks,
+			&i.TotalSeries,
+			&i.CompleteSeries,
+			&i.IncompleteSeries,
+			&i.BooksWithoutIdentifiers,
+			&i.TakenAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMatchReviews = `-- name: ListMatchReviews :many
+SELECT r.id, r.series_id, r.owned_book_id, r.candidate_book_id, r.candidate_title, r.candidate, r.score, r.status,
+    b.title AS owned_title,
+    s.name AS series_name
+FROM match_reviews r
+JOIN books b ON b.id = r.owned_book_id
+JOIN series s ON s.id = r.series_id
+WHERE r.status = ?
+ORDER BY r.id ASC
+`
+
+type ListMatchReviewsRow struct {
+	ID              int64   `json:"id"`
+	SeriesID        int64   `json:"series_id"`
+	OwnedBookID     int64   `json:"owned_book_id"`
+	CandidateBookID int64   `json:"candidate_book_id"`
+	CandidateTitle  string  `json:"candidate_title"`
+	Candidate       string  `json:"candidate"`
+	Score           float64 `json:"score"`
+	Status          string  `json:"status"`
+	OwnedTitle      string  `json:"owned_title"`
+	SeriesName      string  `json:"series_name"`
+}
+
+func (q *Queries) ListMatchReviews(ctx context.Context, status string) ([]ListMatchReviewsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listMatchReviews, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMatchReviewsRow
+	for rows.Next() {
+		var i ListMatchReviewsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SeriesID,
+			&i.OwnedBookID,
+			&i.CandidateBookID,
+			&i.CandidateTitle,
+			&i.Candidate,
+			&i.Score,
+			&i.Status,
+			&i.OwnedTitle,
+			&i.SeriesName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMatchReviewsForExport = `-- name: ListMatchReviewsForExport :many
+SELECT s.series_id, b.book_id AS owned_book_id, r.candidate_book_id, r.candidate_title, r.candidate, r.score, r.status
+FROM match_reviews r
+JOIN series s ON s.id = r.series_id
+JOIN books b ON b.id = r.owned_book_id
+ORDER BY s.series_id ASC, b.book_id ASC, r.candidate_book_id ASC
+`
+
+type ListMatchReviewsForExportRow struct {
+	SeriesID        int64   `json:"series_id"`
+	OwnedBookID     int64   `json:"owned_book_id"`
+	CandidateBookID int64   `json:"candidate_book_id"`
+	CandidateTitle  string  `json:"candidate_title"`
+	Candidate       string  `json:"candidate"`
+	Score           float64 `json:"score"`
+	Status          string  `json:"status"`
+}
+
+func (q *Queries) ListMatchReviewsForExport(ctx context.Context) ([]ListMatchReviewsForExportRow, error) {
+	rows, err := q.db.QueryContext(ctx, listMatchReviewsForExport)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMatchReviewsForExportRow
+	for rows.Next() {
+		var i ListMatchReviewsForExportRow
+		if err := rows.Scan(
+			&i.SeriesID,
+			&i.OwnedBookID,
+			&i.CandidateBookID,
+			&i.CandidateTitle,
+			&i.Candidate,
+			&i.Score,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMatchReviewsForSeries = `-- name: ListMatchReviewsForSeries :many
+SELECT id, series_id, owned_book_id, candidate_book_id, candidate_title, candidate, score, status FROM match_reviews
+WHERE series_id = ?
+ORDER BY id ASC
+`
+
+func (q *Queries) ListMatchReviewsForSeries(ctx context.Context, seriesID int64) ([]MatchReview, error) {
+	rows, err := q.db.QueryContext(ctx, listMatchReviewsForSeries, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MatchReview
+	for rows.Next() {
+		var i MatchReview
+		if err := rows.Scan(
+			&i.ID,
+			&i.SeriesID,
+			&i.OwnedBookID,
+			&i.CandidateBookID,
+			&i.CandidateTitle,
+			&i.Candidate,
+			&i.Score,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMissingBooksForExport = `-- name: ListMissingBooksForExport :many
+SELECT
+    b.id,
+    b.title,
+    CAST(COALESCE(s.name, b.series_name, '') AS TEXT) AS series_name,
+    b.series_number,
+    CAST(COALESCE((
+        SELECT group_concat(a.name, ', ' ORDER BY a.name COLLATE NOCASE)
+        FROM authors a
+        JOIN book_authors ba ON ba.author_id = a.id
+        WHERE ba.book_id = b.id
+    ), '') AS TEXT) AS authors,
+    b.goodreads_id,
+    b.isbn13,
+    b.isbn10,
+    b.publication_date,
+    CAST(COALESCE(b.to_be_published, 0) = 1 OR (COALESCE(b.publication_date, '') != '' AND b.publication_date >= substr(date('now'), 1, length(b.publication_date))) AS BOOLEAN) AS unreleased
+FROM books b
+LEFT JOIN series s ON s.id = b.series_id
+WHERE b.is_missing = 1
+  AND (? IS NULL OR b.series_id = ?)
+  AND (? IS NULL OR b.id IN (SELECT ba.book_id FROM book_authors ba WHERE ba.author_id = ?))
+  AND (? IS NULL OR (COALESCE(b.to_be_published, 0) = 1 OR (COALESCE(b.publication_date, '') != '' AND b.publication_date >= substr(date('now'), 1, length(b.publication_date)))) = ?)
+ORDER BY series_name COLLATE NOCASE ASC, b.series_number ASC, b.title COLLATE NOCASE ASC
+`
+
+type ListMissingBooksForExportParams struct {
+	SeriesID   *int64 `json:"series_id"`
+	AuthorID   *int64 `json:"author_id"`
+	Unreleased *bool  `json:"unreleased"`
+}
+
+type ListMissingBooksForExportRow struct {
+	ID              int64    `json:"id"`
+	Title           string   `json:"title"`
+	SeriesName      string   `json:"series_name"`
+	SeriesNumber    *float64 `json:"series_number"`
+	Authors         string   `json:"authors"`
+	GoodreadsID     *string  `json:"goodreads_id"`
+	Isbn13          *string  `json:"isbn13"`
+	Isbn10          *string  `json:"isbn10"`
+	PublicationDate *string  `json:"publication_date"`
+	Unreleased      bool     `json:"unreleased"`
+}
+
+func (q *Queries) ListMissingBooksForExport(ctx context.Context, arg ListMissingBooksForExportParams) ([]ListMissingBooksForExportRow, error) {
+	rows, err := q.db.QueryContext(ctx, listMissingBooksForExport,
+		arg.SeriesID,
+		arg.SeriesID,
+		arg.AuthorID,
+		arg.AuthorID,
+		arg.Unreleased,
+		arg.Unreleased,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMissingBooksForExportRow
+	for rows.Next() {
+		var i ListMissingBooksForExportRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.SeriesName,
+			&i.SeriesNumber,
+			&i.Authors,
+			&i.GoodreadsID,
+			&i.Isbn13,
+			&i.Isbn10,
+			&i.PublicationDate,
+			&i.Unreleased,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMissingBooksToEnrich = `-- name: ListMissingBooksToEnrich :many
+SELECT b.id, b.book_id, b.title, b.description, b.series_name, b.series_number, b.asin, b.isbn10, b.isbn13, b.language, b.hardcover_id, b.hardcover_book_id, b.goodreads_id, b.google_id, b.data, b.series_id, b.is_missing, b.publication_date, b.to_be_published, b.metadata_source, b.cover_url, b.goodreads_work_id, b.description_markdown, b.discovered_at FROM books b
+LEFT JOIN book_lookups l ON l.book_id = b.id
+WHERE b.is_missing = 1
+  AND (COALESCE(b.isbn13, '') != '' OR COALESCE(b.isbn10, '') != '')
+  AND (b.cover_url IS NULL OR b.description = '' OR b.publication_date IS NULL)
+  AND (l.enrich_checked_at IS NULL OR l.enrich_checked_at < datetime('now', '-' || ? || ' days'))
+ORDER BY l.enrich_checked_at IS NOT NULL, l.enrich_checked_at ASC, b.id ASC
+LIMIT ?
+`
+
+type ListMissingBooksToEnrichParams struct {
+	RetryDays int64 `json:"retry_days"`
+	Limit     int64 `json:"limit"`
+}
+
+func (q *Queries) ListMissingBooksToEnrich(ctx context.Context, arg ListMissingBooksToEnrichParams) ([]Book, error) {
+	rows, err := q.db.QueryContext(ctx, listMissingBooksToEnrich, arg.RetryDays, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Book
+	for rows.Next() {
+		var i Book
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.Title,
+			&i.Description,
+			&i.SeriesName,
+			&i.SeriesNumber,
+			&i.Asin,
+			&i.Isbn10,
+			&i.Isbn13,
+			&i.Language,
+			&i.HardcoverID,
+			&i.HardcoverBookID,
+			&i.GoodreadsID,
+			&i.GoogleID,
+			&i.Data,
+			&i.SeriesID,
+			&i.IsMissing,
+			&i.PublicationDate,
+			&i.ToBePublished,
+			&i.MetadataSource,
+			&i.CoverUrl,
+			&i.GoodreadsWorkID,
+			&i.DescriptionMarkdown,
+			&i.DiscoveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPendingCompletionRunSeries = `-- name: ListPendingCompletionRunSeries :many
+SELECT series_id FROM completion_run_series
+WHERE run_id = ? AND status = 'pending'
+ORDER BY series_id ASC
+`
+
+func (q *Queries) ListPendingCompletionRunSeries(ctx context.Context, runID int64) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, listPendingCompletionRunSeries, runID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var series_id int64
+		if err := rows.Scan(&series_id); err != nil {
+			return nil, err
+		}
+		items = append(items, series_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecentWorkIDLookups = `-- name: ListRecentWorkIDLookups :many
+SELECT l.book_id FROM book_lookups l
+JOIN books b ON b.id = l.book_id
+WHERE b.series_id = ?
+  AND l.work_id_checked_at >= datetime('now', '-' || ? || ' days')
+`
+
+type ListRecentWorkIDLookupsParams struct {
+	SeriesID  *int64 `json:"series_id"`
+	RetryDays int64  `json:"retry_days"`
+}
+
+func (q *Queries) ListRecentWorkIDLookups(ctx context.Context, arg ListRecentWorkIDLookupsParams) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, listRecentWorkIDLookups, arg.SeriesID, arg.RetryDays)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var book_id int64
+		if err := rows.Scan(&book_id); err != nil {
+			return nil, err
+		}
+		items = append(items, book_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecentlyDiscoveredBooks = `-- name: ListRecentlyDiscoveredBooks :many
+SELECT id, book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, series_id, is_missing, publication_date, to_be_published, metadata_source, cover_url, goodreads_work_id, description_markdown, discovered_at FROM books
+WHERE is_missing = 1 AND discovered_at IS NOT NULL
+ORDER BY discovered_at DESC, id DESC
+LIMIT ?
+`
+
+func (q *Queries) ListRecentlyDiscoveredBooks(ctx context.Context, limit int64) ([]Book, error) {
+	rows, err := q.db.QueryContext(ctx, listRecentlyDiscoveredBooks, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Book
+	for rows.Next() {
+		var i Book
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.Title,
+			&i.Description,
+			&i.SeriesName,
+			&i.SeriesNumber,
+			&i.Asin,
+			&i.Isbn10,
+			&i.Isbn13,
+			&i.Language,
+			&i.HardcoverID,
+			&i.HardcoverBookID,
+			&i.GoodreadsID,
+			&i.GoogleID,
+			&i.Data,
+			&i.SeriesID,
+			&i.IsMissing,
+			&i.PublicationDate,
+			&i.ToBePublished,
+			&i.MetadataSource,
+			&i.CoverUrl,
+			&i.GoodreadsWorkID,
+			&i.DescriptionMarkdown,
+			&i.DiscoveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecentlyDiscoveredUpcomingBooks = `-- name: ListRecentlyDiscoveredUpcomingBooks :many
+SELECT id, book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, series_id, is_missing, publication_date, to_be_published, metadata_source, cover_url, goodreads_work_id, description_markdown, discovered_at FROM books
+WHERE is_missing = 1 AND discovered_at IS NOT NULL
+  AND (to_be_published = 1 OR (publication_date != '' AND publication_date >= substr(date('now'), 1, length(publication_date))))
+ORDER BY discovered_at DESC, id DESC
+LIMIT ?
+`
+
+func (q *Queries) ListRecentlyDiscoveredUpcomingBooks(ctx context.Context, limit int64) ([]Book, error) {
+	rows, err := q.db.QueryContext(ctx, listRecentlyDiscoveredUpcomingBooks, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Book
+	for rows.Next() {
+		var i Book
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.Title,
+			&i.Description,
+			&i.SeriesName,
+			&i.SeriesNumber,
+			&i.Asin,
+			&i.Isbn10,
+			&i.Isbn13,
+			&i.Language,
+			&i.HardcoverID,
+			&i.HardcoverBookID,
+			&i.GoodreadsID,
+			&i.GoogleID,
+			&i.Data,
+			&i.SeriesID,
+			&i.IsMissing,
+			&i.PublicationDate,
+			&i.ToBePublished,
+			&i.MetadataSource,
+			&i.CoverUrl,
+			&i.GoodreadsWorkID,
+			&i.DescriptionMarkdown,
+			&i.DiscoveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSeries = `-- name: ListSeries :many
+SELECT s.id, s.series_id, s.name, s.description, s.url, s.data, s.last_checked_at, s.last_check_status, s.last_error, s.followed FROM series s
+WHERE (? IS NULL OR EXISTS (SELECT 1 FROM books mb WHERE mb.series_id = s.id AND mb.is_missing = 1) = ?)
+  AND (? IS NULL OR s.id IN (SELECT sa.series_id FROM series_authors sa WHERE sa.author_id = ?))
+  AND (? IS NULL OR (s.series_id = 0) = ?)
+  AND (? IS NULL OR s.name LIKE '%' || ? || '%')
+ORDER BY
+    CASE WHEN ? = 'name' THEN s.name COLLATE NOCASE END ASC,
+    CASE WHEN ? = 'name_desc' THEN s.name COLLATE NOCASE END DESC,
+    CASE WHEN ? = 'total' THEN (SELECT COUNT(*) FROM books b WHERE b.series_id = s.id) END ASC,
+    CASE WHEN ? = 'total_desc' THEN (SELECT COUNT(*) FROM books b WHERE b.series_id = s.id) END DESC,
+    CASE WHEN ? = 'missing' THEN (SELECT COUNT(*) FROM books b WHERE b.series_id = s.id AND b.is_missing = 1) END ASC,
+    CASE WHEN ? = 'missing_desc' THEN (SELECT COUNT(*) FROM books b WHERE b.series_id = s.id AND b.is_missing = 1) END DESC,
+    CASE WHEN ? = 'completion' THEN CASE WHEN (SELECT COUNT(*) FROM books b WHERE b.series_id = s.id) = 0 THEN 0.0 ELSE CAST((SELECT COUNT(*) FROM books b WHERE b.series_id = s.id) - (SELECT COUNT(*) FROM books b WHERE b.series_id = s.id AND b.is_missing = 1) AS REAL) / (SELECT COUNT(*) FROM books b WHERE b.series_id = s.id) END END ASC,
+    CASE WHEN ? = 'completion_desc' THEN CASE WHEN (SELECT COUNT(*) FROM books b WHERE b.series_id = s.id) = 0 THEN 0.0 ELSE CAST((SELECT COUNT(*) FROM books b WHERE b.series_id = s.id) - (SELECT COUNT(*) FROM books b WHERE b.series_id = s.id AND b.is_missing = 1) AS REAL) / (SELECT COUNT(*) FROM books b WHERE b.series_id = s.id) END END DESC,
+    CASE WHEN ? = 'checked' THEN s.last_checked_at END ASC,
+    CASE WHEN ? = 'checked_desc' THEN s.last_checked_at END DESC,
+    s.id ASC
+LIMIT ? OFFSET ?
+`
+
+type ListSeriesParams struct {
+	Incomplete *bool   `json:"incomplete"`
+	AuthorID   *int64  `json:"author_id"`
+	Unmapped   *bool   `json:"unmapped"`
+	Name       *string `json:"name"`
+	Sort       string  `json:"sort"`
+	Limit      int64   `json:"limit"`
+	Offset     int64   `json:"offset"`
+}
+
+func (q *Queries) ListSeries(ctx context.Context, arg ListSeriesParams) ([]Series, error) {
+	rows, err := q.db.QueryContext(ctx, listSeries,
+		arg.Incomplete,
+		arg.Incomplete,
+		arg.AuthorID,
+		arg.AuthorID,
+		arg.Unmapped,
+		arg.Unmapped,
+		arg.Name,
+		arg.Name,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Series
+	for rows.Next() {
+		var i Series
+		if err := rows.Scan(
+			&i.ID,
+			&i.SeriesID,
+			&i.Name,
+			&i.Description,
+			&i.Url,
+			&i.Data,
+			&i.LastCheckedAt,
+			&i.LastCheckStatus,
+			&i.LastError,
+			&i.Followed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSeriesAuthorNames = `-- name: ListSeriesAuthorNames :many
+SELECT s.series_id, a.name FROM series_authors sa
+JOIN series s ON s.id = sa.series_id
+JOIN authors a ON a.id = sa.author_id
+ORDER BY s.series_id ASC, a.name ASC
+`
+
+type ListSeriesAuthorNamesRow struct {
+	SeriesID int64  `json:"series_id"`
+	Name     string `json:"name"`
+}
+
+func (q *Queries) ListSeriesAuthorNames(ctx context.Context) ([]ListSeriesAuthorNamesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSeriesAuthorNames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListSeriesAuthorNamesRow
+	for rows.Next() {
+		var i ListSeriesAuthorNamesRow
+		if err := rows.Scan(&i.SeriesID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSeriesChanges = `-- name: ListSeriesChanges :many
+SELECT id, series_id, snapshot_id, kind, title, goodreads_id, old_position, new_position, created_at FROM series_changes
+WHERE series_id = ?
+ORDER BY id DESC
+LIMIT ?
+`
+
+type ListSeriesChangesParams struct {
+	SeriesID int64 `json:"series_id"`
+	Limit    int64 `json:"limit"`
+}
+
+func (q *Queries) ListSeriesChanges(ctx context.Context, arg ListSeriesChangesParams) ([]SeriesChange, error) {
+	rows, err := q.db.QueryContext(ctx, listSeriesChanges, arg.SeriesID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SeriesChange
+	for rows.Next() {
+		var i SeriesChange
+		if err := rows.Scan(
+			&i.ID,
+			&i.SeriesID,
+			&i.SnapshotID,
+			&i.Kind,
+			&i.Title,
+			&i.GoodreadsID,
+			&i.OldPosition,
+			&i.NewPosition,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -604,34 +2836,27 @@ func (q *Queries) ListBooks(ctx context.Context, arg ListBooksParams) ([]Book, e
 	return items, nil
 }
 
-const listSeries = `-- name: ListSeries :many
-SELECT id, series_id, name, description, url, data FROM series
+const listSeriesIdentifiers = `-- name: ListSeriesIdentifiers :many
+SELECT id, series_id, name FROM series
 ORDER BY id ASC
-LIMIT ? OFFSET ?
 `
 
-type ListSeriesParams struct {
-	Limit  int64 `json:"limit"`
-	Offset int64 `json:"offset"`
+type ListSeriesIdentifiersRow struct {
+	ID       int64  `json:"id"`
+	SeriesID int64  `json:"series_id"`
+	Name     string `json:"name"`
 }
 
-func (q *Queries) ListSeries(ctx context.Context, arg ListSeriesParams) ([]Series, error) {
-	rows, err := q.db.QueryContext(ctx, listSeries, arg.Limit, arg.Offset)
+func (q *Queries) ListSeriesIdentifiers(ctx context.Context) ([]ListSeriesIdentifiersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSeriesIdentifiers)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Series
+	var items []ListSeriesIdentifiersRow
 	for rows.Next() {
-		var i Series
-		if err := rows.Scan(
-			&i.ID,
-			&i.SeriesID,
-			&i.Name,
-			&i.Description,
-			&i.Url,
-			&i.Data,
-		); err != nil {
+		var i ListSeriesIdentifiersRow
+		if err := rows.Scan(&i.ID, &i.SeriesID, &i.Name); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -653,33 +2878,82 @@ SELECT
     s.description,
     s.url,
     s.data,
+    s.last_checked_at,
+    s.last_check_status,
+    s.last_error,
+    s.followed,
     COUNT(b.id) as total_books,
     COUNT(CASE WHEN b.is_missing = 1 THEN 1 END) as missing_books
 FROM series s
 LEFT JOIN books b ON s.id = b.series_id
-GROUP BY s.id, s.series_id, s.name, s.description, s.url, s.data
-ORDER BY s.id ASC
+WHERE (? IS NULL OR EXISTS (SELECT 1 FROM books mb WHERE mb.series_id = s.id AND mb.is_missing = 1) = ?)
+  AND (? IS NULL OR s.id IN (SELECT sa.series_id FROM series_authors sa WHERE sa.author_id = ?))
+  AND (? IS NULL OR (s.series_id = 0) = ?)
+  AND (? IS NULL OR s.name LIKE '%' || ? || '%')
+GROUP BY s.id, s.series_id, s.name, s.description, s.url, s.data, s.last_checked_at, s.last_check_status, s.last_error, s.followed
+ORDER BY
+    CASE WHEN ? = 'name' THEN s.name COLLATE NOCASE END ASC,
+    CASE WHEN ? = 'name_desc' THEN s.name COLLATE NOCASE END DESC,
+    CASE WHEN ? = 'total' THEN COUNT(b.id) END ASC,
+    CASE WHEN ? = 'total_desc' THEN COUNT(b.id) END DESC,
+    CASE WHEN ? = 'missing' THEN COUNT(CASE WHEN b.is_missing = 1 THEN 1 END) END ASC,
+    CASE WHEN ? = 'missing_desc' THEN COUNT(CASE WHEN b.is_missing = 1 THEN 1 END) END DESC,
+    CASE WHEN ? = 'completion' THEN CASE WHEN COUNT(b.id) = 0 THEN 0.0 ELSE CAST(COUNT(b.id) - COUNT(CASE WHEN b.is_missing = 1 THEN 1 END) AS REAL) / COUNT(b.id) END END ASC,
+    CASE WHEN ? = 'completion_desc' THEN CASE WHEN COUNT(b.id) = 0 THEN 0.0 ELSE CAST(COUNT(b.id) - COUNT(CASE WHEN b.is_missing = 1 THEN 1 END) AS REAL) / COUNT(b.id) END END DESC,
+    CASE WHEN ? = 'checked' THEN s.last_checked_at END ASC,
+    CASE WHEN ? = 'checked_desc' THEN s.last_checked_at END DESC,
+    s.id ASC
 LIMIT ? OFFSET ?
 `
 
 type ListSeriesWithBookStatsParams struct {
-	Limit  int64 `json:"limit"`
-	Offset int64 `json:"offset"`
+	Incomplete *bool   `json:"incomplete"`
+	AuthorID   *int64  `json:"author_id"`
+	Unmapped   *bool   `json:"unmapped"`
+	Name       *string `json:"name"`
+	Sort       string  `json:"sort"`
+	Limit      int64   `json:"limit"`
+	Offset     int64   `json:"offset"`
 }
 
 type ListSeriesWithBookStatsRow struct {
-	ID           int64       `json:"id"`
-	SeriesID     int64       `json:"series_id"`
-	Name         string      `json:"name"`
-	Description  *string     `json:"description"`
-	Url          *string     `json:"url"`
-	Data         interface{} `json:"data"`
-	TotalBooks   int64       `json:"total_books"`
-	MissingBooks int64       `json:"missing_books"`
+	ID              int64       `json:"id"`
+	SeriesID        int64       `json:"series_id"`
+	Name            string      `json:"name"`
+	Description     *string     `json:"description"`
+	Url             *string     `json:"url"`
+	Data            interface{} `json:"data"`
+	LastCheckedAt   *time.Time  `json:"last_checked_at"`
+	LastCheckStatus *string     `json:"last_check_status"`
+	LastError       *string     `json:"last_error"`
+	Followed        bool        `json:"followed"`
+	TotalBooks      int64       `json:"total_books"`
+	MissingBooks    int64       `json:"missing_books"`
 }
 
 func (q *Queries) ListSeriesWithBookStats(ctx context.Context, arg ListSeriesWithBookStatsParams) ([]ListSeriesWithBookStatsRow, error) {
-	rows, err := q.db.QueryContext(ctx, listSeriesWithBookStats, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listSeriesWithBookStats,
+		arg.Incomplete,
+		arg.Incomplete,
+		arg.AuthorID,
+		arg.AuthorID,
+		arg.Unmapped,
+		arg.Unmapped,
+		arg.Name,
+		arg.Name,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Sort,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -694,6 +2968,10 @@ func (q *Queries) ListSeriesWithBookStats(ctx context.Context, arg ListSeriesWit
 			&i.Description,
 			&i.Url,
 			&i.Data,
+			&i.LastCheckedAt,
+			&i.LastCheckStatus,
+			&i.LastError,
+			&i.Followed,
 			&i.TotalBooks,
 			&i.MissingBooks,
 		); err != nil {
@@ -710,6 +2988,315 @@ func (q *Queries) ListSeriesWithBookStats(ctx context.Context, arg ListSeriesWit
 	return items, nil
 }
 
+const listTopAuthorsByMissingBooks = `-- name: ListTopAuthorsByMissingBooks :many
+SELECT a.id, a.name, COUNT(*) AS missing_books
+FROM authors a
+JOIN book_authors ba ON ba.author_id = a.id
+JOIN books b ON b.id = ba.book_id
+WHERE b.is_missing = 1
+GROUP BY a.id, a.name
+ORDER BY missing_books DESC, a.name COLLATE NOCASE ASC
+LIMIT ?
+`
+
+type ListTopAuthorsByMissingBooksRow struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	MissingBooks int64  `json:"missing_books"`
+}
+
+func (q *Queries) ListTopAuthorsByMissingBooks(ctx context.Context, limit int64) ([]ListTopAuthorsByMissingBooksRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTopAuthorsByMissingBooks, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTopAuthorsByMissingBooksRow
+	for rows.Next() {
+		var i ListTopAuthorsByMissingBooksRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.MissingBooks); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUpcomingBooks = `-- name: ListUpcomingBooks :many
+SELECT b.id, b.book_id, b.title, b.description, b.series_name, b.series_number, b.asin, b.isbn10, b.isbn13, b.language, b.hardcover_id, b.hardcover_book_id, b.goodreads_id, b.google_id, b.data, b.series_id, b.is_missing, b.publication_date, b.to_be_published, b.metadata_source, b.cover_url, b.goodreads_work_id, b.description_markdown, b.discovered_at FROM books b
+JOIN series s ON s.id = b.series_id
+WHERE s.followed = 1
+  AND (b.to_be_published = 1 OR (b.publication_date != '' AND b.publication_date >= substr(date('now'), 1, length(b.publication_date))))
+ORDER BY b.publication_date IS NULL, b.publication_date ASC, b.title ASC
+`
+
+func (q *Queries) ListUpcomingBooks(ctx context.Context) ([]Book, error) {
+	rows, err := q.db.QueryContext(ctx, listUpcomingBooks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Book
+	for rows.Next() {
+		var i Book
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.Title,
+			&i.Description,
+			&i.SeriesName,
+			&i.SeriesNumber,
+			&i.Asin,
+			&i.Isbn10,
+			&i.Isbn13,
+			&i.Language,
+			&i.HardcoverID,
+			&i.HardcoverBookID,
+			&i.GoodreadsID,
+			&i.GoogleID,
+			&i.Data,
+			&i.SeriesID,
+			&i.IsMissing,
+			&i.PublicationDate,
+			&i.ToBePublished,
+			&i.MetadataSource,
+			&i.CoverUrl,
+			&i.GoodreadsWorkID,
+			&i.DescriptionMarkdown,
+			&i.DiscoveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markEnrichLookup = `-- name: MarkEnrichLookup :exec
+INSERT INTO book_lookups (book_id, enrich_checked_at)
+VALUES (?, CURRENT_TIMESTAMP)
+ON CONFLICT(book_id) DO UPDATE SET enrich_checked_at = excluded.enrich_checked_at
+`
+
+func (q *Queries) MarkEnrichLookup(ctx context.Context, bookID int64) error {
+	_, err := q.db.ExecContext(ctx, markEnrichLookup, bookID)
+	return err
+}
+
+const markGoodreadsIDLookup = `-- name: MarkGoodreadsIDLookup :exec
+INSERT INTO book_lookups (book_id, goodreads_checked_at)
+VALUES (?, CURRENT_TIMESTAMP)
+ON CONFLICT(book_id) DO UPDATE SET goodreads_checked_at = excluded.goodreads_checked_at
+`
+
+func (q *Queries) MarkGoodreadsIDLookup(ctx context.Context, bookID int64) error {
+	_, err := q.db.ExecContext(ctx, markGoodreadsIDLookup, bookID)
+	return err
+}
+
+const markWorkIDLookup = `-- name: MarkWorkIDLookup :exec
+INSERT INTO book_lookups (book_id, work_id_checked_at)
+VALUES (?, CURRENT_TIMESTAMP)
+ON CONFLICT(book_id) DO UPDATE SET work_id_checked_at = excluded.work_id_checked_at
+`
+
+func (q *Queries) MarkWorkIDLookup(ctx context.Context, bookID int64) error {
+	_, err := q.db.ExecContext(ctx, markWorkIDLookup, bookID)
+	return err
+}
+
+const releaseJob = `-- name: ReleaseJob :execrows
+UPDATE jobs
+SET status = 'queued', attempts = attempts - 1, lease_expires_at = NULL, run_at = CURRENT_TIMESTAMP
+WHERE id = ? AND status = 'running' AND attempts = ?
+`
+
+type ReleaseJobParams struct {
+	ID       int64 `json:"id"`
+	Attempts int64 `json:"attempts"`
+}
+
+func (q *Queries) ReleaseJob(ctx context.Context, arg ReleaseJobParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, releaseJob, arg.ID, arg.Attempts)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const requeueJob = `-- name: RequeueJob :execrows
+UPDATE jobs
+SET status = 'queued', attempts = 0, run_at = CURRENT_TIMESTAMP, lease_expires_at = NULL, finished_at = NULL
+WHERE id = ? AND status = 'dead'
+`
+
+func (q *Queries) RequeueJob(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, requeueJob, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const resumeCompletionRun = `-- name: ResumeCompletionRun :exec
+UPDATE completion_runs
+SET status = 'running', finished_at = NULL
+WHERE id = ?
+`
+
+func (q *Queries) ResumeCompletionRun(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, resumeCompletionRun, id)
+	return err
+}
+
+const retryJob = `-- name: RetryJob :execrows
+UPDATE jobs
+SET status = 'queued',
+    lease_expires_at = NULL,
+    last_error = ?,
+    run_at = datetime('now', '+' || ? || ' seconds')
+WHERE id = ? AND status = 'running' AND attempts = ?
+`
+
+type RetryJobParams struct {
+	LastError    *string `json:"last_error"`
+	DelaySeconds int64   `json:"delay_seconds"`
+	ID           int64   `json:"id"`
+	Attempts     int64   `json:"attempts"`
+}
+
+func (q *Queries) RetryJob(ctx context.Context, arg RetryJobParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, retryJob,
+		arg.LastError,
+		arg.DelaySeconds,
+		arg.ID,
+		arg.Attempts,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const searchLibrary = `-- name: SearchLibrary :many
+SELECT CAST(kind AS TEXT) AS kind,
+    CAST(ref_id AS INTEGER) AS ref_id,
+    CAST(title AS TEXT) AS title,
+    CAST(highlight(search_index, 2, char(2), char(3)) AS TEXT) AS highlighted_title,
+    CAST(snippet(search_index, -1, char(2), char(3), '…', 16) AS TEXT) AS snippet,
+    CAST(bm25(search_index, 0.0, 0.0, 10.0, 1.0, 5.0, 3.0) AS REAL) AS rank
+FROM search_index
+WHERE search_index MATCH ?
+  AND kind = COALESCE(?, kind)
+ORDER BY rank
+LIMIT ?
+`
+
+type SearchLibraryParams struct {
+	Query string  `json:"query"`
+	Kind  *string `json:"kind"`
+	Limit int64   `json:"limit"`
+}
+
+type SearchLibraryRow struct {
+	Kind             string  `json:"kind"`
+	RefID            int64   `json:"ref_id"`
+	Title            string  `json:"title"`
+	HighlightedTitle string  `json:"highlighted_title"`
+	Snippet          string  `json:"snippet"`
+	Rank             float64 `json:"rank"`
+}
+
+func (q *Queries) SearchLibrary(ctx context.Context, arg SearchLibraryParams) ([]SearchLibraryRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchLibrary, arg.Query, arg.Kind, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchLibraryRow
+	for rows.Next() {
+		var i SearchLibraryRow
+		if err := rows.Scan(
+			&i.Kind,
+			&i.RefID,
+			&i.Title,
+			&i.HighlightedTitle,
+			&i.Snippet,
+			&i.Rank,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setAuthorGoodreadsID = `-- name: SetAuthorGoodreadsID :exec
+UPDATE authors
+SET goodreads_id = ?
+WHERE name = ?
+`
+
+type SetAuthorGoodreadsIDParams struct {
+	GoodreadsID *string `json:"goodreads_id"`
+	Name        string  `json:"name"`
+}
+
+func (q *Queries) SetAuthorGoodreadsID(ctx context.Context, arg SetAuthorGoodreadsIDParams) error {
+	_, err := q.db.ExecContext(ctx, setAuthorGoodreadsID, arg.GoodreadsID, arg.Name)
+	return err
+}
+
+const setBookGoodreadsID = `-- name: SetBookGoodreadsID :exec
+UPDATE books
+SET goodreads_id = ?
+WHERE id = ?
+`
+
+type SetBookGoodreadsIDParams struct {
+	GoodreadsID *string `json:"goodreads_id"`
+	ID          int64   `json:"id"`
+}
+
+func (q *Queries) SetBookGoodreadsID(ctx context.Context, arg SetBookGoodreadsIDParams) error {
+	_, err := q.db.ExecContext(ctx, setBookGoodreadsID, arg.GoodreadsID, arg.ID)
+	return err
+}
+
+const setBookGoodreadsWorkID = `-- name: SetBookGoodreadsWorkID :exec
+UPDATE books
+SET goodreads_work_id = ?
+WHERE id = ?
+`
+
+type SetBookGoodreadsWorkIDParams struct {
+	GoodreadsWorkID *string `json:"goodreads_work_id"`
+	ID              int64   `json:"id"`
+}
+
+func (q *Queries) SetBookGoodreadsWorkID(ctx context.Context, arg SetBookGoodreadsWorkIDParams) error {
+	_, err := q.db.ExecContext(ctx, setBookGoodreadsWorkID, arg.GoodreadsWorkID, arg.ID)
+	return err
+}
+
 const setConfig = `-- name: SetConfig :exec
 INSERT INTO configuration (key, value)
 VALUES (?, ?)
@@ -726,6 +3313,58 @@ func (q *Queries) SetConfig(ctx context.Context, arg SetConfigParams) error {
 	return err
 }
 
+const setMatchReviewStatus = `-- name: SetMatchReviewStatus :exec
+UPDATE match_reviews
+SET status = ?
+WHERE id = ?
+`
+
+type SetMatchReviewStatusParams struct {
+	Status string `json:"status"`
+	ID     int64  `json:"id"`
+}
+
+func (q *Queries) SetMatchReviewStatus(ctx context.Context, arg SetMatchReviewStatusParams) error {
+	_, err := q.db.ExecContext(ctx, setMatchReviewStatus, arg.Status, arg.ID)
+	return err
+}
+
+const setSeriesCheckResult = `-- name: SetSeriesCheckResult :exec
+UPDATE series
+SET last_checked_at = CURRENT_TIMESTAMP, last_check_status = ?, last_error = ?
+WHERE id = ?
+`
+
+type SetSeriesCheckResultParams struct {
+	LastCheckStatus *string `json:"last_check_status"`
+	LastError       *string `json:"last_error"`
+	ID              int64   `json:"id"`
+}
+
+func (q *Queries) SetSeriesCheckResult(ctx context.Context, arg SetSeriesCheckResultParams) error {
+	_, err := q.db.ExecContext(ctx, setSeriesCheckResult, arg.LastCheckStatus, arg.LastError, arg.ID)
+	return err
+}
+
+const setSeriesFollowed = `-- name: SetSeriesFollowed :execrows
+UPDATE series
+SET followed = ?
+WHERE id = ?
+`
+
+type SetSeriesFollowedParams struct {
+	Followed bool  `json:"followed"`
+	ID       int64 `json:"id"`
+}
+
+func (q *Queries) SetSeriesFollowed(ctx context.Context, arg SetSeriesFollowedParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setSeriesFollowed, arg.Followed, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateBookSeries = `-- name: UpdateBookSeries :exec
 UPDATE books
 SET series_id = ?
@@ -746,22 +3385,23 @@ const upsertAuthor = `-- name: UpsertAuthor :one
 INSERT INTO authors (name)
 VALUES (?)
 ON CONFLICT(name) DO UPDATE SET name=excluded.name
-RETURNING id, name
+RETURNING id, name, goodreads_id
 `
 
 func (q *Queries) UpsertAuthor(ctx context.Context, name string) (Author, error) {
 	row := q.db.QueryRowContext(ctx, upsertAuthor, name)
 	var i Author
-	err := row.Scan(&i.ID, &i.Name)
+	err := row.Scan(&i.ID, &i.Name, &i.GoodreadsID)
 	return i, err
 }
 
 const upsertBook = `-- name: UpsertBook :one
-INSERT INTO books (book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, is_missing)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO books (book_id, title, description, description_markdown, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, is_missing)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(book_id) DO UPDATE SET
     title = excluded.title,
     description = excluded.description,
+    description_markdown = excluded.description_markdown,
     series_name = excluded.series_name,
     series_number = excluded.series_number,
     asin = excluded.asin,
@@ -774,25 +3414,26 @@ ON CONFLICT(book_id) DO UPDATE SET
     google_id = excluded.google_id,
     data = excluded.data,
     is_missing = excluded.is_missing
-RETURNING id, book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, series_id, is_missing
+RETURNING id, book_id, title, description, series_name, series_number, asin, isbn10, isbn13, language, hardcover_id, hardcover_book_id, goodreads_id, google_id, data, series_id, is_missing, publication_date, to_be_published, metadata_source, cover_url, goodreads_work_id, description_markdown, discovered_at
 `
 
 type UpsertBookParams struct {
-	BookID          int64       `json:"book_id"`
-	Title           string      `json:"title"`
-	Description     string      `json:"description"`
-	SeriesName      *string     `json:"series_name"`
-	SeriesNumber    *float64    `json:"series_number"`
-	Asin            *string     `json:"asin"`
-	Isbn10          *string     `json:"isbn10"`
-	Isbn13          *string     `json:"isbn13"`
-	Language        *string     `json:"language"`
-	HardcoverID     *string     `json:"hardcover_id"`
-	HardcoverBookID *int64      `json:"hardcover_book_id"`
-	GoodreadsID     *string     `json:"goodreads_id"`
-	GoogleID        *string     `json:"google_id"`
-	Data            interface{} `json:"data"`
-	IsMissing       *bool       `json:"is_missing"`
+	BookID              int64       `json:"book_id"`
+	Title               string      `json:"title"`
+	Description         string      `json:"description"`
+	DescriptionMarkdown *string     `json:"description_markdown"`
+	SeriesName          *string     `json:"series_name"`
+	SeriesNumber        *float64    `json:"series_number"`
+	Asin                *string     `json:"asin"`
+	Isbn10              *string     `json:"isbn10"`
+	Isbn13              *string     `json:"isbn13"`
+	Language            *string     `json:"language"`
+	HardcoverID         *string     `json:"hardcover_id"`
+	HardcoverBookID     *int64      `json:"hardcover_book_id"`
+	GoodreadsID         *string     `json:"goodreads_id"`
+	GoogleID            *string     `json:"google_id"`
+	Data                interface{} `json:"data"`
+	IsMissing           *bool       `json:"is_missing"`
 }
 
 func (q *Queries) UpsertBook(ctx context.Context, arg UpsertBookParams) (Book, error) {
@@ -800,6 +3441,7 @@ func (q *Queries) UpsertBook(ctx context.Context, arg UpsertBookParams) (Book, e
 		arg.BookID,
 		arg.Title,
 		arg.Description,
+		arg.DescriptionMarkdown,
 		arg.SeriesName,
 		arg.SeriesNumber,
 		arg.Asin,
@@ -832,6 +3474,112 @@ func (q *Queries) UpsertBook(ctx context.Context, arg UpsertBookParams) (Book, e
 		&i.Data,
 		&i.SeriesID,
 		&i.IsMissing,
+		&i.PublicationDate,
+		&i.ToBePublished,
+		&i.MetadataSource,
+		&i.CoverUrl,
+		&i.GoodreadsWorkID,
+		&i.DescriptionMarkdown,
+		&i.DiscoveredAt,
+	)
+	return i, err
+}
+
+const upsertLibrarySnapshot = `-- name: UpsertLibrarySnapshot :one
+INSERT INTO library_snapshots (taken_on, total_books, owned_books, missing_books, unreleased_books, total_series, complete_series, incomplete_series, books_without_identifiers)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(taken_on) DO UPDATE SET
+    total_books = excluded.total_books,
+    owned_books = excluded.owned_books,
+    missing_books = excluded.missing_books,
+    unreleased_books = excluded.unreleased_books,
+    total_series = excluded.total_series,
+    complete_series = excluded.complete_series,
+    incomplete_series = excluded.incomplete_series,
+    books_without_identifiers = excluded.books_without_identifiers,
+    taken_at = CURRENT_TIMESTAMP
+RETURNING id, taken_on, total_books, owned_books, missing_books, unreleased_books, total_series, complete_series, incomplete_series, books_without_identifiers, taken_at
+`
+
+type UpsertLibrarySnapshotParams struct {
+	TakenOn                 string `json:"taken_on"`
+	TotalBooks              int64  `json:"total_books"`
+	OwnedBooks              int64  `json:"owned_books"`
+	MissingBooks            int64  `json:"missing_books"`
+	UnreleasedBooks         int64  `json:"unreleased_books"`
+	TotalSeries             int64  `json:"total_series"`
+	CompleteSeries          int64  `json:"complete_series"`
+	IncompleteSeries        int64  `json:"incomplete_series"`
+	BooksWithoutIdentifiers int64  `json:"books_without_identifiers"`
+}
+
+func (q *Queries) UpsertLibrarySnapshot(ctx context.Context, arg UpsertLibrarySnapshotParams) (LibrarySnapshot, error) {
+	row := q.db.QueryRowContext(ctx, upsertLibrarySnapshot,
+		arg.TakenOn,
+		arg.TotalBooks,
+		arg.OwnedBooks,
+		arg.MissingBooks,
+		arg.UnreleasedBooks,
+		arg.TotalSeries,
+		arg.CompleteSeries,
+		arg.IncompleteSeries,
+		arg.BooksWithoutIdentifiers,
+	)
+	var i LibrarySnapshot
+	err := row.Scan(
+		&i.ID,
+		&i.TakenOn,
+		&i.TotalBooks,
+		&i.OwnedBooks,
+		&i.MissingBooks,
+		&i.UnreleasedBooks,
+		&i.TotalSeries,
+		&i.CompleteSeries,
+		&i.IncompleteSeries,
+		&i.BooksWithoutIdentifiers,
+		&i.TakenAt,
+	)
+	return i, err
+}
+
+const upsertMatchReview = `-- name: UpsertMatchReview :one
+INSERT INTO match_reviews (series_id, owned_book_id, candidate_book_id, candidate_title, candidate, score)
+VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT(series_id, owned_book_id, candidate_book_id) DO UPDATE SET
+    candidate_title = excluded.candidate_title,
+    candidate = excluded.candidate,
+    score = excluded.score
+RETURNING id, series_id, owned_book_id, candidate_book_id, candidate_title, candidate, score, status
+`
+
+type UpsertMatchReviewParams struct {
+	SeriesID        int64   `json:"series_id"`
+	OwnedBookID     int64   `json:"owned_book_id"`
+	CandidateBookID int64   `json:"candidate_book_id"`
+	CandidateTitle  string  `json:"candidate_title"`
+	Candidate       string  `json:"candidate"`
+	Score           float64 `json:"score"`
+}
+
+func (q *Queries) UpsertMatchReview(ctx context.Context, arg UpsertMatchReviewParams) (MatchReview, error) {
+	row := q.db.QueryRowContext(ctx, upsertMatchReview,
+		arg.SeriesID,
+		arg.OwnedBookID,
+		arg.CandidateBookID,
+		arg.CandidateTitle,
+		arg.Candidate,
+		arg.Score,
+	)
+	var i MatchReview
+	err := row.Scan(
+		&i.ID,
+		&i.SeriesID,
+		&i.OwnedBookID,
+		&i.CandidateBookID,
+		&i.CandidateTitle,
+		&i.Candidate,
+		&i.Score,
+		&i.Status,
 	)
 	return i, err
 }
@@ -844,7 +3592,7 @@ ON CONFLICT(series_id) DO UPDATE SET
     description = excluded.description,
     url = excluded.url,
     data = excluded.data
-RETURNING id, series_id, name, description, url, data
+RETURNING id, series_id, name, description, url, data, last_checked_at, last_check_status, last_error, followed
 `
 
 type UpsertSeriesParams struct {
@@ -871,6 +3619,10 @@ func (q *Queries) UpsertSeries(ctx context.Context, arg UpsertSeriesParams) (Ser
 		&i.Description,
 		&i.Url,
 		&i.Data,
+		&i.LastCheckedAt,
+		&i.LastCheckStatus,
+		&i.LastError,
+		&i.Followed,
 	)
 	return i, err
 }
@@ -0,0 +1,34 @@
+package db
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestQueries_latestSeriesSnapshot(t *testing.T) {
+	t.Chdir("../..")
+	ctx := context.Background()
+	queries := New(migratedDB(t, filepath.Join(t.TempDir(), "library.db")))
+
+	series, err := queries.CreateSeries(ctx, CreateSeriesParams{SeriesID: 1, Name: "Series"})
+	if err != nil {
+		t.Fatalf("CreateSeries() error = %v", err)
+	}
+
+	// Providers list a series differently, so each is compared only with its own snapshots
+	for _, provider := range []string{"goodreads", "hardcover"} {
+		_, err := queries.CreateSeriesSnapshot(ctx, CreateSeriesSnapshotParams{SeriesID: series.ID, Provider: provider, Entries: provider})
+		if err != nil {
+			t.Fatalf("CreateSeriesSnapshot() error = %v", err)
+		}
+	}
+
+	latest, err := queries.GetLatestSeriesSnapshot(ctx, GetLatestSeriesSnapshotParams{SeriesID: series.ID, Provider: "goodreads"})
+	if err != nil {
+		t.Fatalf("GetLatestSeriesSnapshot() error = %v", err)
+	}
+	if latest.Entries != "goodreads" {
+		t.Errorf("GetLatestSeriesSnapshot() = %q snapshot, want the goodreads one", latest.Entries)
+	}
+}
@@ -4,28 +4,32 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"io/fs"
 	"log/slog"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
+	"unicode"
 
 	_ "modernc.org/sqlite"
 )
 
-func SetupDatabase() (Querier, error) {
-	slog.Debug("Setting up database")
+// migrationsDir holds the dbmate migrations, relative to the working directory
+const migrationsDir = "db/migrations"
 
-	// Determine database path: prefer mounted /data folder, fall back to ./db
-	var dbFilePath string
+// FilePath returns the path of the database file: the mounted /data folder when there is one, ./db otherwise
+func FilePath() string {
 	if _, err := os.Stat("/data"); err == nil {
-		dbFilePath = "/data/bookscraping.db"
-		slog.Debug("Using mounted /data folder", slog.String("path", dbFilePath))
-	} else {
-		dbFilePath = "./db/bookscraping.db"
-		slog.Debug("Using local db folder", slog.String("path", dbFilePath))
+		return "/data/bookscraping.db"
 	}
+	return "./db/bookscraping.db"
+}
+
+func SetupDatabase() (Querier, error) {
+	slog.Debug("Setting up database")
+
+	dbFilePath := FilePath()
+	slog.Debug("Using database file", slog.String("path", dbFilePath))
 
 	// Ensure parent directory exists
 	dbDir := filepath.Dir(dbFilePath)
@@ -54,7 +58,7 @@ func SetupDatabase() (Querier, error) {
 
 	// Create queries instance
 	queries := New(sqlDB)
-	count, err := queries.CountBooks(context.Background())
+	count, err := queries.CountBooks(context.Background(), CountBooksParams{})
 	if err != nil {
 		slog.Error("Failed to count books in database", slog.Any("error", err))
 		if closeErr := sqlDB.Close(); closeErr != nil {
@@ -81,28 +85,13 @@ func runMigrations(sqlDB *sql.DB) error {
 		return fmt.Errorf("failed to create schema_migrations table: %w", err)
 	}
 
-	// Find and sort migration files
-	migrationsDir := "db/migrations"
-	entries, err := os.ReadDir(migrationsDir)
+	migrations, err := migrationFiles()
 	if err != nil {
-		return fmt.Errorf("failed to read migrations directory: %w", err)
-	}
-
-	var migrations []fs.DirEntry
-	for _, entry := range entries {
-		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".sql") {
-			migrations = append(migrations, entry)
-		}
+		return err
 	}
 
-	// Sort migrations by filename (timestamp-based)
-	sort.Slice(migrations, func(i, j int) bool {
-		return migrations[i].Name() < migrations[j].Name()
-	})
-
 	// Execute each migration
-	for _, migration := range migrations {
-		filename := migration.Name()
+	for _, filename := range migrations {
 		version := strings.TrimSuffix(filename, ".sql")
 
 		// Check if migration has already been applied
@@ -169,6 +158,23 @@ func runMigrations(sqlDB *sql.DB) error {
 	return nil
 }
 
+// migrationFiles lists the migration file names, oldest first (they start with a timestamp)
+func migrationFiles() ([]string, error) {
+	entries, err := os.ReadDir(migrationsDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations directory: %w", err)
+	}
+
+	var migrations []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".sql") {
+			migrations = append(migrations, entry.Name())
+		}
+	}
+	sort.Strings(migrations)
+	return migrations, nil
+}
+
 // extractUpSQL extracts the SQL between '-- migrate:up' and '-- migrate:down' markers
 func extractUpSQL(content string) string {
 	lines := strings.Split(content, "\n")
@@ -195,7 +201,8 @@ func extractUpSQL(content string) string {
 	return strings.TrimSpace(strings.Join(upLines, "\n"))
 }
 
-// splitStatements splits SQL by semicolon, handling strings and inline comments properly
+// splitStatements splits SQL by semicolon, handling strings and inline comments properly.
+// Semicolons inside the BEGIN ... END body of a CREATE TRIGGER don't end the statement.
 func splitStatements(sql string) []string {
 	// First, remove inline comments (-- to end of line)
 	sql = removeInlineComments(sql)
@@ -207,9 +214,40 @@ func splitStatements(sql string) []string {
 	var i int
 	runes := []rune(sql)
 
+	// depth counts the open BEGIN and CASE blocks of a trigger body
+	var word strings.Builder
+	depth := 0
+	endWord := func() {
+		switch strings.ToUpper(word.String()) {
+		case "BEGIN":
+			if depth > 0 || isCreateTrigger(current.String()) {
+				depth++
+			}
+		case "CASE":
+			if depth > 0 {
+				depth++
+			}
+		case "END":
+			if depth > 0 {
+				depth--
+			}
+		}
+		word.Reset()
+	}
+
 	for i < len(runes) {
 		char := runes[i]
 
+		if !inString && (unicode.IsLetter(char) || unicode.IsDigit(char) || char == '_') {
+			word.WriteRune(char)
+			current.WriteRune(char)
+			i++
+			continue
+		}
+		if !inString {
+			endWord()
+		}
+
 		if !inString && (char == '\'' || char == '"') {
 			inString = true
 			stringChar = char
@@ -224,7 +262,7 @@ func splitStatements(sql string) []string {
 				inString = false
 				current.WriteRune(char)
 			}
-		} else if !inString && char == ';' {
+		} else if !inString && char == ';' && depth == 0 {
 			statements = append(statements, current.String())
 			current.Reset()
 		} else {
@@ -242,6 +280,18 @@ func splitStatements(sql string) []string {
 	return statements
 }
 
+// isCreateTrigger reports whether a statement creates a trigger
+func isCreateTrigger(stmt string) bool {
+	fields := strings.Fields(strings.ToUpper(stmt))
+	if len(fields) < 2 || fields[0] != "CREATE" {
+		return false
+	}
+	if fields[1] == "TEMP" || fields[1] == "TEMPORARY" {
+		fields = fields[1:]
+	}
+	return len(fields) > 1 && fields[1] == "TRIGGER"
+}
+
 // removeInlineComments removes SQL inline comments (-- to end of line) while preserving strings
 func removeInlineComments(sql string) string {
 	var result strings.Builder
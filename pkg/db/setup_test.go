@@ -122,6 +122,23 @@ func TestSplitStatements(t *testing.T) {
 				" SELECT 1",
 			},
 		},
+		{
+			name:  "trigger body",
+			input: "CREATE TRIGGER t AFTER INSERT ON x BEGIN INSERT INTO y VALUES (new.id); DELETE FROM z WHERE id = CASE WHEN new.id > 1 THEN 1 END; END; SELECT 1;",
+			expected: []string{
+				"CREATE TRIGGER t AFTER INSERT ON x BEGIN INSERT INTO y VALUES (new.id); DELETE FROM z WHERE id = CASE WHEN new.id > 1 THEN 1 END; END",
+				" SELECT 1",
+			},
+		},
+		{
+			name:  "begin outside a trigger",
+			input: "BEGIN; SELECT 1; END;",
+			expected: []string{
+				"BEGIN",
+				" SELECT 1",
+				" END",
+			},
+		},
 		{
 			name:  "escaped quotes",
 			input: "INSERT INTO x VALUES ('it''s ok'); SELECT 1;",
@@ -0,0 +1,28 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// InTx runs fn with queries bound to a transaction, committing when fn succeeds and rolling back otherwise
+func (q *Queries) InTx(ctx context.Context, fn func(Querier) error) error {
+	sqlDB, ok := q.db.(*sql.DB)
+	if !ok {
+		return errors.New("already in a transaction")
+	}
+
+	tx, err := sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	if err := fn(q.WithTx(tx)); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// maxAuthorSeriesPages caps pagination so a broken next-page link can't loop forever
+const maxAuthorSeriesPages = 20
+
+var (
+	seriesIDPattern     = regexp.MustCompile(`/series/(\d+)`)
+	primaryWorksPattern = regexp.MustCompile(`(\d+)\s+primary works?`)
+	totalWorksPattern   = regexp.MustCompile(`(\d+)\s+total works?`)
+)
+
+// AuthorSeries is a series listed on a Goodreads author's series page
+type AuthorSeries struct {
+	ID           string `json:"id"`
+	Title        string `json:"title"`
+	URL          string `json:"url"`
+	PrimaryWorks int    `json:"primary_works"`
+	TotalWorks   int    `json:"total_works"`
+}
+
+// GetAuthorSeries fetches every series listed on a Goodreads author's series page
+func (c *Client) GetAuthorSeries(ctx context.Context, authorID string) ([]AuthorSeries, error) {
+	var allSeries []AuthorSeries
+	seen := make(map[string]bool)
+
+	for page := 1; page <= maxAuthorSeriesPages; page++ {
+		url := fmt.Sprintf("%s/series/list?id=%s&page=%d", c.baseURL, authorID, page)
+		slog.Info("Fetching author series from URL", slog.String("url", url))
+
+		doc, err := c.fetchDocument(ctx, url)
+		if err != nil {
+			return nil, err
+		}
+
+		pageSeries, hasNext, err := c.parseAuthorSeriesPage(doc, url)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, series := range pageSeries {
+			if seen[series.ID] {
+				continue
+			}
+			seen[series.ID] = true
+			allSeries = append(allSeries, series)
+		}
+
+		if !hasNext || len(pageSeries) == 0 {
+			break
+		}
+	}
+
+	slog.Info("Parsed author series", slog.String("author_id", authorID), slog.Int("count", len(allSeries)))
+	return allSeries, nil
+}
+
+// parseAuthorSeriesPage extracts the series on one page of an author's series list
+func (c *Client) parseAuthorSeriesPage(doc *goquery.Document, url string) ([]AuthorSeries, bool, error) {
+	sel := c.selectors.AuthorSeries
+
+	container := doc.Find(sel.Container)
+	if container.Length() == 0 {
+		return nil, false, &MarkerError{URL: url, Marker: sel.Container}
+	}
+
+	var series []AuthorSeries
+	container.Find(sel.Item).Each(func(i int, s *goquery.Selection) {
+		link := s.Find(sel.Link).First()
+		href, _ := link.Attr("href")
+		matches := seriesIDPattern.FindStringSubmatch(href)
+		if len(matches) < 2 {
+			slog.Debug("Skipping author series entry without series link", slog.Int("index", i))
+			return
+		}
+
+		entry := AuthorSeries{
+			ID:    matches[1],
+			Title: strings.TrimSpace(link.Text()),
+			URL:   c.baseURL + href,
+		}
+		if strings.HasPrefix(href, "http") {
+			entry.URL = href
+		}
+
+		if sel.Works != "" {
+			works := s.Find(sel.Works).Text()
+			entry.PrimaryWorks = matchInt(primaryWorksPattern, works)
+			entry.TotalWorks = matchInt(totalWorksPattern, works)
+		}
+
+		series = append(series, entry)
+	})
+
+	hasNext := sel.NextPage != "" && doc.Find(sel.NextPage).Length() > 0
+	return series, hasNext, nil
+}
+
+func matchInt(pattern *regexp.Regexp, text string) int {
+	matches := pattern.FindStringSubmatch(text)
+	if len(matches) < 2 {
+		return 0
+	}
+	n, _ := strconv.Atoi(matches[1])
+	return n
+}
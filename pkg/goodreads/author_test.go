@@ -0,0 +1,77 @@
+package goodreads
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+)
+
+const authorSeriesLastPage = `<html><body><div class="mainContent">
+<div class="seriesDesc">
+<a class="bookTitle" href="/series/53205-david-trevellyan">David Trevellyan</a>
+<span class="greyText">3 primary works &bull; 3 total works</span>
+</div>
+<div class="seriesDesc">
+<a class="bookTitle" href="/series/300001-standalone-shorts">Standalone Shorts</a>
+<span class="greyText">1 primary work &bull; 4 total works</span>
+</div>
+</div></body></html>`
+
+func TestGetAuthorSeries(t *testing.T) {
+	firstPage, err := os.ReadFile("fixtures/author_series.html")
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+
+	var requested []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.RawQuery)
+		if r.URL.Query().Get("id") != "2922340" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.URL.Query().Get("page") == "2" {
+			_, _ = w.Write([]byte(authorSeriesLastPage))
+			return
+		}
+		_, _ = w.Write(firstPage)
+	}))
+	defer srv.Close()
+
+	client := NewClient()
+	client.baseURL = srv.URL
+
+	series, err := client.GetAuthorSeries(context.Background(), "2922340")
+	if err != nil {
+		t.Fatalf("GetAuthorSeries() error = %v", err)
+	}
+
+	want := []AuthorSeries{
+		{ID: "53205", Title: "David Trevellyan", URL: srv.URL + "/series/53205-david-trevellyan", PrimaryWorks: 3, TotalWorks: 3},
+		{ID: "218131", Title: "Cooper Fisher", URL: srv.URL + "/series/218131-cooper-fisher", PrimaryWorks: 2, TotalWorks: 3},
+		{ID: "300001", Title: "Standalone Shorts", URL: srv.URL + "/series/300001-standalone-shorts", PrimaryWorks: 1, TotalWorks: 4},
+	}
+	if !reflect.DeepEqual(series, want) {
+		t.Errorf("GetAuthorSeries() = %+v, want %+v", series, want)
+	}
+	if len(requested) != 2 {
+		t.Errorf("expected 2 page requests, got %v", requested)
+	}
+
+	if _, err := client.GetAuthorSeries(context.Background(), "1"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetAuthorSeries() for unknown author error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestGetAuthorSeriesLayoutChanged(t *testing.T) {
+	client := newTestClient(t, http.StatusOK, `<html><body><div class="redesigned"></div></body></html>`)
+
+	_, err := client.GetAuthorSeries(context.Background(), "2922340")
+	if !errors.Is(err, ErrLayoutChanged) {
+		t.Fatalf("GetAuthorSeries() error = %v, want ErrLayoutChanged", err)
+	}
+}
@@ -0,0 +1,25 @@
+package goodreads
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// AutoComplete queries the Goodreads search box endpoint, which answers with the same book
+// objects the series pages embed. ISBNs work as queries too.
+func (c *Client) AutoComplete(ctx context.Context, query string) ([]SeriesBookk, error) {
+	searchURL := fmt.Sprintf("%s/book/auto_complete?format=json&q=%s", c.baseURL, url.QueryEscape(query))
+
+	body, err := c.fetchJSON(ctx, searchURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var books []SeriesBookk
+	if err := json.Unmarshal(body, &books); err != nil {
+		return nil, fmt.Errorf("%w: parsing search results: %v", ErrLayoutChanged, err)
+	}
+	return books, nil
+}
@@ -1,20 +1,100 @@
 package goodreads
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultRequestInterval is the spacing between requests the server uses unless configured otherwise
+const DefaultRequestInterval = time.Second
 
 type Client struct {
 	baseURL    string
 	httpClient *http.Client
+	selectors  *Selectors
+
+	// interval is the minimum time between the starts of two requests; 0 disables rate limiting
+	interval time.Duration
+	limitMu  sync.Mutex
+	next     time.Time
+}
+
+// ClientOption configures a Client
+type ClientOption func(*Client)
+
+// WithSelectors overrides the default selector profile
+func WithSelectors(selectors *Selectors) ClientOption {
+	return func(c *Client) {
+		c.selectors = selectors
+	}
+}
+
+// WithBaseURL points the client at a different Goodreads host
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		c.baseURL = baseURL
+	}
+}
+
+// WithRateLimit spaces requests at least interval apart, across all callers of the client
+func WithRateLimit(interval time.Duration) ClientOption {
+	return func(c *Client) {
+		c.interval = interval
+	}
 }
 
 // NewClient creates a new Goodreads client
-func NewClient() *Client {
+func NewClient(opts ...ClientOption) *Client {
 	client := &http.Client{}
 	client.Transport = &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
 	}
-	return &Client{
+	c := &Client{
 		baseURL:    "https://www.goodreads.com",
 		httpClient: client,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.selectors == nil {
+		c.selectors = DefaultSelectors()
+	}
+	return c
+}
+
+// Selectors returns a copy of the active selector profile
+func (c *Client) Selectors() Selectors {
+	return *c.selectors
+}
+
+// wait blocks until the rate limit allows another request or ctx is done
+func (c *Client) wait(ctx context.Context) error {
+	if c.interval <= 0 {
+		return nil
+	}
+
+	// Reserve the next slot, then sleep until it comes round
+	c.limitMu.Lock()
+	now := time.Now()
+	slot := c.next
+	if slot.Before(now) {
+		slot = now
+	}
+	c.next = slot.Add(c.interval)
+	c.limitMu.Unlock()
+
+	delay := time.Until(slot)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
@@ -0,0 +1,32 @@
+package goodreads
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimit(t *testing.T) {
+	client := newTestClient(t, http.StatusOK, `{}`)
+	client.interval = 40 * time.Millisecond
+
+	start := time.Now()
+	for range 3 {
+		if _, err := client.fetchJSON(context.Background(), client.baseURL+"/"); err != nil {
+			t.Fatalf("fetchJSON() error = %v", err)
+		}
+	}
+	// The first request goes straight through; the other two wait an interval each
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Errorf("three requests took %v, want at least 80ms", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	client.next = time.Now().Add(time.Hour)
+	if _, err := client.fetchJSON(ctx, client.baseURL+"/"); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled while waiting, got %v", err)
+	}
+}
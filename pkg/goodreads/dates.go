@@ -0,0 +1,16 @@
+package goodreads
+
+import (
+	"strings"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+)
+
+// NormalizePublicationDate strips the wording Goodreads puts around publication dates
+// and normalizes what's left with metadata.NormalizeDate.
+func NormalizePublicationDate(raw string) string {
+	raw = strings.TrimSpace(raw)
+	raw = strings.TrimPrefix(raw, "Expected publication ")
+	raw = strings.TrimPrefix(raw, "Published ")
+	return metadata.NormalizeDate(raw)
+}
@@ -0,0 +1,29 @@
+package goodreads
+
+import "testing"
+
+func TestNormalizePublicationDate(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"2026-03-10", "2026-03-10"},
+		{"March 10, 2026", "2026-03-10"},
+		{"Mar 10, 2026", "2026-03-10"},
+		{"Expected publication March 10, 2026", "2026-03-10"},
+		{"03/10/2026", "2026-03-10"},
+		{"March 2026", "2026-03"},
+		{"2026", "2026"},
+		{"  2010 ", "2010"},
+		{"", ""},
+		{"sometime soon", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := NormalizePublicationDate(tt.input); got != tt.expected {
+				t.Errorf("NormalizePublicationDate(%q) = %q, want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package goodreads
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var (
+	// ErrBlocked is returned when Goodreads serves an anti-bot interstitial or captcha instead of the requested page
+	ErrBlocked = errors.New("goodreads: request blocked by anti-bot page")
+	// ErrNotFound is returned when Goodreads responds with 404 for the requested page
+	ErrNotFound = errors.New("goodreads: page not found")
+	// ErrLayoutChanged is returned when a page is missing an element the parser depends on
+	ErrLayoutChanged = errors.New("goodreads: page layout changed")
+)
+
+// Error kinds reported by ErrorKind
+const (
+	ErrorKindBlocked = "blocked"
+	ErrorKindStatus  = "status"
+	ErrorKindLayout  = "layout"
+	ErrorKindOther   = "other"
+)
+
+// StatusError is returned when Goodreads responds with a non-200 status code
+type StatusError struct {
+	URL        string
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("goodreads: unexpected status %d for %s", e.StatusCode, e.URL)
+}
+
+// Is reports a 404 StatusError as ErrNotFound
+func (e *StatusError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+// MarkerError is returned when an expected page marker (selector or attribute) is absent
+type MarkerError struct {
+	URL    string
+	Marker string
+}
+
+func (e *MarkerError) Error() string {
+	return fmt.Sprintf("goodreads: expected marker %q not found on %s", e.Marker, e.URL)
+}
+
+func (e *MarkerError) Unwrap() error {
+	return ErrLayoutChanged
+}
+
+// ErrorKind classifies an error returned by the client so callers can report it without type switches
+func ErrorKind(err error) string {
+	var statusErr *StatusError
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, ErrBlocked):
+		return ErrorKindBlocked
+	case errors.As(err, &statusErr):
+		return ErrorKindStatus
+	case errors.Is(err, ErrLayoutChanged):
+		return ErrorKindLayout
+	default:
+		return ErrorKindOther
+	}
+}
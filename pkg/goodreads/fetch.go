@@ -0,0 +1,126 @@
+package goodreads
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// fetchDocument fetches a Goodreads page and parses it, rejecting block pages and non-200 responses
+func (c *Client) fetchDocument(ctx context.Context, url string) (*goquery.Document, error) {
+	body, status, err := c.fetch(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("parsing page HTML: %w", err)
+	}
+
+	if err := c.checkResponse(doc, url, status); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// fetchJSON fetches a Goodreads JSON endpoint. An HTML answer is checked for a block page,
+// since that is what Goodreads serves instead of JSON when it is rate limiting.
+func (c *Client) fetchJSON(ctx context.Context, url string) ([]byte, error) {
+	body, status, err := c.fetch(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '<' {
+		doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("parsing page HTML: %w", err)
+		}
+		if err := c.checkResponse(doc, url, status); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: expected JSON from %s", ErrLayoutChanged, url)
+	}
+
+	if status != http.StatusOK {
+		return nil, &StatusError{URL: url, StatusCode: status}
+	}
+
+	return body, nil
+}
+
+// fetch performs a GET request and returns the body and status code
+func (c *Client) fetch(ctx context.Context, url string) ([]byte, int, error) {
+	if err := c.wait(ctx); err != nil {
+		return nil, 0, fmt.Errorf("waiting for rate limit: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("creating request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("fetching page: %w", err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			slog.Error("Failed to close response body", slog.Any("error", err))
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, fmt.Errorf("reading page: %w", err)
+	}
+
+	return body, resp.StatusCode, nil
+}
+
+// checkResponse rejects block pages and non-200 responses
+func (c *Client) checkResponse(doc *goquery.Document, url string, status int) error {
+	// Block pages are often served with 403/503, so check for them before the status code
+	if isBlockPage(doc, c.selectors.Block) {
+		slog.Warn("Goodreads served an anti-bot page", slog.String("url", url), slog.Int("status", status))
+		return fmt.Errorf("%w (status %d)", ErrBlocked, status)
+	}
+
+	if status != http.StatusOK {
+		return &StatusError{URL: url, StatusCode: status}
+	}
+
+	return nil
+}
+
+// isBlockPage reports whether the document is an anti-bot interstitial rather than real content.
+// Titles have to match whole, since a book or series can be called "Access Denied" too.
+func isBlockPage(doc *goquery.Document, block BlockSelectors) bool {
+	title := normalizeTitle(doc.Find("title").First().Text())
+	for _, blockTitle := range block.Titles {
+		if title == normalizeTitle(blockTitle) {
+			return true
+		}
+	}
+
+	for _, selector := range block.Markers {
+		if doc.Find(selector).Length() > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeTitle lower-cases a page title and collapses its whitespace
+func normalizeTitle(title string) string {
+	return strings.ToLower(strings.Join(strings.Fields(title), " "))
+}
@@ -0,0 +1,37 @@
+package goodreads
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestIsBlockPage(t *testing.T) {
+	tests := []struct {
+		name string
+		html string
+		want bool
+	}{
+		{"robot check", `<title>Robot Check</title>`, true},
+		{"cloudflare interstitial", `<title>Attention Required! | Cloudflare</title>`, true},
+		{"challenge title with whitespace", "<title>\n  Just a moment...\n</title>", true},
+		{"challenge marker", `<title>Goodreads</title><form id="challenge-form"></form>`, true},
+		{"book titled like a block page", `<title>Access Denied (Firewall, #2) by Jane Doe | Goodreads</title>`, false},
+		{"series titled like a block page", `<title>Just a Moment Series by Sam Lee</title>`, false},
+		{"real page", `<title>Die Twice by Andrew Grant | Goodreads</title>`, false},
+	}
+
+	block := DefaultSelectors().Block
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader("<html><head>" + tt.html + "</head></html>"))
+			if err != nil {
+				t.Fatalf("parsing page: %v", err)
+			}
+			if got := isBlockPage(doc, block); got != tt.want {
+				t.Errorf("isBlockPage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
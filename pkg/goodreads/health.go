@@ -0,0 +1,72 @@
+package goodreads
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// DefaultSampleSeriesID is the series used for live parser checks when none is given
+const DefaultSampleSeriesID = "45175"
+
+//go:embed fixtures/series.html
+var seriesFixture []byte
+
+// ParserHealth reports whether the series parser could extract books from a page
+type ParserHealth struct {
+	Source     string `json:"source"`
+	OK         bool   `json:"ok"`
+	Books      int    `json:"books"`
+	Error      string `json:"error,omitempty"`
+	ErrorKind  string `json:"error_kind,omitempty"`
+	DurationMS int64  `json:"duration_ms"`
+}
+
+// CheckFixture runs the series parser with the default selectors against the embedded fixture page.
+// A failure here means the parser itself is broken, not Goodreads.
+func CheckFixture() ParserHealth {
+	start := time.Now()
+	health := ParserHealth{Source: "fixture"}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(seriesFixture))
+	if err == nil {
+		var books []BookWithPosition
+		books, err = parseSeriesPage(doc, "fixture", DefaultSelectors().Series)
+		health.Books = len(books)
+	}
+
+	return health.finish(err, start)
+}
+
+// CheckLive fetches a real series page and reports whether it could be parsed
+func (c *Client) CheckLive(seriesID string) ParserHealth {
+	if seriesID == "" {
+		seriesID = DefaultSampleSeriesID
+	}
+	start := time.Now()
+	health := ParserHealth{Source: fmt.Sprintf("%s/series/%s", c.baseURL, seriesID)}
+
+	books, err := c.GetSeriesBooks(seriesID)
+	health.Books = len(books)
+
+	return health.finish(err, start)
+}
+
+func (h ParserHealth) finish(err error, start time.Time) ParserHealth {
+	h.DurationMS = time.Since(start).Milliseconds()
+	if err != nil {
+		h.Error = err.Error()
+		h.ErrorKind = ErrorKind(err)
+		return h
+	}
+	// A series page that parses but yields no books is as suspicious as a parse failure
+	h.OK = h.Books > 0
+	if !h.OK {
+		h.ErrorKind = ErrorKindLayout
+		h.Error = "no books parsed from page"
+	}
+	return h
+}
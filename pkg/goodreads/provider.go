@@ -0,0 +1,118 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/identifiers"
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+)
+
+// ProviderName identifies Goodreads in provider configuration and book provenance
+const ProviderName = "goodreads"
+
+// Provider adapts the scraper to the metadata.Provider interface
+type Provider struct {
+	client *Client
+}
+
+var _ metadata.Provider = (*Provider)(nil)
+
+// NewProvider wraps a client as a metadata provider
+func NewProvider(client *Client) *Provider {
+	return &Provider{client: client}
+}
+
+// Name returns "goodreads"
+func (p *Provider) Name() string {
+	return ProviderName
+}
+
+// SeriesBooks scrapes the series page; it needs the Goodreads series ID
+func (p *Provider) SeriesBooks(ctx context.Context, series metadata.SeriesQuery) ([]metadata.Book, error) {
+	if series.GoodreadsID == "" {
+		return nil, fmt.Errorf("%w: series has no Goodreads ID", metadata.ErrUnsupported)
+	}
+
+	booksWithPosition, err := p.client.GetSeriesBooksContext(ctx, series.GoodreadsID)
+	if err != nil {
+		return nil, err
+	}
+
+	books := make([]metadata.Book, len(booksWithPosition))
+	for i, bp := range booksWithPosition {
+		books[i] = toMetadataBook(bp.Book)
+		books[i].SeriesName = series.Name
+		books[i].SeriesPosition = bp.SeriesPosition
+	}
+	return books, nil
+}
+
+// Book looks a book up by ISBN through the search endpoint
+func (p *Provider) Book(ctx context.Context, ids metadata.Identifiers) (*metadata.Book, error) {
+	query := ids.ISBN13
+	if query == "" {
+		query = ids.ISBN10
+	}
+	if query == "" {
+		return nil, fmt.Errorf("%w: Goodreads book lookup needs an ISBN", metadata.ErrUnsupported)
+	}
+
+	results, err := p.client.AutoComplete(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	book := toMetadataBook(results[0])
+	if ids.ISBN13 != "" {
+		book.ISBN13 = ids.ISBN13
+	} else {
+		book.ISBN10 = ids.ISBN10
+	}
+	return &book, nil
+}
+
+// Search uses the Goodreads search box endpoint
+func (p *Provider) Search(ctx context.Context, query string) ([]metadata.Book, error) {
+	results, err := p.client.AutoComplete(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	books := make([]metadata.Book, len(results))
+	for i, result := range results {
+		books[i] = toMetadataBook(result)
+	}
+	return books, nil
+}
+
+// toMetadataBook converts a Goodreads book object into the provider-neutral form
+func toMetadataBook(b SeriesBookk) metadata.Book {
+	description := b.Description.HTML
+	if description == "" {
+		description = b.Description.TruncatedHTML
+	}
+
+	book := metadata.Book{
+		Identifiers:     metadata.Identifiers{GoodreadsID: identifiers.GoodreadsID(b.BookID), GoodreadsWorkID: identifiers.GoodreadsID(b.WorkID)},
+		Title:           b.Title,
+		Description:     description,
+		PublicationDate: NormalizePublicationDate(b.PublicationDate),
+		ToBePublished:   b.ToBePublished,
+		ImageURL:        b.ImageURL,
+		URL:             b.BookURL,
+		Sources:         []string{ProviderName},
+	}
+	if b.Author.Name != "" {
+		author := metadata.Author{Name: b.Author.Name}
+		if b.Author.ID != 0 {
+			author.GoodreadsID = strconv.Itoa(b.Author.ID)
+		}
+		book.Authors = []metadata.Author{author}
+	}
+	return book
+}
@@ -0,0 +1,64 @@
+package goodreads
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+)
+
+func TestProviderSeriesBooks(t *testing.T) {
+	provider := NewProvider(newTestClient(t, http.StatusOK, string(seriesFixture)))
+
+	books, err := provider.SeriesBooks(context.Background(), metadata.SeriesQuery{GoodreadsID: "45175", Name: "David Trevellyan"})
+	if err != nil {
+		t.Fatalf("SeriesBooks() error = %v", err)
+	}
+	if len(books) != 2 {
+		t.Fatalf("expected 2 books, got %d", len(books))
+	}
+
+	first := books[0]
+	if first.GoodreadsID != "7315139" || first.SeriesPosition != "2" || first.SeriesName != "David Trevellyan" {
+		t.Errorf("unexpected book: %+v", first)
+	}
+	if len(first.Authors) != 1 || first.Authors[0].GoodreadsID != "2922340" {
+		t.Errorf("unexpected authors: %+v", first.Authors)
+	}
+	if len(first.Sources) != 1 || first.Sources[0] != ProviderName {
+		t.Errorf("unexpected sources: %v", first.Sources)
+	}
+
+	_, err = provider.SeriesBooks(context.Background(), metadata.SeriesQuery{Name: "Unmapped"})
+	if !errors.Is(err, metadata.ErrUnsupported) {
+		t.Errorf("expected ErrUnsupported without a Goodreads ID, got %v", err)
+	}
+}
+
+func TestProviderSearch(t *testing.T) {
+	body := `[{"bookId":"7315139","workId":"7573428","title":"Die Twice (David Trevellyan, #2)","bookUrl":"/book/show/7315139","author":{"id":2922340,"name":"Andrew Grant"},"description":{"html":"A thriller","truncated":false}}]`
+	provider := NewProvider(newTestClient(t, http.StatusOK, body))
+
+	books, err := provider.Search(context.Background(), "die twice")
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(books) != 1 || books[0].GoodreadsID != "7315139" || books[0].Description != "A thriller" {
+		t.Errorf("unexpected results: %+v", books)
+	}
+
+	book, err := provider.Book(context.Background(), metadata.Identifiers{ISBN13: "9780000000001"})
+	if err != nil {
+		t.Fatalf("Book() error = %v", err)
+	}
+	if book.GoodreadsID != "7315139" || book.GoodreadsWorkID != "7573428" || book.ISBN13 != "9780000000001" {
+		t.Errorf("unexpected book: %+v", book)
+	}
+
+	blocked := NewProvider(newTestClient(t, http.StatusOK, `<html><head><title>Robot Check</title></head></html>`))
+	if _, err := blocked.Search(context.Background(), "x"); !errors.Is(err, ErrBlocked) {
+		t.Errorf("expected ErrBlocked, got %v", err)
+	}
+}
@@ -0,0 +1,119 @@
+package goodreads
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed selectors.yaml
+var defaultSelectorsYAML []byte
+
+// Selectors holds the CSS selectors and JSON paths used to scrape Goodreads pages
+type Selectors struct {
+	Series       SeriesSelectors       `yaml:"series" json:"series"`
+	AuthorSeries AuthorSeriesSelectors `yaml:"author_series" json:"author_series"`
+	Book         BookSelectors         `yaml:"book" json:"book"`
+	Block        BlockSelectors        `yaml:"block" json:"block"`
+}
+
+// SeriesSelectors locates the series list on a series page
+type SeriesSelectors struct {
+	List        string   `yaml:"list" json:"list"`
+	PropsAttr   string   `yaml:"props_attr" json:"props_attr"`
+	EntriesPath []string `yaml:"entries_path" json:"entries_path"`
+	HeadersPath []string `yaml:"headers_path" json:"headers_path"`
+	BookPath    []string `yaml:"book_path" json:"book_path"`
+}
+
+// AuthorSeriesSelectors locates the series on an author's series list page
+type AuthorSeriesSelectors struct {
+	Container string `yaml:"container" json:"container"`
+	Item      string `yaml:"item" json:"item"`
+	Link      string `yaml:"link" json:"link"`
+	Works     string `yaml:"works" json:"works"`
+	NextPage  string `yaml:"next_page" json:"next_page"`
+}
+
+// BookSelectors locates the work ID on a book page.
+// The patterns are regular expressions whose first group is the work ID.
+type BookSelectors struct {
+	WorkLink        string `yaml:"work_link" json:"work_link"`
+	WorkLinkPattern string `yaml:"work_link_pattern" json:"work_link_pattern"`
+	NextData        string `yaml:"next_data" json:"next_data"`
+	NextDataPattern string `yaml:"next_data_pattern" json:"next_data_pattern"`
+}
+
+// BlockSelectors identifies anti-bot interstitials
+type BlockSelectors struct {
+	Titles  []string `yaml:"titles" json:"titles"`
+	Markers []string `yaml:"markers" json:"markers"`
+}
+
+// DefaultSelectors returns the selector profile embedded in the binary
+func DefaultSelectors() *Selectors {
+	var sel Selectors
+	if err := yaml.Unmarshal(defaultSelectorsYAML, &sel); err != nil {
+		// The embedded profile is part of the build, so this is a programming error
+		panic(fmt.Sprintf("goodreads: invalid embedded selectors: %v", err))
+	}
+	return &sel
+}
+
+// LoadSelectors reads a YAML or JSON override file and applies it on top of the default profile
+func LoadSelectors(path string) (*Selectors, error) {
+	// #nosec G304 -- the path comes from operator configuration
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading selectors file %s: %w", path, err)
+	}
+
+	sel := DefaultSelectors()
+	// JSON is valid YAML, so one decoder handles both formats
+	if err := yaml.Unmarshal(data, sel); err != nil {
+		return nil, fmt.Errorf("parsing selectors file %s: %w", path, err)
+	}
+
+	if err := sel.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid selectors file %s: %w", path, err)
+	}
+	return sel, nil
+}
+
+// Validate checks that every selector the scraper needs is set
+func (s *Selectors) Validate() error {
+	switch {
+	case s.Series.List == "":
+		return fmt.Errorf("series.list is required")
+	case s.Series.PropsAttr == "":
+		return fmt.Errorf("series.props_attr is required")
+	case len(s.Series.EntriesPath) == 0:
+		return fmt.Errorf("series.entries_path is required")
+	case len(s.Series.BookPath) == 0:
+		return fmt.Errorf("series.book_path is required")
+	case s.AuthorSeries.Container == "":
+		return fmt.Errorf("author_series.container is required")
+	case s.AuthorSeries.Item == "":
+		return fmt.Errorf("author_series.item is required")
+	case s.AuthorSeries.Link == "":
+		return fmt.Errorf("author_series.link is required")
+	case s.Book.WorkLink == "" && s.Book.NextData == "":
+		return fmt.Errorf("book.work_link or book.next_data is required")
+	}
+
+	for name, pattern := range map[string]string{
+		"book.work_link_pattern": s.Book.WorkLinkPattern,
+		"book.next_data_pattern": s.Book.NextDataPattern,
+	} {
+		if pattern == "" {
+			continue
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("%s is not a valid regular expression: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package goodreads
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadSelectors(t *testing.T) {
+	defaults := DefaultSelectors()
+
+	tests := []struct {
+		name     string
+		filename string
+		content  string
+		wantErr  bool
+		check    func(t *testing.T, sel *Selectors)
+	}{
+		{
+			name:     "yaml override keeps unspecified defaults",
+			filename: "selectors.yaml",
+			content:  "series:\n  list: \"div.newSeriesList\"\n",
+			check: func(t *testing.T, sel *Selectors) {
+				if sel.Series.List != "div.newSeriesList" {
+					t.Errorf("Series.List = %q, want override", sel.Series.List)
+				}
+				if sel.Series.PropsAttr != defaults.Series.PropsAttr {
+					t.Errorf("Series.PropsAttr = %q, want default %q", sel.Series.PropsAttr, defaults.Series.PropsAttr)
+				}
+				if !reflect.DeepEqual(sel.Block, defaults.Block) {
+					t.Errorf("Block = %+v, want defaults", sel.Block)
+				}
+			},
+		},
+		{
+			name:     "json override replaces paths",
+			filename: "selectors.json",
+			content:  `{"series": {"entries_path": ["props", "items"], "book_path": ["work"]}}`,
+			check: func(t *testing.T, sel *Selectors) {
+				if !reflect.DeepEqual(sel.Series.EntriesPath, []string{"props", "items"}) {
+					t.Errorf("Series.EntriesPath = %v", sel.Series.EntriesPath)
+				}
+				if !reflect.DeepEqual(sel.Series.BookPath, []string{"work"}) {
+					t.Errorf("Series.BookPath = %v", sel.Series.BookPath)
+				}
+			},
+		},
+		{
+			name:     "clearing a required selector is rejected",
+			filename: "selectors.yaml",
+			content:  "series:\n  list: \"\"\n",
+			wantErr:  true,
+		},
+		{
+			name:     "malformed file",
+			filename: "selectors.yaml",
+			content:  "series: [",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.filename)
+			if err := os.WriteFile(path, []byte(tt.content), 0600); err != nil {
+				t.Fatalf("Failed to write selectors file: %v", err)
+			}
+
+			sel, err := LoadSelectors(path)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("LoadSelectors() expected error, got %+v", sel)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadSelectors() error = %v", err)
+			}
+			tt.check(t, sel)
+		})
+	}
+}
+
+func TestParseSeriesDataCustomPaths(t *testing.T) {
+	sel := SeriesSelectors{
+		EntriesPath: []string{"props", "items"},
+		HeadersPath: []string{"props", "positions"},
+		BookPath:    []string{"work"},
+	}
+	data := []byte(`{"props": {"items": [{"work": {"bookId": "1", "title": "One"}}, {"work": {"bookId": "2", "title": "Two"}}], "positions": ["1", "2.5"]}}`)
+
+	books, err := parseSeriesData(data, sel)
+	if err != nil {
+		t.Fatalf("parseSeriesData() error = %v", err)
+	}
+	if len(books) != 2 {
+		t.Fatalf("parseSeriesData() returned %d books, want 2", len(books))
+	}
+	if books[1].Book.Title != "Two" || books[1].SeriesPosition != "2.5" || books[1].Index != 1 {
+		t.Errorf("second book = %+v", books[1])
+	}
+
+	if _, err := parseSeriesData(data, DefaultSelectors().Series); err == nil {
+		t.Error("parseSeriesData() with default paths on custom layout expected error")
+	}
+}
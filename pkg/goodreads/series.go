@@ -1,6 +1,7 @@
 package goodreads
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 
@@ -9,43 +10,54 @@ import (
 
 // GetSeriesBooks fetches and parses a Goodreads series page, returning all books
 func (c *Client) GetSeriesBooks(seriesID string) ([]BookWithPosition, error) {
+	return c.GetSeriesBooksContext(context.Background(), seriesID)
+}
+
+// GetSeriesBooksContext is GetSeriesBooks with a caller-supplied context
+func (c *Client) GetSeriesBooksContext(ctx context.Context, seriesID string) ([]BookWithPosition, error) {
 	url := fmt.Sprintf("%s/series/%s", c.baseURL, seriesID)
 	slog.Info("Fetching series from URL", slog.String("url", url))
 
-	resp, err := c.httpClient.Get(url)
+	doc, err := c.fetchDocument(ctx, url)
 	if err != nil {
-		return nil, fmt.Errorf("fetching series page: %w", err)
+		return nil, err
 	}
-	defer func() {
-		if err := resp.Body.Close(); err != nil {
-			slog.Error("Failed to close response body", slog.Any("error", err))
-		}
-	}()
 
-	doc, err := goquery.NewDocumentFromReader(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("parsing series HTML: %w", err)
+	return parseSeriesPage(doc, url, c.selectors.Series)
+}
+
+// parseSeriesPage extracts the books from the series list components on a series page.
+// A page without any series list is reported as a layout change rather than an empty series.
+func parseSeriesPage(doc *goquery.Document, url string, sel SeriesSelectors) ([]BookWithPosition, error) {
+	seriesLists := doc.Find(sel.List)
+	if seriesLists.Length() == 0 {
+		return nil, &MarkerError{URL: url, Marker: sel.List}
 	}
 
 	var booksWithPosition []BookWithPosition
+	var parseErr error
 
 	// Look for the React component with series data
-	doc.Find("div[data-react-class='ReactComponents.SeriesList']").Each(func(i int, s *goquery.Selection) {
-		dataProps, exists := s.Attr("data-react-props")
+	seriesLists.EachWithBreak(func(i int, s *goquery.Selection) bool {
+		dataProps, exists := s.Attr(sel.PropsAttr)
 		if !exists {
-			slog.Warn("No data-react-props found in series list div")
-			return
+			parseErr = &MarkerError{URL: url, Marker: sel.PropsAttr}
+			return false
 		}
 
-		books, err := ParseSeriesData([]byte(dataProps))
+		books, err := parseSeriesData([]byte(dataProps), sel)
 		if err != nil {
-			slog.Error("Error parsing series data", slog.String("error", err.Error()))
-			return
+			parseErr = fmt.Errorf("%w: parsing series data: %v", ErrLayoutChanged, err)
+			return false
 		}
 
 		slog.Info("Parsed books from series", slog.Int("count", len(books)))
 		booksWithPosition = append(booksWithPosition, books...)
+		return true
 	})
+	if parseErr != nil {
+		return nil, parseErr
+	}
 
 	return booksWithPosition, nil
 }
@@ -55,26 +67,16 @@ func (c *Client) GetSeries(seriesID string) (*Series, error) {
 	url := fmt.Sprintf("%s/series/%s", c.baseURL, seriesID)
 	fmt.Println("Fetching series from URL:", url)
 
-	resp, err := c.httpClient.Get(url)
-	if err != nil {
-		return nil, fmt.Errorf("fetching series page: %w", err)
-	}
-	defer func() {
-		if err := resp.Body.Close(); err != nil {
-			slog.Error("Failed to close response body", slog.Any("error", err))
-		}
-	}()
-
-	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	doc, err := c.fetchDocument(context.Background(), url)
 	if err != nil {
-		return nil, fmt.Errorf("parsing series HTML: %w", err)
+		return nil, err
 	}
 
-	doc.Find("div[data-react-class='ReactComponents.SeriesList']").Each(func(i int, s *goquery.Selection) {
+	doc.Find(c.selectors.Series.List).Each(func(i int, s *goquery.Selection) {
 		fmt.Println("Found React series div", i)
-		dataProps, exists := s.Attr("data-react-props")
+		dataProps, exists := s.Attr(c.selectors.Series.PropsAttr)
 		slog.Debug("dataProps", slog.String("dataProps", dataProps), slog.Bool("exists", exists))
-		b, err := ParseSeriesData([]byte(dataProps))
+		b, err := parseSeriesData([]byte(dataProps), c.selectors.Series)
 		if err != nil {
 			slog.Error("Error parsing series data", slog.Int("index", i), slog.String("error", err.Error()))
 			return
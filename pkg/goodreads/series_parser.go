@@ -1,6 +1,12 @@
 package goodreads
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/buger/jsonparser"
+)
 
 // SeriesData represents the JSON structure containing series information
 type SeriesData struct {
@@ -63,23 +69,56 @@ type BookWithPosition struct {
 
 // ParseSeriesData parses the JSON data and returns books with their series positions
 func ParseSeriesData(jsonData []byte) ([]BookWithPosition, error) {
-	var data SeriesData
-	if err := json.Unmarshal(jsonData, &data); err != nil {
-		return nil, err
+	return parseSeriesData(jsonData, DefaultSelectors().Series)
+}
+
+// parseSeriesData walks the series props using the configured JSON paths
+func parseSeriesData(jsonData []byte, sel SeriesSelectors) ([]BookWithPosition, error) {
+	var headers []string
+	_, err := jsonparser.ArrayEach(jsonData, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		header, _ := jsonparser.ParseString(value)
+		headers = append(headers, header)
+	}, sel.HeadersPath...)
+	// Headers are optional; only the entries are required
+	if err != nil && !errors.Is(err, jsonparser.KeyPathNotFoundError) {
+		return nil, fmt.Errorf("reading series headers: %w", err)
 	}
 
-	result := make([]BookWithPosition, 0, len(data.Series))
-	for i, entry := range data.Series {
+	result := []BookWithPosition{}
+	var entryErr error
+	_, err = jsonparser.ArrayEach(jsonData, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		if entryErr != nil {
+			return
+		}
+		bookData, _, _, err := jsonparser.Get(value, sel.BookPath...)
+		if err != nil {
+			entryErr = fmt.Errorf("reading book at %v: %w", sel.BookPath, err)
+			return
+		}
+
+		var book SeriesBookk
+		if err := json.Unmarshal(bookData, &book); err != nil {
+			entryErr = fmt.Errorf("decoding book: %w", err)
+			return
+		}
+
+		i := len(result)
 		position := ""
-		if i < len(data.SeriesHeaders) {
-			position = data.SeriesHeaders[i]
+		if i < len(headers) {
+			position = headers[i]
 		}
 
 		result = append(result, BookWithPosition{
-			Book:           entry.Book,
+			Book:           book,
 			SeriesPosition: position,
 			Index:          i,
 		})
+	}, sel.EntriesPath...)
+	if err != nil {
+		return nil, fmt.Errorf("reading series entries at %v: %w", sel.EntriesPath, err)
+	}
+	if entryErr != nil {
+		return nil, entryErr
 	}
 
 	return result, nil
@@ -0,0 +1,121 @@
+package goodreads
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestClient(t *testing.T, status int, body string) *Client {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	client := NewClient()
+	client.baseURL = srv.URL
+	return client
+}
+
+func TestGetSeriesBooks(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		wantBooks int
+		wantErr   error
+		wantKind  string
+	}{
+		{
+			name:      "fixture page",
+			status:    http.StatusOK,
+			body:      string(seriesFixture),
+			wantBooks: 2,
+		},
+		{
+			name:     "captcha page",
+			status:   http.StatusOK,
+			body:     `<html><head><title>Robot Check</title></head><body><form action="/errors/validateCaptcha"></form></body></html>`,
+			wantErr:  ErrBlocked,
+			wantKind: ErrorKindBlocked,
+		},
+		{
+			name:     "challenge page with 503",
+			status:   http.StatusServiceUnavailable,
+			body:     `<html><head><title>Just a moment...</title></head><body><form id="challenge-form"></form></body></html>`,
+			wantErr:  ErrBlocked,
+			wantKind: ErrorKindBlocked,
+		},
+		{
+			name:     "not found",
+			status:   http.StatusNotFound,
+			body:     `<html><head><title>Page not found</title></head><body></body></html>`,
+			wantErr:  ErrNotFound,
+			wantKind: ErrorKindStatus,
+		},
+		{
+			name:     "server error",
+			status:   http.StatusInternalServerError,
+			body:     `<html><body>oops</body></html>`,
+			wantKind: ErrorKindStatus,
+		},
+		{
+			name:     "missing series list",
+			status:   http.StatusOK,
+			body:     `<html><head><title>Series</title></head><body><div class="somethingNew"></div></body></html>`,
+			wantErr:  ErrLayoutChanged,
+			wantKind: ErrorKindLayout,
+		},
+		{
+			name:     "series list without props",
+			status:   http.StatusOK,
+			body:     `<html><body><div data-react-class="ReactComponents.SeriesList"></div></body></html>`,
+			wantErr:  ErrLayoutChanged,
+			wantKind: ErrorKindLayout,
+		},
+		{
+			name:     "series list with invalid props",
+			status:   http.StatusOK,
+			body:     `<html><body><div data-react-class="ReactComponents.SeriesList" data-react-props="not json"></div></body></html>`,
+			wantErr:  ErrLayoutChanged,
+			wantKind: ErrorKindLayout,
+		},
+		{
+			name:   "empty series",
+			status: http.StatusOK,
+			body:   `<html><body><div data-react-class="ReactComponents.SeriesList" data-react-props="{&quot;series&quot;:[],&quot;seriesHeaders&quot;:[]}"></div></body></html>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, tt.status, tt.body)
+
+			books, err := client.GetSeriesBooks("123")
+
+			if kind := ErrorKind(err); kind != tt.wantKind {
+				t.Fatalf("ErrorKind() = %q, want %q (err: %v)", kind, tt.wantKind, err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetSeriesBooks() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(books) != tt.wantBooks {
+				t.Errorf("GetSeriesBooks() returned %d books, want %d", len(books), tt.wantBooks)
+			}
+		})
+	}
+}
+
+func TestCheckFixture(t *testing.T) {
+	health := CheckFixture()
+	if !health.OK {
+		t.Fatalf("CheckFixture() not OK: %+v", health)
+	}
+	if health.Books != 2 {
+		t.Errorf("CheckFixture() parsed %d books, want 2", health.Books)
+	}
+}
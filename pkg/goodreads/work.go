@@ -0,0 +1,64 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// GetWorkID resolves the work ID of a book from its book page. Goodreads book IDs are edition
+// specific, while the work ID is shared by every edition of the same book.
+func (c *Client) GetWorkID(ctx context.Context, bookID string) (string, error) {
+	url := fmt.Sprintf("%s/book/show/%s", c.baseURL, bookID)
+	slog.Debug("Fetching book page for work ID", slog.String("url", url))
+
+	doc, err := c.fetchDocument(ctx, url)
+	if err != nil {
+		return "", err
+	}
+
+	return parseWorkID(doc, url, c.selectors.Book)
+}
+
+// parseWorkID finds the work ID in the "all editions" link, falling back to the Next.js page state
+func parseWorkID(doc *goquery.Document, url string, sel BookSelectors) (string, error) {
+	if sel.WorkLink != "" && sel.WorkLinkPattern != "" {
+		pattern, err := regexp.Compile(sel.WorkLinkPattern)
+		if err != nil {
+			return "", fmt.Errorf("compiling book.work_link_pattern: %w", err)
+		}
+
+		var workID string
+		doc.Find(sel.WorkLink).EachWithBreak(func(_ int, s *goquery.Selection) bool {
+			href, _ := s.Attr("href")
+			if m := pattern.FindStringSubmatch(href); len(m) > 1 {
+				workID = m[1]
+				return false
+			}
+			return true
+		})
+		if workID != "" {
+			return workID, nil
+		}
+	}
+
+	if sel.NextData != "" && sel.NextDataPattern != "" {
+		pattern, err := regexp.Compile(sel.NextDataPattern)
+		if err != nil {
+			return "", fmt.Errorf("compiling book.next_data_pattern: %w", err)
+		}
+
+		if m := pattern.FindStringSubmatch(doc.Find(sel.NextData).First().Text()); len(m) > 1 {
+			return m[1], nil
+		}
+	}
+
+	marker := sel.WorkLink
+	if marker == "" {
+		marker = sel.NextData
+	}
+	return "", &MarkerError{URL: url, Marker: marker}
+}
@@ -0,0 +1,69 @@
+package goodreads
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+)
+
+func TestGetWorkID(t *testing.T) {
+	bookPage, err := os.ReadFile("fixtures/book.html")
+	if err != nil {
+		t.Fatalf("reading fixture: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		wantWork string
+		wantErr  error
+	}{
+		{
+			name:     "next.js page state",
+			status:   http.StatusOK,
+			body:     string(bookPage),
+			wantWork: "7573428",
+		},
+		{
+			name:     "editions link",
+			status:   http.StatusOK,
+			body:     `<html><body><a href="/work/editions/7573428-die-twice">All editions</a></body></html>`,
+			wantWork: "7573428",
+		},
+		{
+			name:    "no work reference",
+			status:  http.StatusOK,
+			body:    `<html><body><h1>Die Twice</h1></body></html>`,
+			wantErr: ErrLayoutChanged,
+		},
+		{
+			name:    "not found",
+			status:  http.StatusNotFound,
+			body:    `<html><head><title>Page not found</title></head></html>`,
+			wantErr: ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, tt.status, tt.body)
+
+			workID, err := client.GetWorkID(context.Background(), "7315139")
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetWorkID() error = %v", err)
+			}
+			if workID != tt.wantWork {
+				t.Errorf("GetWorkID() = %q, want %q", workID, tt.wantWork)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package hardcover
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// bookFields is the selection shared by every book query
+const bookFields = `
+fragment BookFields on books {
+  id
+  slug
+  title
+  description
+  release_date
+  contributions { author { name } }
+  book_series { position series { id name } }
+  editions(where: {isbn_13: {_is_null: false}}, limit: 1) { isbn_10 isbn_13 asin }
+}`
+
+const getBookQuery = `query GetBook($id: Int!) {
+  books_by_pk(id: $id) { ...BookFields }
+}` + bookFields
+
+const getBookBySlugQuery = `query GetBookBySlug($slug: String!) {
+  books(where: {slug: {_eq: $slug}}, limit: 1) { ...BookFields }
+}` + bookFields
+
+const getBookByISBNQuery = `query GetBookByISBN($isbn: String!) {
+  editions(where: {_or: [{isbn_13: {_eq: $isbn}}, {isbn_10: {_eq: $isbn}}]}, limit: 1) { book { ...BookFields } }
+}` + bookFields
+
+const searchBooksQuery = `query SearchBooks($query: String!) {
+  search(query: $query, query_type: "Book", per_page: 10) { results }
+}`
+
+// Book is a Hardcover book with its series memberships
+type Book struct {
+	ID            int64          `json:"id"`
+	Slug          string         `json:"slug"`
+	Title         string         `json:"title"`
+	Description   string         `json:"description"`
+	ReleaseDate   string         `json:"release_date"`
+	Contributions []Contribution `json:"contributions"`
+	BookSeries    []BookSeries   `json:"book_series"`
+	Editions      []Edition      `json:"editions"`
+}
+
+// Contribution links a book to one of its authors
+type Contribution struct {
+	Author struct {
+		Name string `json:"name"`
+	} `json:"author"`
+}
+
+// BookSeries is a book's position in one series
+type BookSeries struct {
+	Position *float64  `json:"position"`
+	Series   SeriesRef `json:"series"`
+}
+
+// Edition carries the identifiers of one edition of a book
+type Edition struct {
+	ISBN10 string `json:"isbn_10"`
+	ISBN13 string `json:"isbn_13"`
+	ASIN   string `json:"asin"`
+}
+
+// AuthorNames returns the names of the book's authors
+func (b Book) AuthorNames() []string {
+	names := make([]string, 0, len(b.Contributions))
+	for _, c := range b.Contributions {
+		if c.Author.Name != "" {
+			names = append(names, c.Author.Name)
+		}
+	}
+	return names
+}
+
+// GetBook fetches a book by its numeric Hardcover ID
+func (c *Client) GetBook(ctx context.Context, id int64) (*Book, error) {
+	var data struct {
+		Book *Book `json:"books_by_pk"`
+	}
+	if err := c.query(ctx, getBookQuery, map[string]any{"id": id}, &data); err != nil {
+		return nil, err
+	}
+	if data.Book == nil {
+		return nil, fmt.Errorf("%w: book %d", ErrNotFound, id)
+	}
+	return data.Book, nil
+}
+
+// GetBookBySlug fetches a book by its Hardcover slug, which is what Booklore stores as hardcoverId
+func (c *Client) GetBookBySlug(ctx context.Context, slug string) (*Book, error) {
+	var data struct {
+		Books []Book `json:"books"`
+	}
+	if err := c.query(ctx, getBookBySlugQuery, map[string]any{"slug": slug}, &data); err != nil {
+		return nil, err
+	}
+	if len(data.Books) == 0 {
+		return nil, fmt.Errorf("%w: book %q", ErrNotFound, slug)
+	}
+	return &data.Books[0], nil
+}
+
+// GetBookByISBN fetches the book that has an edition with the given ISBN-10 or ISBN-13
+func (c *Client) GetBookByISBN(ctx context.Context, isbn string) (*Book, error) {
+	var data struct {
+		Editions []struct {
+			Book Book `json:"book"`
+		} `json:"editions"`
+	}
+	if err := c.query(ctx, getBookByISBNQuery, map[string]any{"isbn": isbn}, &data); err != nil {
+		return nil, err
+	}
+	if len(data.Editions) == 0 {
+		return nil, fmt.Errorf("%w: ISBN %s", ErrNotFound, isbn)
+	}
+	return &data.Editions[0].Book, nil
+}
+
+// SearchResult is a book hit from Hardcover's search index
+type SearchResult struct {
+	ID          int64    `json:"id"`
+	Slug        string   `json:"slug"`
+	Title       string   `json:"title"`
+	ReleaseDate string   `json:"release_date"`
+	AuthorNames []string `json:"author_names"`
+	ISBNs       []string `json:"isbns"`
+	SeriesNames []string `json:"series_names"`
+}
+
+// Search runs a free text book search
+func (c *Client) Search(ctx context.Context, query string) ([]SearchResult, error) {
+	var data struct {
+		Search struct {
+			Results struct {
+				Hits []struct {
+					Document searchDocument `json:"document"`
+				} `json:"hits"`
+			} `json:"results"`
+		} `json:"search"`
+	}
+	if err := c.query(ctx, searchBooksQuery, map[string]any{"query": query}, &data); err != nil {
+		return nil, err
+	}
+
+	results := make([]SearchResult, 0, len(data.Search.Results.Hits))
+	for _, hit := range data.Search.Results.Hits {
+		results = append(results, hit.Document.result())
+	}
+	return results, nil
+}
+
+// searchDocument is a search hit as stored in the index, where IDs are strings
+type searchDocument struct {
+	ID          string   `json:"id"`
+	Slug        string   `json:"slug"`
+	Title       string   `json:"title"`
+	ReleaseDate string   `json:"release_date"`
+	AuthorNames []string `json:"author_names"`
+	ISBNs       []string `json:"isbns"`
+	SeriesNames []string `json:"series_names"`
+}
+
+func (d searchDocument) result() SearchResult {
+	id, _ := strconv.ParseInt(d.ID, 10, 64)
+	return SearchResult{
+		ID:          id,
+		Slug:        d.Slug,
+		Title:       d.Title,
+		ReleaseDate: d.ReleaseDate,
+		AuthorNames: d.AuthorNames,
+		ISBNs:       d.ISBNs,
+		SeriesNames: d.SeriesNames,
+	}
+}
@@ -0,0 +1,49 @@
+// Package hardcover is a client for the Hardcover GraphQL API (https://hardcover.app).
+package hardcover
+
+import (
+	"net/http"
+	"time"
+)
+
+// DefaultEndpoint is Hardcover's public GraphQL endpoint
+const DefaultEndpoint = "https://api.hardcover.app/v1/graphql"
+
+// Client talks to the Hardcover GraphQL API
+type Client struct {
+	endpoint   string
+	token      string
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// ClientOption configures a Client
+type ClientOption func(*Client)
+
+// WithEndpoint points the client at a different GraphQL endpoint
+func WithEndpoint(endpoint string) ClientOption {
+	return func(c *Client) {
+		c.endpoint = endpoint
+	}
+}
+
+// WithHTTPClient replaces the default HTTP client
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// NewClient creates a Hardcover client authenticated with an API token
+func NewClient(token string, opts ...ClientOption) *Client {
+	c := &Client{
+		endpoint:   DefaultEndpoint,
+		token:      token,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		now:        time.Now,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
@@ -0,0 +1,99 @@
+package hardcover
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrUnauthorized is returned when Hardcover rejects the API token
+	ErrUnauthorized = errors.New("hardcover: unauthorized, check the API token")
+	// ErrNotFound is returned when the requested book or series doesn't exist
+	ErrNotFound = errors.New("hardcover: not found")
+)
+
+// GraphQLError is returned when the API answers with query errors
+type GraphQLError struct {
+	Messages []string
+}
+
+func (e *GraphQLError) Error() string {
+	return "hardcover: " + strings.Join(e.Messages, "; ")
+}
+
+type graphqlRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+type graphqlResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// query runs a GraphQL query and decodes its data into out
+func (c *Client) query(ctx context.Context, query string, variables map[string]any, out any) error {
+	payload, err := json.Marshal(graphqlRequest{Query: query, Variables: variables})
+	if err != nil {
+		return fmt.Errorf("encoding query: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+strings.TrimPrefix(c.token, "Bearer "))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("querying hardcover: %w", err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			slog.Error("Failed to close response body", slog.Any("error", err))
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading response: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return ErrUnauthorized
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("hardcover returned status %d", resp.StatusCode)
+	}
+
+	var result graphqlResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	if len(result.Errors) > 0 {
+		gqlErr := &GraphQLError{}
+		for _, e := range result.Errors {
+			gqlErr.Messages = append(gqlErr.Messages, e.Message)
+		}
+		// Hasura reports a bad token as a query error rather than a 401
+		if strings.Contains(strings.ToLower(strings.Join(gqlErr.Messages, " ")), "jwt") {
+			return fmt.Errorf("%w: %v", ErrUnauthorized, gqlErr)
+		}
+		return gqlErr
+	}
+
+	if err := json.Unmarshal(result.Data, out); err != nil {
+		return fmt.Errorf("decoding data: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package hardcover
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+)
+
+// operationFixtures maps GraphQL operation names to recorded responses
+var operationFixtures = map[string]string{
+	"GetBook":       "book.json",
+	"GetBookBySlug": "",
+	"GetBookByISBN": "",
+	"GetSeries":     "series.json",
+	"FindSeries":    "find_series.json",
+	"SearchBooks":   "search.json",
+}
+
+var operationPattern = regexp.MustCompile(`^\s*query\s+(\w+)`)
+
+// newStubServer serves recorded responses for the GraphQL operations the client sends
+func newStubServer(t *testing.T, token string) *Client {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"errors":[{"message":"Could not verify JWT: JWSError JWSInvalidSignature"}]}`))
+			return
+		}
+
+		var req graphqlRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		match := operationPattern.FindStringSubmatch(req.Query)
+		if match == nil {
+			http.Error(w, "no operation name", http.StatusBadRequest)
+			return
+		}
+
+		fixture, ok := operationFixtures[match[1]]
+		if !ok {
+			_, _ = w.Write([]byte(`{"errors":[{"message":"field not found in type: 'query_root'"}]}`))
+			return
+		}
+		if fixture == "" {
+			_, _ = w.Write([]byte(`{"data":{"books":[],"editions":[]}}`))
+			return
+		}
+
+		body, err := os.ReadFile(filepath.Join("fixtures", fixture))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+
+	client := NewClient(token, WithEndpoint(srv.URL))
+	client.now = func() time.Time { return time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC) }
+	return client
+}
+
+func TestGetBook(t *testing.T) {
+	client := newStubServer(t, "secret")
+
+	book, err := client.GetBook(context.Background(), 427116)
+	if err != nil {
+		t.Fatalf("GetBook() error = %v", err)
+	}
+	if book.Title != "Die Twice" || book.Slug != "die-twice" {
+		t.Errorf("unexpected book: %+v", book)
+	}
+	if len(book.BookSeries) != 1 || book.BookSeries[0].Series.ID != 9876 || *book.BookSeries[0].Position != 2 {
+		t.Errorf("unexpected series membership: %+v", book.BookSeries)
+	}
+
+	_, err = client.GetBookBySlug(context.Background(), "missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestUnauthorized(t *testing.T) {
+	client := newStubServer(t, "secret")
+	client.token = "wrong"
+
+	_, err := client.GetBook(context.Background(), 427116)
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("expected ErrUnauthorized, got %v", err)
+	}
+}
+
+func TestProviderSeriesBooks(t *testing.T) {
+	tests := []struct {
+		name  string
+		query metadata.SeriesQuery
+	}{
+		{
+			name:  "through an owned book",
+			query: metadata.SeriesQuery{Name: "David Trevellyan", HardcoverBookIDs: []int64{427116}},
+		},
+		{
+			name:  "by series name",
+			query: metadata.SeriesQuery{Name: "David Trevellyan"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := NewProvider(newStubServer(t, "secret"))
+
+			books, err := provider.SeriesBooks(context.Background(), tt.query)
+			if err != nil {
+				t.Fatalf("SeriesBooks() error = %v", err)
+			}
+			if len(books) != 3 {
+				t.Fatalf("expected 3 books after dropping duplicates, got %d", len(books))
+			}
+
+			first := books[0]
+			if first.HardcoverBookID != 427115 || first.SeriesPosition != "1" || first.ISBN13 != "9780312383046" {
+				t.Errorf("unexpected first book: %+v", first)
+			}
+			if first.SeriesName != "David Trevellyan" || first.Sources[0] != ProviderName {
+				t.Errorf("unexpected series or provenance: %+v", first)
+			}
+
+			last := books[2]
+			if last.SeriesPosition != "3.5" || !last.ToBePublished || last.PublicationDate != "2027-03-02" {
+				t.Errorf("unexpected upcoming book: %+v", last)
+			}
+		})
+	}
+
+	provider := NewProvider(newStubServer(t, "secret"))
+	if _, err := provider.SeriesBooks(context.Background(), metadata.SeriesQuery{GoodreadsID: "45175"}); !errors.Is(err, metadata.ErrUnsupported) {
+		t.Errorf("expected ErrUnsupported, got %v", err)
+	}
+}
+
+func TestProviderSearch(t *testing.T) {
+	provider := NewProvider(newStubServer(t, "secret"))
+
+	books, err := provider.Search(context.Background(), "die twice")
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(books) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(books))
+	}
+	book := books[0]
+	if book.HardcoverBookID != 427116 || book.ISBN13 != "9780312383053" || book.ISBN10 != "0312383053" {
+		t.Errorf("unexpected result: %+v", book)
+	}
+
+	found, err := provider.Book(context.Background(), metadata.Identifiers{HardcoverID: "missing"})
+	if err != nil || found != nil {
+		t.Errorf("expected no book and no error for an unknown slug, got %+v, %v", found, err)
+	}
+}
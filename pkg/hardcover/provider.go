@@ -0,0 +1,181 @@
+package hardcover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+)
+
+// ProviderName identifies Hardcover in provider configuration and book provenance
+const ProviderName = "hardcover"
+
+// Provider adapts the client to the metadata.Provider interface
+type Provider struct {
+	client *Client
+}
+
+var _ metadata.Provider = (*Provider)(nil)
+
+// NewProvider wraps a client as a metadata provider
+func NewProvider(client *Client) *Provider {
+	return &Provider{client: client}
+}
+
+// Name returns "hardcover"
+func (p *Provider) Name() string {
+	return ProviderName
+}
+
+// SeriesBooks finds the series through a book we already own, falling back to an exact name match
+func (p *Provider) SeriesBooks(ctx context.Context, series metadata.SeriesQuery) ([]metadata.Book, error) {
+	if len(series.HardcoverBookIDs) == 0 && series.Name == "" {
+		return nil, fmt.Errorf("%w: series has no Hardcover book IDs or name", metadata.ErrUnsupported)
+	}
+
+	ref, err := p.findSeries(ctx, series)
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := p.client.GetSeries(ctx, ref.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	books := make([]metadata.Book, 0, len(found.Entries))
+	for _, entry := range found.Entries {
+		book := p.toMetadataBook(entry.Book)
+		book.SeriesName = found.Name
+		if entry.Position != nil {
+			book.SeriesPosition = strconv.FormatFloat(*entry.Position, 'f', -1, 64)
+		}
+		books = append(books, book)
+	}
+	return books, nil
+}
+
+// findSeries resolves a series query to a Hardcover series
+func (p *Provider) findSeries(ctx context.Context, series metadata.SeriesQuery) (*SeriesRef, error) {
+	for _, bookID := range series.HardcoverBookIDs {
+		ref, err := p.client.SeriesForBook(ctx, bookID, series.Name)
+		if err == nil {
+			return ref, nil
+		}
+		if !errors.Is(err, ErrNotFound) {
+			return nil, err
+		}
+	}
+
+	if series.Name != "" {
+		refs, err := p.client.FindSeries(ctx, series.Name)
+		if err != nil {
+			return nil, err
+		}
+		if len(refs) > 0 {
+			return &refs[0], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: series %q", ErrNotFound, series.Name)
+}
+
+// Book looks a book up by Hardcover ID, slug or ISBN
+func (p *Provider) Book(ctx context.Context, ids metadata.Identifiers) (*metadata.Book, error) {
+	var (
+		book *Book
+		err  error
+	)
+	switch {
+	case ids.HardcoverBookID != 0:
+		book, err = p.client.GetBook(ctx, ids.HardcoverBookID)
+	case ids.HardcoverID != "":
+		book, err = p.client.GetBookBySlug(ctx, ids.HardcoverID)
+	case ids.ISBN13 != "":
+		book, err = p.client.GetBookByISBN(ctx, ids.ISBN13)
+	case ids.ISBN10 != "":
+		book, err = p.client.GetBookByISBN(ctx, ids.ISBN10)
+	default:
+		return nil, fmt.Errorf("%w: Hardcover book lookup needs a Hardcover ID or ISBN", metadata.ErrUnsupported)
+	}
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := p.toMetadataBook(*book)
+	if len(book.BookSeries) > 0 {
+		result.SeriesName = book.BookSeries[0].Series.Name
+		if position := book.BookSeries[0].Position; position != nil {
+			result.SeriesPosition = strconv.FormatFloat(*position, 'f', -1, 64)
+		}
+	}
+	return &result, nil
+}
+
+// Search uses Hardcover's search index
+func (p *Provider) Search(ctx context.Context, query string) ([]metadata.Book, error) {
+	results, err := p.client.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	books := make([]metadata.Book, len(results))
+	for i, r := range results {
+		book := metadata.Book{
+			Identifiers:     metadata.Identifiers{HardcoverID: r.Slug, HardcoverBookID: r.ID},
+			Title:           r.Title,
+			PublicationDate: r.ReleaseDate,
+			ToBePublished:   p.unreleased(r.ReleaseDate),
+			Sources:         []string{ProviderName},
+		}
+		for _, name := range r.AuthorNames {
+			book.Authors = append(book.Authors, metadata.Author{Name: name})
+		}
+		for _, isbn := range r.ISBNs {
+			if len(isbn) == 13 && book.ISBN13 == "" {
+				book.ISBN13 = isbn
+			} else if len(isbn) == 10 && book.ISBN10 == "" {
+				book.ISBN10 = isbn
+			}
+		}
+		if len(r.SeriesNames) > 0 {
+			book.SeriesName = r.SeriesNames[0]
+		}
+		books[i] = book
+	}
+	return books, nil
+}
+
+// toMetadataBook converts a Hardcover book into the provider-neutral form
+func (p *Provider) toMetadataBook(b Book) metadata.Book {
+	book := metadata.Book{
+		Identifiers:     metadata.Identifiers{HardcoverID: b.Slug, HardcoverBookID: b.ID},
+		Title:           b.Title,
+		Description:     b.Description,
+		PublicationDate: b.ReleaseDate,
+		ToBePublished:   p.unreleased(b.ReleaseDate),
+		Sources:         []string{ProviderName},
+	}
+	if b.Slug != "" {
+		book.URL = "https://hardcover.app/books/" + b.Slug
+	}
+	if len(b.Editions) > 0 {
+		book.ISBN10 = b.Editions[0].ISBN10
+		book.ISBN13 = b.Editions[0].ISBN13
+		book.ASIN = b.Editions[0].ASIN
+	}
+	for _, name := range b.AuthorNames() {
+		book.Authors = append(book.Authors, metadata.Author{Name: name})
+	}
+	return book
+}
+
+// unreleased reports whether a YYYY-MM-DD release date is in the future
+func (p *Provider) unreleased(releaseDate string) bool {
+	return releaseDate != "" && releaseDate > p.client.now().Format("2006-01-02")
+}
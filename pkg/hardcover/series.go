@@ -0,0 +1,103 @@
+package hardcover
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const getSeriesQuery = `query GetSeries($id: Int!) {
+  series_by_pk(id: $id) {
+    id
+    name
+    book_series(where: {book: {canonical_id: {_is_null: true}}}, order_by: {position: asc}) {
+      position
+      book { ...BookFields }
+    }
+  }
+}` + bookFields
+
+const findSeriesQuery = `query FindSeries($name: String!) {
+  series(where: {name: {_eq: $name}}, limit: 10) { id name books_count }
+}`
+
+// SeriesRef identifies a series
+type SeriesRef struct {
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	BooksCount int    `json:"books_count"`
+}
+
+// Series is a series with its books in order
+type Series struct {
+	ID      int64         `json:"id"`
+	Name    string        `json:"name"`
+	Entries []SeriesEntry `json:"book_series"`
+}
+
+// SeriesEntry is a book at a position in a series
+type SeriesEntry struct {
+	Position *float64 `json:"position"`
+	Book     Book     `json:"book"`
+}
+
+// GetSeries fetches a series and its books ordered by position
+func (c *Client) GetSeries(ctx context.Context, id int64) (*Series, error) {
+	var data struct {
+		Series *Series `json:"series_by_pk"`
+	}
+	if err := c.query(ctx, getSeriesQuery, map[string]any{"id": id}, &data); err != nil {
+		return nil, err
+	}
+	if data.Series == nil {
+		return nil, fmt.Errorf("%w: series %d", ErrNotFound, id)
+	}
+
+	// Hardcover lists every edition-level duplicate; keep the first book at each ID
+	seen := make(map[int64]bool)
+	entries := data.Series.Entries[:0]
+	for _, entry := range data.Series.Entries {
+		if seen[entry.Book.ID] {
+			continue
+		}
+		seen[entry.Book.ID] = true
+		entries = append(entries, entry)
+	}
+	data.Series.Entries = entries
+
+	return data.Series, nil
+}
+
+// FindSeries looks series up by exact name, largest first
+func (c *Client) FindSeries(ctx context.Context, name string) ([]SeriesRef, error) {
+	var data struct {
+		Series []SeriesRef `json:"series"`
+	}
+	if err := c.query(ctx, findSeriesQuery, map[string]any{"name": name}, &data); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(data.Series, func(i, j int) bool {
+		return data.Series[i].BooksCount > data.Series[j].BooksCount
+	})
+	return data.Series, nil
+}
+
+// SeriesForBook returns the series a book belongs to, preferring the one whose name matches
+func (c *Client) SeriesForBook(ctx context.Context, bookID int64, name string) (*SeriesRef, error) {
+	book, err := c.GetBook(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+	if len(book.BookSeries) == 0 {
+		return nil, fmt.Errorf("%w: book %d is not in a series", ErrNotFound, bookID)
+	}
+
+	for _, bs := range book.BookSeries {
+		if strings.EqualFold(strings.TrimSpace(bs.Series.Name), strings.TrimSpace(name)) {
+			return &bs.Series, nil
+		}
+	}
+	return &book.BookSeries[0].Series, nil
+}
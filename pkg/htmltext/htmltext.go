@@ -0,0 +1,387 @@
+// Package htmltext converts the HTML found in book descriptions into plain text or Markdown.
+//
+// Both forms keep paragraph breaks and decode entities. Scripts, styles, images and other
+// embedded content are dropped, and the Markdown output escapes anything that could be read
+// as raw HTML or unintended formatting.
+package htmltext
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// ToText converts HTML to plain text, with blank lines between paragraphs
+func ToText(s string) string {
+	return convert(s, false)
+}
+
+// ToMarkdown converts HTML to Markdown. Only http, https and mailto links are kept.
+func ToMarkdown(s string) string {
+	return convert(s, true)
+}
+
+func convert(s string, markdown bool) string {
+	if strings.TrimSpace(s) == "" {
+		return ""
+	}
+
+	context := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := html.ParseFragment(strings.NewReader(s), context)
+	if err != nil {
+		// The parser only fails on reader errors, which a strings.Reader never returns
+		return strings.TrimSpace(s)
+	}
+
+	root := &html.Node{Type: html.ElementNode, Data: "div", DataAtom: atom.Div}
+	for _, n := range nodes {
+		root.AppendChild(n)
+	}
+
+	r := renderer{markdown: markdown}
+	return r.blocks(root)
+}
+
+// dropped elements are removed along with their content
+var dropped = map[atom.Atom]bool{
+	atom.Script: true, atom.Style: true, atom.Head: true, atom.Title: true, atom.Iframe: true,
+	atom.Object: true, atom.Embed: true, atom.Noscript: true, atom.Template: true, atom.Svg: true,
+	atom.Img: true, atom.Form: true, atom.Input: true, atom.Button: true, atom.Select: true,
+	atom.Textarea: true, atom.Video: true, atom.Audio: true, atom.Canvas: true,
+}
+
+// block elements start a new paragraph
+var block = map[atom.Atom]bool{
+	atom.P: true, atom.Div: true, atom.Section: true, atom.Article: true, atom.Header: true,
+	atom.Footer: true, atom.Aside: true, atom.Main: true, atom.Nav: true, atom.Figure: true,
+	atom.Figcaption: true, atom.Address: true, atom.Center: true, atom.Table: true, atom.Thead: true,
+	atom.Tbody: true, atom.Tfoot: true, atom.Tr: true, atom.Dl: true, atom.Dt: true, atom.Dd: true,
+	atom.H1: true, atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true, atom.H6: true,
+	atom.Ul: true, atom.Ol: true, atom.Li: true, atom.Blockquote: true, atom.Pre: true, atom.Hr: true,
+}
+
+var headingLevels = map[atom.Atom]int{
+	atom.H1: 1, atom.H2: 2, atom.H3: 3, atom.H4: 4, atom.H5: 5, atom.H6: 6,
+}
+
+// orderedMarker matches text that Markdown would read as an ordered list item
+var orderedMarker = regexp.MustCompile(`^(\d+)([.)])(\s|$)`)
+
+type renderer struct {
+	markdown bool
+}
+
+// blocks renders the children of n as paragraphs separated by blank lines
+func (r *renderer) blocks(n *html.Node) string {
+	var out []string
+	var inline strings.Builder
+
+	flush := func() {
+		out = append(out, r.paragraphs(inline.String())...)
+		inline.Reset()
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && dropped[c.DataAtom] {
+			continue
+		}
+		if c.Type == html.ElementNode && block[c.DataAtom] {
+			flush()
+			if b := r.block(c); b != "" {
+				out = append(out, b)
+			}
+			continue
+		}
+		// Inline elements wrapping blocks, like <span><p>..</p></span>, are treated as containers
+		if c.Type == html.ElementNode && containsBlock(c) {
+			flush()
+			if b := r.blocks(c); b != "" {
+				out = append(out, b)
+			}
+			continue
+		}
+		inline.WriteString(r.inline(c))
+	}
+	flush()
+
+	return strings.Join(out, "\n\n")
+}
+
+// block renders a single block-level element
+func (r *renderer) block(n *html.Node) string {
+	switch {
+	case headingLevels[n.DataAtom] > 0:
+		text := strings.Join(r.paragraphs(r.inlineChildren(n)), " ")
+		if text == "" || !r.markdown {
+			return text
+		}
+		return strings.Repeat("#", headingLevels[n.DataAtom]) + " " + text
+	case n.DataAtom == atom.Ul || n.DataAtom == atom.Ol:
+		return r.list(n)
+	case n.DataAtom == atom.Blockquote:
+		content := r.blocks(n)
+		if content == "" || !r.markdown {
+			return content
+		}
+		lines := strings.Split(content, "\n")
+		for i, line := range lines {
+			if line == "" {
+				lines[i] = ">"
+			} else {
+				lines[i] = "> " + line
+			}
+		}
+		return strings.Join(lines, "\n")
+	case n.DataAtom == atom.Pre:
+		text := strings.Trim(textContent(n), "\n")
+		if strings.TrimSpace(text) == "" || !r.markdown {
+			return text
+		}
+		fence := "```"
+		for strings.Contains(text, fence) {
+			fence += "`"
+		}
+		return fence + "\n" + text + "\n" + fence
+	case n.DataAtom == atom.Hr:
+		if r.markdown {
+			return "---"
+		}
+		return ""
+	default:
+		return r.blocks(n)
+	}
+}
+
+// list renders ul/ol items, indenting continuation lines under their marker
+func (r *renderer) list(n *html.Node) string {
+	var items []string
+	number := 1
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type != html.ElementNode || c.DataAtom != atom.Li {
+			continue
+		}
+		content := r.blocks(c)
+		if content == "" {
+			continue
+		}
+
+		marker := "- "
+		if n.DataAtom == atom.Ol {
+			marker = strconv.Itoa(number) + ". "
+			number++
+		}
+		indent := strings.Repeat(" ", len(marker))
+
+		lines := strings.Split(content, "\n")
+		for i := range lines {
+			switch {
+			case i == 0:
+				lines[i] = marker + lines[i]
+			case lines[i] != "":
+				lines[i] = indent + lines[i]
+			}
+		}
+		items = append(items, strings.Join(lines, "\n"))
+	}
+	return strings.Join(items, "\n")
+}
+
+// inlineChildren renders the children of n as inline content
+func (r *renderer) inlineChildren(n *html.Node) string {
+	var b strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		b.WriteString(r.inline(c))
+	}
+	return b.String()
+}
+
+// inline renders a node as inline content; line breaks come out as "\n"
+func (r *renderer) inline(n *html.Node) string {
+	switch n.Type {
+	case html.TextNode:
+		// Newlines in the source are just whitespace; only <br> and blocks break lines
+		text := strings.ReplaceAll(n.Data, "\n", " ")
+		if r.markdown {
+			return escape(text)
+		}
+		return text
+	case html.ElementNode:
+	default:
+		return ""
+	}
+
+	if dropped[n.DataAtom] {
+		return ""
+	}
+	// Block elements inside headings and other inline-only contexts still get their own paragraph
+	if block[n.DataAtom] {
+		return "\n\n" + r.inlineChildren(n) + "\n\n"
+	}
+
+	switch n.DataAtom {
+	case atom.Br:
+		return "\n"
+	case atom.B, atom.Strong:
+		return r.wrap(r.inlineChildren(n), "**")
+	case atom.I, atom.Em, atom.Cite:
+		return r.wrap(r.inlineChildren(n), "*")
+	case atom.Code, atom.Kbd, atom.Samp, atom.Tt:
+		return r.code(textContent(n))
+	case atom.A:
+		return r.link(n)
+	}
+	return r.inlineChildren(n)
+}
+
+// wrap surrounds text with a Markdown delimiter, keeping surrounding whitespace outside of it
+func (r *renderer) wrap(text, delim string) string {
+	if !r.markdown {
+		return text
+	}
+	core := strings.TrimFunc(text, unicode.IsSpace)
+	if core == "" {
+		return text
+	}
+	start := strings.Index(text, core)
+	return text[:start] + delim + core + delim + text[start+len(core):]
+}
+
+// code renders inline code, picking a backtick fence the content doesn't contain
+func (r *renderer) code(text string) string {
+	text = collapseSpace(text)
+	if !r.markdown || strings.TrimSpace(text) == "" {
+		return text
+	}
+	fence := "`"
+	for strings.Contains(text, fence) {
+		fence += "`"
+	}
+	if strings.HasPrefix(text, "`") || strings.HasSuffix(text, "`") {
+		return fence + " " + text + " " + fence
+	}
+	return fence + text + fence
+}
+
+// link renders an anchor; unsafe or relative targets are reduced to their text
+func (r *renderer) link(n *html.Node) string {
+	text := r.inlineChildren(n)
+	if !r.markdown {
+		return text
+	}
+
+	href := ""
+	for _, attr := range n.Attr {
+		if attr.Key == "href" {
+			href = strings.TrimSpace(attr.Val)
+		}
+	}
+	lower := strings.ToLower(href)
+	if !strings.HasPrefix(lower, "http://") && !strings.HasPrefix(lower, "https://") && !strings.HasPrefix(lower, "mailto:") {
+		return text
+	}
+
+	core := strings.TrimFunc(text, unicode.IsSpace)
+	if core == "" {
+		return text
+	}
+	href = strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29", "<", "%3C", ">", "%3E").Replace(href)
+	start := strings.Index(text, core)
+	return text[:start] + "[" + core + "](" + href + ")" + text[start+len(core):]
+}
+
+// paragraphs splits inline content into trimmed paragraphs.
+// Single line breaks are kept within a paragraph; blank lines separate paragraphs.
+func (r *renderer) paragraphs(s string) []string {
+	var out []string
+	var lines []string
+
+	flush := func() {
+		if len(lines) == 0 {
+			return
+		}
+		sep := "\n"
+		if r.markdown {
+			sep = "  \n"
+		}
+		out = append(out, strings.Join(lines, sep))
+		lines = nil
+	}
+
+	for line := range strings.SplitSeq(s, "\n") {
+		line = collapseSpace(line)
+		if line == "" {
+			flush()
+			continue
+		}
+		if r.markdown {
+			line = escapeLineStart(line)
+		}
+		lines = append(lines, line)
+	}
+	flush()
+	return out
+}
+
+// collapseSpace trims s and collapses runs of whitespace, including non-breaking spaces
+func collapseSpace(s string) string {
+	return strings.Join(strings.FieldsFunc(s, unicode.IsSpace), " ")
+}
+
+// markdownEscaper escapes characters that would otherwise be read as Markdown syntax or raw HTML
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`, "<", `\<`,
+)
+
+func escape(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+// escapeLineStart escapes markers that only mean something at the start of a line
+func escapeLineStart(line string) string {
+	if m := orderedMarker.FindStringSubmatchIndex(line); m != nil {
+		return line[:m[3]] + `\` + line[m[4]:]
+	}
+	switch line[0] {
+	case '#', '-', '+', '=', '>':
+		return `\` + line
+	}
+	return line
+}
+
+// containsBlock reports whether any element under n is a block element
+func containsBlock(n *html.Node) bool {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type != html.ElementNode || dropped[c.DataAtom] {
+			continue
+		}
+		if block[c.DataAtom] || containsBlock(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// textContent returns the raw text under n, ignoring markup
+func textContent(n *html.Node) string {
+	var b strings.Builder
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			b.WriteString(n.Data)
+			return
+		}
+		if n.Type == html.ElementNode && n.DataAtom == atom.Br {
+			b.WriteString("\n")
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return b.String()
+}
@@ -0,0 +1,112 @@
+package htmltext
+
+import "testing"
+
+func TestToText(t *testing.T) {
+	tests := []struct {
+		name string
+		html string
+		want string
+	}{
+		{
+			name: "empty",
+			html: "  ",
+			want: "",
+		},
+		{
+			name: "plain text is left alone",
+			html: "Just a description.",
+			want: "Just a description.",
+		},
+		{
+			name: "entities are decoded",
+			html: "Tom &amp; Jerry&#39;s &quot;adventure&quot;&nbsp;begins",
+			want: `Tom & Jerry's "adventure" begins`,
+		},
+		{
+			name: "paragraphs keep their breaks",
+			html: "<p>First paragraph.</p><p>Second\n   paragraph.</p>",
+			want: "First paragraph.\n\nSecond paragraph.",
+		},
+		{
+			name: "goodreads style line breaks",
+			html: "He came back.<br /><br />She didn't.<br />Nobody did.",
+			want: "He came back.\n\nShe didn't.\nNobody did.",
+		},
+		{
+			name: "inline markup is dropped",
+			html: "<b>Die Twice</b> is the <i>second</i> <a href=\"/book/show/1\">novel</a>",
+			want: "Die Twice is the second novel",
+		},
+		{
+			name: "scripts and images are removed",
+			html: "<p>Safe<script>alert(1)</script><img src=x onerror=alert(1)></p><style>p{}</style>",
+			want: "Safe",
+		},
+		{
+			name: "lists",
+			html: "<ul><li>One</li><li>Two</li></ul>",
+			want: "- One\n- Two",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToText(tt.html); got != tt.want {
+				t.Errorf("ToText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToMarkdown(t *testing.T) {
+	tests := []struct {
+		name string
+		html string
+		want string
+	}{
+		{
+			name: "emphasis and links",
+			html: `<p><b>Die Twice</b> by <a href="https://example.com/andrew grant">Andrew Grant</a>, <em>a thriller </em>.</p>`,
+			want: "**Die Twice** by [Andrew Grant](https://example.com/andrew%20grant), *a thriller* .",
+		},
+		{
+			name: "unsafe and relative links keep only their text",
+			html: `<a href="javascript:alert(1)">click</a> <a href="/book/show/1">book</a>`,
+			want: "click book",
+		},
+		{
+			name: "markdown and html in text is escaped",
+			html: "5 * 3 = 15 &lt;script&gt;alert(1)&lt;/script&gt; [not a link](x) snake_case",
+			want: `5 \* 3 = 15 \<script>alert(1)\</script> \[not a link\](x) snake\_case`,
+		},
+		{
+			name: "line start markers are escaped",
+			html: "# not a heading<br>- not a list<br>1. not numbered<br>&gt; not a quote",
+			want: "\\# not a heading  \n\\- not a list  \n1\\. not numbered  \n\\> not a quote",
+		},
+		{
+			name: "headings lists and quotes",
+			html: "<h2>Praise</h2><blockquote><p>Gripping.</p><p>Relentless.</p></blockquote><ol><li>One</li><li><p>Two</p><p>More</p></li></ol>",
+			want: "## Praise\n\n> Gripping.\n>\n> Relentless.\n\n1. One\n2. Two\n\n   More",
+		},
+		{
+			name: "block inside inline element",
+			html: "<span><p>First</p><p>Second</p></span>",
+			want: "First\n\nSecond",
+		},
+		{
+			name: "code",
+			html: "Run <code>go test</code><pre>line 1\n  line 2</pre>",
+			want: "Run `go test`\n\n```\nline 1\n  line 2\n```",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToMarkdown(tt.html); got != tt.want {
+				t.Errorf("ToMarkdown() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
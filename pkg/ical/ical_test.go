@@ -0,0 +1,78 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCalendarWrite(t *testing.T) {
+	cal := Calendar{
+		ProdID: "-//bookscraping//test//EN",
+		Name:   "Upcoming, releases",
+		Events: []Event{
+			{
+				UID:         "book-1@bookscraping",
+				Date:        time.Date(2026, 11, 3, 0, 0, 0, 0, time.UTC),
+				Summary:     "Die Twice; Again (David Trevellyan, #4)",
+				Description: "Line one\nLine two with a backslash \\",
+				URL:         "https://www.goodreads.com/book/show/1",
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	now := time.Date(2026, 10, 18, 12, 30, 0, 0, time.UTC)
+	if err := cal.Write(&buf, now); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"X-WR-CALNAME:Upcoming\\, releases\r\n",
+		"DTSTAMP:20261018T123000Z\r\n",
+		"DTSTART;VALUE=DATE:20261103\r\n",
+		"DTEND;VALUE=DATE:20261104\r\n",
+		"SUMMARY:Die Twice\\; Again (David Trevellyan\\, #4)\r\n",
+		"DESCRIPTION:Line one\\nLine two with a backslash \\\\\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("calendar output missing %q\n%s", want, out)
+		}
+	}
+	if strings.Contains(strings.ReplaceAll(out, "\r\n", ""), "\n") {
+		t.Error("calendar output contains bare LF line endings")
+	}
+}
+
+func TestWriteFolded(t *testing.T) {
+	cal := Calendar{
+		ProdID: "-//bookscraping//test//EN",
+		Events: []Event{
+			{
+				UID:     "book-2@bookscraping",
+				Date:    time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				Summary: strings.Repeat("é", 100),
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := cal.Write(&buf, time.Now()); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	for _, line := range strings.Split(buf.String(), "\r\n") {
+		if len(line) > maxLineOctets {
+			t.Errorf("line longer than %d octets: %q", maxLineOctets, line)
+		}
+	}
+
+	unfolded := strings.ReplaceAll(buf.String(), "\r\n ", "")
+	if !strings.Contains(unfolded, "SUMMARY:"+strings.Repeat("é", 100)+"\r\n") {
+		t.Error("unfolded summary does not round-trip")
+	}
+}
@@ -0,0 +1,28 @@
+// Package identifiers turns external book identifiers into canonical forms, so the same record
+// compares equal whichever source it came from.
+package identifiers
+
+import (
+	"regexp"
+	"strings"
+)
+
+// goodreadsIDPattern finds the numeric ID in plain IDs, slugs ("7315139-die-twice",
+// "7315139.Die_Twice") and Goodreads URLs ("https://www.goodreads.com/book/show/7315139-die-twice")
+var goodreadsIDPattern = regexp.MustCompile(`^(?:(?:https?://)?(?:www\.)?(?:goodreads\.com)?/(?:book/show|series|author/show|work/editions)/)?0*([1-9]\d*)(?:[-._?#/].*)?$`)
+
+// GoodreadsID returns the canonical numeric form of a Goodreads book, work, series or author ID.
+// It returns "" when raw has no recognizable ID.
+func GoodreadsID(raw string) string {
+	m := goodreadsIDPattern.FindStringSubmatch(strings.TrimSpace(raw))
+	if m == nil || m[1] == "" {
+		return ""
+	}
+	return m[1]
+}
+
+// SameGoodreadsID reports whether two Goodreads IDs, in any form, name the same record
+func SameGoodreadsID(a, b string) bool {
+	id := GoodreadsID(a)
+	return id != "" && id == GoodreadsID(b)
+}
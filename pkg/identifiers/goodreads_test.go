@@ -0,0 +1,44 @@
+package identifiers
+
+import "testing"
+
+func TestGoodreadsID(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want string
+	}{
+		{"7315139", "7315139"},
+		{" 7315139 ", "7315139"},
+		{"7315139-die-twice", "7315139"},
+		{"7315139.Die_Twice", "7315139"},
+		{"007315139", "7315139"},
+		{"https://www.goodreads.com/book/show/7315139-die-twice", "7315139"},
+		{"https://www.goodreads.com/book/show/7315139.Die_Twice?from_search=true", "7315139"},
+		{"goodreads.com/book/show/7315139", "7315139"},
+		{"/book/show/7315139-die-twice", "7315139"},
+		{"https://www.goodreads.com/series/49126-david-trevellyan", "49126"},
+		{"https://www.goodreads.com/work/editions/7573428-die-twice", "7573428"},
+		{"", ""},
+		{"0", ""},
+		{"die-twice", ""},
+		{"https://www.goodreads.com/book/show/", ""},
+		{"https://example.com/book/7315139", ""},
+	}
+	for _, tt := range tests {
+		if got := GoodreadsID(tt.raw); got != tt.want {
+			t.Errorf("GoodreadsID(%q) = %q, want %q", tt.raw, got, tt.want)
+		}
+	}
+}
+
+func TestSameGoodreadsID(t *testing.T) {
+	if !SameGoodreadsID("7315139-die-twice", "7315139") {
+		t.Error("slug and numeric ID should be the same")
+	}
+	if SameGoodreadsID("", "") {
+		t.Error("empty IDs should never be the same")
+	}
+	if SameGoodreadsID("7315139", "7315138") {
+		t.Error("different IDs should not be the same")
+	}
+}
@@ -0,0 +1,505 @@
+// Package jobs runs background work from a queue stored in SQLite, so it survives restarts.
+//
+// A worker leases a job while it runs and keeps renewing the lease; a job whose worker died is
+// picked up again once its lease runs out. The job's attempt count identifies the lease, so a worker
+// whose job was taken over can neither renew it nor store an outcome for it. Failed jobs are retried with exponential backoff until
+// they run out of attempts, after which they are dead-lettered until someone requeues them.
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+)
+
+// Job statuses
+const (
+	StatusQueued    = "queued"
+	StatusRunning   = "running"
+	StatusSucceeded = "succeeded"
+	StatusDead      = "dead"
+)
+
+// Defaults
+const (
+	DefaultWorkers      = 1
+	DefaultMaxAttempts  = 5
+	DefaultLease        = 5 * time.Minute
+	DefaultPollInterval = 5 * time.Second
+	DefaultBackoff      = 30 * time.Second
+	DefaultMaxBackoff   = time.Hour
+)
+
+// ErrUnknownKind is returned when enqueuing a job no handler is registered for
+var ErrUnknownKind = errors.New("no handler registered for job kind")
+
+// errLeaseLost means another worker took the job over, so this worker must not store an outcome
+var errLeaseLost = errors.New("job lease lost")
+
+// Handler runs one job with its raw JSON payload
+type Handler func(ctx context.Context, payload []byte) error
+
+// Queue stores jobs and runs them with a pool of workers
+type Queue struct {
+	queries db.Querier
+
+	mu       sync.RWMutex
+	handlers map[string]Handler
+
+	workers      int
+	maxAttempts  int64
+	lease        time.Duration
+	pollInterval time.Duration
+	backoff      time.Duration
+	maxBackoff   time.Duration
+
+	// wake lets Enqueue start an idle worker without waiting for the next poll
+	wake chan struct{}
+
+	// pause is held for reading while a worker claims and runs a job, and for writing while the queue is paused
+	pause sync.RWMutex
+	// runningMu guards running and paused
+	runningMu sync.Mutex
+	// running cancels the jobs in progress, keyed by job ID
+	running map[int64]context.CancelFunc
+	paused  bool
+}
+
+// Option configures a Queue
+type Option func(*Queue)
+
+// WithWorkers sets how many jobs run at once
+func WithWorkers(workers int) Option {
+	return func(q *Queue) {
+		q.workers = workers
+	}
+}
+
+// WithMaxAttempts sets how many times a job is tried before it is dead-lettered
+func WithMaxAttempts(attempts int64) Option {
+	return func(q *Queue) {
+		q.maxAttempts = attempts
+	}
+}
+
+// WithLease sets how long a worker holds a job before another worker may take it over
+func WithLease(lease time.Duration) Option {
+	return func(q *Queue) {
+		q.lease = lease
+	}
+}
+
+// WithPollInterval sets how often idle workers look for due jobs
+func WithPollInterval(interval time.Duration) Option {
+	return func(q *Queue) {
+		q.pollInterval = interval
+	}
+}
+
+// WithBackoff sets the delay before the first retry, which doubles on every further retry up to max
+func WithBackoff(base, max time.Duration) Option {
+	return func(q *Queue) {
+		q.backoff = base
+		q.maxBackoff = max
+	}
+}
+
+// New creates a queue on top of the jobs table
+func New(queries db.Querier, opts ...Option) *Queue {
+	q := &Queue{
+		queries:      queries,
+		handlers:     make(map[string]Handler),
+		workers:      DefaultWorkers,
+		maxAttempts:  DefaultMaxAttempts,
+		lease:        DefaultLease,
+		pollInterval: DefaultPollInterval,
+		backoff:      DefaultBackoff,
+		maxBackoff:   DefaultMaxBackoff,
+		wake:         make(chan struct{}, 1),
+		running:      make(map[int64]context.CancelFunc),
+	}
+	for _, opt := range opts {
+		opt(q)
+	}
+	return q
+}
+
+// Handle registers the handler for a kind of job
+func (q *Queue) Handle(kind string, handler Handler) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.handlers[kind] = handler
+}
+
+// Register registers a handler that receives the job payload decoded into T.
+// A payload that doesn't decode fails the job without retries.
+func Register[T any](q *Queue, kind string, handler func(context.Context, T) error) {
+	q.Handle(kind, func(ctx context.Context, payload []byte) error {
+		var args T
+		if err := json.Unmarshal(payload, &args); err != nil {
+			return Permanent(fmt.Errorf("decoding %s payload: %w", kind, err))
+		}
+		return handler(ctx, args)
+	})
+}
+
+// Kinds lists the registered job kinds
+func (q *Queue) Kinds() []string {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	kinds := make([]string, 0, len(q.handlers))
+	for kind := range q.handlers {
+		kinds = append(kinds, kind)
+	}
+	slices.Sort(kinds)
+	return kinds
+}
+
+func (q *Queue) handler(kind string) (Handler, bool) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	handler, ok := q.handlers[kind]
+	return handler, ok
+}
+
+// EnqueueOption configures a single job
+type EnqueueOption func(*enqueueOptions)
+
+type enqueueOptions struct {
+	delay       time.Duration
+	maxAttempts int64
+}
+
+// WithDelay runs the job no earlier than delay from now
+func WithDelay(delay time.Duration) EnqueueOption {
+	return func(o *enqueueOptions) {
+		o.delay = delay
+	}
+}
+
+// WithAttempts overrides the queue's maximum attempts for this job
+func WithAttempts(attempts int64) EnqueueOption {
+	return func(o *enqueueOptions) {
+		o.maxAttempts = attempts
+	}
+}
+
+// Enqueue stores a job with its payload encoded as JSON
+func (q *Queue) Enqueue(ctx context.Context, kind string, payload any, opts ...EnqueueOption) (db.Job, error) {
+	if _, ok := q.handler(kind); !ok {
+		return db.Job{}, fmt.Errorf("%w: %q", ErrUnknownKind, kind)
+	}
+
+	options := enqueueOptions{maxAttempts: q.maxAttempts}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return db.Job{}, fmt.Errorf("encoding %s payload: %w", kind, err)
+	}
+
+	job, err := q.queries.EnqueueJob(ctx, db.EnqueueJobParams{
+		Kind:         kind,
+		Payload:      string(encoded),
+		MaxAttempts:  max(options.maxAttempts, 1),
+		DelaySeconds: seconds(options.delay),
+	})
+	if err != nil {
+		return db.Job{}, fmt.Errorf("enqueuing %s job: %w", kind, err)
+	}
+
+	slog.Debug("Enqueued job", slog.Int64("job_id", job.ID), slog.String("kind", kind))
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+	return job, nil
+}
+
+// Run works through due jobs until ctx is done, then waits for the running jobs to stop
+func (q *Queue) Run(ctx context.Context) {
+	slog.Info("Starting job workers", slog.Int("workers", q.workers), slog.Any("kinds", q.Kinds()))
+
+	var wg sync.WaitGroup
+	for range max(q.workers, 1) {
+		wg.Go(func() {
+			q.work(ctx)
+		})
+	}
+	wg.Wait()
+}
+
+// work claims and runs jobs, sleeping when there are none due
+func (q *Queue) work(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.wake:
+		case <-timer.C:
+		}
+
+		// Drain the queue before sleeping again
+		for ctx.Err() == nil {
+			if !q.runNext(ctx) {
+				break
+			}
+		}
+		timer.Reset(q.pollInterval)
+	}
+}
+
+// runNext claims and runs one job, reporting false when none was due
+func (q *Queue) runNext(ctx context.Context) bool {
+	q.pause.RLock()
+	defer q.pause.RUnlock()
+
+	job, ok := q.claim(ctx)
+	if !ok {
+		return false
+	}
+
+	jobCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	q.track(job.ID, cancel)
+	defer q.untrack(job.ID)
+
+	q.process(jobCtx, job)
+	return true
+}
+
+// Pause stops the workers from claiming jobs and interrupts the running ones, which go back on the queue.
+// It returns once no job is running. Resume lets the workers carry on.
+func (q *Queue) Pause() {
+	q.runningMu.Lock()
+	q.paused = true
+	for _, cancel := range q.running {
+		cancel()
+	}
+	q.runningMu.Unlock()
+
+	q.pause.Lock()
+}
+
+// Resume lets the workers claim jobs again after Pause
+func (q *Queue) Resume() {
+	q.runningMu.Lock()
+	q.paused = false
+	q.runningMu.Unlock()
+
+	q.pause.Unlock()
+}
+
+// track records how to interrupt a running job, interrupting it straight away when the queue is being paused
+func (q *Queue) track(id int64, cancel context.CancelFunc) {
+	q.runningMu.Lock()
+	defer q.runningMu.Unlock()
+	if q.paused {
+		cancel()
+	}
+	q.running[id] = cancel
+}
+
+func (q *Queue) untrack(id int64) {
+	q.runningMu.Lock()
+	defer q.runningMu.Unlock()
+	delete(q.running, id)
+}
+
+// claim leases the next due job, if there is one
+func (q *Queue) claim(ctx context.Context) (db.Job, bool) {
+	job, err := q.queries.ClaimJob(ctx, seconds(q.lease))
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.Job{}, false
+	}
+	if err != nil {
+		if ctx.Err() == nil {
+			slog.Error("Failed to claim job", slog.Any("error", err))
+		}
+		return db.Job{}, false
+	}
+	return job, true
+}
+
+// process runs a claimed job and records the outcome
+func (q *Queue) process(ctx context.Context, job db.Job) {
+	logger := slog.With(slog.Int64("job_id", job.ID), slog.String("kind", job.Kind), slog.Int64("attempt", job.Attempts))
+	// Outcomes are stored even while shutting down
+	storeCtx := context.WithoutCancel(ctx)
+
+	handler, ok := q.handler(job.Kind)
+	if !ok {
+		q.deadLetter(storeCtx, logger, job, fmt.Errorf("%w: %q", ErrUnknownKind, job.Kind))
+		return
+	}
+	// A job whose lease ran out on its last attempt has been tried often enough
+	if job.Attempts > job.MaxAttempts {
+		q.deadLetter(storeCtx, logger, job, fmt.Errorf("lease expired after %d attempts", job.MaxAttempts))
+		return
+	}
+
+	logger.Info("Running job")
+	err := q.runWithLease(ctx, logger, job, handler)
+
+	var perm *permanentError
+	switch {
+	case errors.Is(err, errLeaseLost):
+		logger.Warn("Lost job lease, leaving the job to the worker that took it over", slog.Any("error", err))
+	case err == nil:
+		completed, err := q.queries.CompleteJob(storeCtx, db.CompleteJobParams{ID: job.ID, Attempts: job.Attempts})
+		if err != nil {
+			logger.Error("Failed to mark job succeeded", slog.Any("error", err))
+			return
+		}
+		if completed == 0 {
+			logger.Warn("Lost job lease, not marking the job succeeded")
+			return
+		}
+		logger.Info("Job succeeded")
+	case ctx.Err() != nil:
+		// Interrupted by shutdown or a pause: put it back so it runs again straight away
+		q.release(storeCtx, logger, job)
+	case errors.As(err, &perm) || job.Attempts >= job.MaxAttempts:
+		q.deadLetter(storeCtx, logger, job, err)
+	default:
+		q.retry(storeCtx, logger, job, err, q.backoffFor(job.Attempts))
+	}
+}
+
+// runWithLease runs the handler while renewing the job's lease. If the lease is lost,
+// another worker may have taken the job over, so the handler's context is cancelled
+// and errLeaseLost is returned.
+func (q *Queue) runWithLease(ctx context.Context, logger *slog.Logger, job db.Job, handler Handler) (err error) {
+	jobCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		ticker := time.NewTicker(max(q.lease/2, time.Second))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-jobCtx.Done():
+				return
+			case <-ticker.C:
+				renewed, err := q.queries.ExtendJobLease(jobCtx, db.ExtendJobLeaseParams{
+					LeaseSeconds: seconds(q.lease),
+					ID:           job.ID,
+					Attempts:     job.Attempts,
+				})
+				if err != nil {
+					logger.Error("Failed to renew job lease", slog.Any("error", err))
+					continue
+				}
+				if renewed == 0 {
+					cancel(errLeaseLost)
+					return
+				}
+			}
+		}
+	})
+	defer wg.Wait()
+	defer cancel(nil)
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+		if errors.Is(context.Cause(jobCtx), errLeaseLost) {
+			err = errors.Join(errLeaseLost, err)
+		}
+	}()
+	return handler(jobCtx, []byte(job.Payload))
+}
+
+func (q *Queue) retry(ctx context.Context, logger *slog.Logger, job db.Job, jobErr error, delay time.Duration) {
+	message := jobErr.Error()
+	retried, err := q.queries.RetryJob(ctx, db.RetryJobParams{
+		LastError:    &message,
+		DelaySeconds: seconds(delay),
+		ID:           job.ID,
+		Attempts:     job.Attempts,
+	})
+	if err != nil {
+		logger.Error("Failed to reschedule job", slog.Any("error", err))
+		return
+	}
+	if retried == 0 {
+		logger.Warn("Lost job lease, not rescheduling the job", slog.Any("error", jobErr))
+		return
+	}
+	logger.Warn("Job failed, retrying", slog.Duration("delay", delay), slog.Any("error", jobErr))
+}
+
+// release puts an interrupted job back on the queue without counting the attempt it didn't get to finish
+func (q *Queue) release(ctx context.Context, logger *slog.Logger, job db.Job) {
+	released, err := q.queries.ReleaseJob(ctx, db.ReleaseJobParams{ID: job.ID, Attempts: job.Attempts})
+	if err != nil {
+		logger.Error("Failed to requeue interrupted job", slog.Any("error", err))
+		return
+	}
+	if released == 0 {
+		logger.Warn("Lost job lease, not requeueing the interrupted job")
+		return
+	}
+	logger.Info("Job interrupted, requeued")
+}
+
+func (q *Queue) deadLetter(ctx context.Context, logger *slog.Logger, job db.Job, jobErr error) {
+	message := jobErr.Error()
+	dead, err := q.queries.DeadLetterJob(ctx, db.DeadLetterJobParams{LastError: &message, ID: job.ID, Attempts: job.Attempts})
+	if err != nil {
+		logger.Error("Failed to dead-letter job", slog.Any("error", err))
+		return
+	}
+	if dead == 0 {
+		logger.Warn("Lost job lease, not dead-lettering the job", slog.Any("error", jobErr))
+		return
+	}
+	logger.Error("Job dead-lettered", slog.Any("error", jobErr))
+}
+
+// backoffFor returns the delay before retrying a job that failed on the given attempt
+func (q *Queue) backoffFor(attempt int64) time.Duration {
+	delay := q.backoff
+	for i := int64(1); i < attempt && delay < q.maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, q.maxBackoff)
+}
+
+// seconds rounds a duration up to whole seconds, the resolution of the jobs table
+func seconds(d time.Duration) int64 {
+	return int64((d + time.Second - 1) / time.Second)
+}
+
+// permanentError marks a failure that retrying won't fix
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent wraps an error so the job is dead-lettered straight away instead of retried
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
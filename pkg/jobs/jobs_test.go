@@ -0,0 +1,232 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/stretchr/testify/mock"
+)
+
+type payload struct {
+	Name string `json:"name"`
+}
+
+func TestQueue_process(t *testing.T) {
+	errTransient := errors.New("transient")
+
+	tests := []struct {
+		name    string
+		job     db.Job
+		handler func(context.Context, payload) error
+		expect  func(*db.MockQuerier)
+	}{
+		{
+			name:    "success",
+			job:     db.Job{ID: 1, Kind: "test", Payload: `{"name":"a"}`, Attempts: 1, MaxAttempts: 3},
+			handler: func(context.Context, payload) error { return nil },
+			expect: func(m *db.MockQuerier) {
+				m.On("CompleteJob", mock.Anything, db.CompleteJobParams{ID: 1, Attempts: 1}).Return(int64(1), nil).Once()
+			},
+		},
+		{
+			name:    "failure is retried with backoff",
+			job:     db.Job{ID: 1, Kind: "test", Payload: `{}`, Attempts: 2, MaxAttempts: 3},
+			handler: func(context.Context, payload) error { return errTransient },
+			expect: func(m *db.MockQuerier) {
+				m.On("RetryJob", mock.Anything, mock.MatchedBy(func(arg db.RetryJobParams) bool {
+					return arg.ID == 1 && arg.DelaySeconds == 20 && *arg.LastError == "transient"
+				})).Return(int64(1), nil).Once()
+			},
+		},
+		{
+			name:    "failure on the last attempt is dead-lettered",
+			job:     db.Job{ID: 1, Kind: "test", Payload: `{}`, Attempts: 3, MaxAttempts: 3},
+			handler: func(context.Context, payload) error { return errTransient },
+			expect: func(m *db.MockQuerier) {
+				m.On("DeadLetterJob", mock.Anything, mock.MatchedBy(func(arg db.DeadLetterJobParams) bool {
+					return arg.ID == 1 && *arg.LastError == "transient"
+				})).Return(int64(1), nil).Once()
+			},
+		},
+		{
+			name:    "permanent failure is dead-lettered",
+			job:     db.Job{ID: 1, Kind: "test", Payload: `{}`, Attempts: 1, MaxAttempts: 3},
+			handler: func(context.Context, payload) error { return Permanent(errTransient) },
+			expect: func(m *db.MockQuerier) {
+				m.On("DeadLetterJob", mock.Anything, mock.Anything).Return(int64(1), nil).Once()
+			},
+		},
+		{
+			name:    "undecodable payload is dead-lettered",
+			job:     db.Job{ID: 1, Kind: "test", Payload: `[]`, Attempts: 1, MaxAttempts: 3},
+			handler: func(context.Context, payload) error { return nil },
+			expect: func(m *db.MockQuerier) {
+				m.On("DeadLetterJob", mock.Anything, mock.Anything).Return(int64(1), nil).Once()
+			},
+		},
+		{
+			name:    "panic is retried",
+			job:     db.Job{ID: 1, Kind: "test", Payload: `{}`, Attempts: 1, MaxAttempts: 3},
+			handler: func(context.Context, payload) error { panic("boom") },
+			expect: func(m *db.MockQuerier) {
+				m.On("RetryJob", mock.Anything, mock.MatchedBy(func(arg db.RetryJobParams) bool {
+					return *arg.LastError == "job panicked: boom"
+				})).Return(int64(1), nil).Once()
+			},
+		},
+		{
+			name: "unknown kind is dead-lettered",
+			job:  db.Job{ID: 1, Kind: "other", Payload: `{}`, Attempts: 1, MaxAttempts: 3},
+			expect: func(m *db.MockQuerier) {
+				m.On("DeadLetterJob", mock.Anything, mock.Anything).Return(int64(1), nil).Once()
+			},
+		},
+		{
+			name:    "lease expired on the last attempt",
+			job:     db.Job{ID: 1, Kind: "test", Payload: `{}`, Attempts: 4, MaxAttempts: 3},
+			handler: func(context.Context, payload) error { t.Error("handler should not run"); return nil },
+			expect: func(m *db.MockQuerier) {
+				m.On("DeadLetterJob", mock.Anything, mock.Anything).Return(int64(1), nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQuerier := db.NewMockQuerier(t)
+			tt.expect(mockQuerier)
+
+			q := New(mockQuerier, WithBackoff(10*time.Second, time.Minute))
+			if tt.handler != nil {
+				Register(q, "test", tt.handler)
+			}
+			q.process(context.Background(), tt.job)
+		})
+	}
+}
+
+func TestQueue_processShutdown(t *testing.T) {
+	mockQuerier := db.NewMockQuerier(t)
+	// A job interrupted by shutdown on its last attempt goes back on the queue with that attempt given back,
+	// rather than being dead-lettered
+	mockQuerier.On("ReleaseJob", mock.Anything, db.ReleaseJobParams{ID: 1, Attempts: 3}).Return(int64(1), nil).Once()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	q := New(mockQuerier)
+	Register(q, "test", func(ctx context.Context, _ payload) error {
+		cancel()
+		return ctx.Err()
+	})
+	q.process(ctx, db.Job{ID: 1, Kind: "test", Payload: `{}`, Attempts: 3, MaxAttempts: 3})
+}
+
+func TestQueue_processLeaseLost(t *testing.T) {
+	t.Run("taken over while running", func(t *testing.T) {
+		mockQuerier := db.NewMockQuerier(t)
+		// Another worker claimed the job, bumping its attempts, so renewing the lease matches nothing
+		mockQuerier.On("ExtendJobLease", mock.Anything, mock.MatchedBy(func(arg db.ExtendJobLeaseParams) bool {
+			return arg.ID == 1 && arg.Attempts == 1
+		})).Return(int64(0), nil).Once()
+
+		q := New(mockQuerier, WithLease(time.Second))
+		Register(q, "test", func(ctx context.Context, _ payload) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+		// No outcome is stored: the mock fails on any ReleaseJob, CompleteJob or DeadLetterJob call
+		q.process(context.Background(), db.Job{ID: 1, Kind: "test", Payload: `{}`, Attempts: 1, MaxAttempts: 3})
+	})
+
+	t.Run("taken over before the outcome was stored", func(t *testing.T) {
+		mockQuerier := db.NewMockQuerier(t)
+		mockQuerier.On("CompleteJob", mock.Anything, db.CompleteJobParams{ID: 1, Attempts: 1}).Return(int64(0), nil).Once()
+
+		q := New(mockQuerier)
+		Register(q, "test", func(context.Context, payload) error { return nil })
+		q.process(context.Background(), db.Job{ID: 1, Kind: "test", Payload: `{}`, Attempts: 1, MaxAttempts: 3})
+	})
+}
+
+func TestQueue_Enqueue(t *testing.T) {
+	mockQuerier := db.NewMockQuerier(t)
+	mockQuerier.On("EnqueueJob", mock.Anything, db.EnqueueJobParams{
+		Kind:         "test",
+		Payload:      `{"name":"a"}`,
+		MaxAttempts:  2,
+		DelaySeconds: 90,
+	}).Return(db.Job{ID: 5, Kind: "test"}, nil).Once()
+
+	q := New(mockQuerier, WithMaxAttempts(2))
+	Register(q, "test", func(context.Context, payload) error { return nil })
+
+	job, err := q.Enqueue(context.Background(), "test", payload{Name: "a"}, WithDelay(90*time.Second))
+	if err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+	if job.ID != 5 {
+		t.Errorf("Enqueue() job ID = %d, want 5", job.ID)
+	}
+
+	if _, err := q.Enqueue(context.Background(), "other", nil); !errors.Is(err, ErrUnknownKind) {
+		t.Errorf("Enqueue() of an unknown kind error = %v, want %v", err, ErrUnknownKind)
+	}
+}
+
+func TestQueue_backoffFor(t *testing.T) {
+	q := New(nil, WithBackoff(30*time.Second, 5*time.Minute))
+
+	tests := []struct {
+		attempt int64
+		want    time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{4, 4 * time.Minute},
+		{5, 5 * time.Minute},
+		{50, 5 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := q.backoffFor(tt.attempt); got != tt.want {
+			t.Errorf("backoffFor(%d) = %v, want %v", tt.attempt, got, tt.want)
+		}
+	}
+}
+
+func TestQueue_Pause(t *testing.T) {
+	job := db.Job{ID: 1, Kind: "test", Payload: `{}`, Attempts: 1, MaxAttempts: 3}
+
+	mockQuerier := db.NewMockQuerier(t)
+	mockQuerier.On("ClaimJob", mock.Anything, mock.Anything).Return(job, nil).Once()
+	mockQuerier.On("ClaimJob", mock.Anything, mock.Anything).Return(db.Job{}, sql.ErrNoRows).Maybe()
+	// The interrupted job goes back on the queue straight away
+	mockQuerier.On("ReleaseJob", mock.Anything, db.ReleaseJobParams{ID: 1, Attempts: 1}).Return(int64(1), nil).Once()
+
+	started := make(chan struct{})
+	q := New(mockQuerier, WithPollInterval(time.Hour))
+	Register(q, "test", func(ctx context.Context, _ payload) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		q.Run(ctx)
+	}()
+
+	<-started
+	// Pause returns only once the running job has been interrupted and its outcome stored
+	q.Pause()
+	mockQuerier.AssertCalled(t, "ReleaseJob", mock.Anything, mock.Anything)
+	q.Resume()
+
+	cancel()
+	<-done
+}
@@ -0,0 +1,293 @@
+// Package match decides whether a book listed in a series is one we already own.
+//
+// Shared identifiers (Goodreads book or work ID, ISBN, ASIN, Hardcover ID) are a certain match.
+// Without one, the normalized title, authors and series position are scored, and the score is
+// compared against two thresholds: above the match threshold the books are the same, between
+// the review threshold and the match threshold a person should decide.
+package match
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/identifiers"
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+)
+
+// Default thresholds
+const (
+	DefaultThreshold       = 0.85
+	DefaultReviewThreshold = 0.6
+)
+
+// Weights of the fuzzy score components; they add up to 1
+const (
+	titleWeight    = 0.6
+	authorWeight   = 0.25
+	positionWeight = 0.15
+)
+
+// Verdict is the outcome of matching a series entry against owned books
+type Verdict string
+
+const (
+	// Matched means the entry is an owned book
+	Matched Verdict = "matched"
+	// Review means the best candidate is plausible but uncertain
+	Review Verdict = "review"
+	// Missing means no owned book resembles the entry
+	Missing Verdict = "missing"
+)
+
+// Book is the part of a book the matcher compares
+type Book struct {
+	metadata.Identifiers
+	Title   string
+	Authors []string
+	// Position is the series position; 0 when unknown
+	Position float64
+}
+
+// Result describes the best owned book for a series entry
+type Result struct {
+	// Index of the best owned book, or -1 when there are none
+	Index   int
+	Score   float64
+	Reason  string
+	Verdict Verdict
+}
+
+// Matcher compares series entries against owned books
+type Matcher struct {
+	threshold       float64
+	reviewThreshold float64
+}
+
+// Option configures a Matcher
+type Option func(*Matcher)
+
+// WithThreshold sets the score at or above which books are considered the same
+func WithThreshold(threshold float64) Option {
+	return func(m *Matcher) {
+		m.threshold = threshold
+	}
+}
+
+// WithReviewThreshold sets the score at or above which uncertain matches are queued for review
+func WithReviewThreshold(threshold float64) Option {
+	return func(m *Matcher) {
+		m.reviewThreshold = threshold
+	}
+}
+
+// New creates a matcher with the default thresholds
+func New(opts ...Option) *Matcher {
+	m := &Matcher{
+		threshold:       DefaultThreshold,
+		reviewThreshold: DefaultReviewThreshold,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Thresholds returns the match and review thresholds
+func (m *Matcher) Thresholds() (threshold, review float64) {
+	return m.threshold, m.reviewThreshold
+}
+
+// Match finds the owned book that best matches a series entry
+func (m *Matcher) Match(owned []Book, entry Book) Result {
+	best := Result{Index: -1, Verdict: Missing}
+	for i, book := range owned {
+		if reason := sharedIdentifier(book.Identifiers, entry.Identifiers); reason != "" {
+			return Result{Index: i, Score: 1, Reason: reason, Verdict: Matched}
+		}
+		if score := Score(book, entry); score > best.Score {
+			best = Result{Index: i, Score: score, Reason: "title"}
+		}
+	}
+
+	switch {
+	case best.Index < 0:
+	case best.Score >= m.threshold:
+		best.Verdict = Matched
+	case best.Score >= m.reviewThreshold:
+		best.Verdict = Review
+	default:
+		best.Verdict = Missing
+	}
+	return best
+}
+
+// Score rates how alike two books are from their titles, authors and series positions, from 0 to 1
+func Score(a, b Book) float64 {
+	return titleWeight*titleSimilarity(a.Title, b.Title) +
+		authorWeight*authorSimilarity(a.Authors, b.Authors) +
+		positionWeight*positionSimilarity(a.Position, b.Position)
+}
+
+// sharedIdentifier returns the name of an identifier both books have in common, or ""
+func sharedIdentifier(a, b metadata.Identifiers) string {
+	same := func(x, y string) bool {
+		return x != "" && x == y
+	}
+	switch {
+	case identifiers.SameGoodreadsID(a.GoodreadsID, b.GoodreadsID):
+		return "goodreads_id"
+	case identifiers.SameGoodreadsID(a.GoodreadsWorkID, b.GoodreadsWorkID):
+		return "goodreads_work_id"
+	case same(isbnKey(a.ISBN13, a.ISBN10), isbnKey(b.ISBN13, b.ISBN10)):
+		return "isbn"
+	case same(strings.ToUpper(a.ASIN), strings.ToUpper(b.ASIN)):
+		return "asin"
+	case same(strings.ToLower(a.HardcoverID), strings.ToLower(b.HardcoverID)):
+		return "hardcover_id"
+	case a.HardcoverBookID != 0 && a.HardcoverBookID == b.HardcoverBookID:
+		return "hardcover_book_id"
+	}
+	return ""
+}
+
+// isbnKey returns the ISBN-13 form of a book's ISBN, converting an ISBN-10 when that is all there is
+func isbnKey(isbn13, isbn10 string) string {
+	if isbn := cleanISBN(isbn13); len(isbn) == 13 {
+		return isbn
+	}
+	isbn := cleanISBN(isbn10)
+	if len(isbn) != 10 {
+		return ""
+	}
+
+	// 978 prefix plus the first nine digits, with a recomputed check digit
+	digits := "978" + isbn[:9]
+	sum := 0
+	for i, c := range digits {
+		d := int(c - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	return digits + strconv.Itoa((10-sum%10)%10)
+}
+
+func cleanISBN(isbn string) string {
+	var b strings.Builder
+	for _, c := range strings.ToUpper(isbn) {
+		if unicode.IsDigit(c) || c == 'X' {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+// seriesSuffix matches the "(Series Name, #2)" Goodreads appends to titles
+var seriesSuffix = regexp.MustCompile(`\s*\([^()]*#[^()]*\)\s*$`)
+
+var leadingArticles = []string{"the ", "a ", "an "}
+
+// NormalizeTitle lower-cases a title and strips series suffixes, punctuation and leading articles
+func NormalizeTitle(title string) string {
+	title = seriesSuffix.ReplaceAllString(title, "")
+	title = collapse(strings.ReplaceAll(title, "&", " and "), " ")
+	for _, article := range leadingArticles {
+		if rest, ok := strings.CutPrefix(title, article); ok && rest != "" {
+			return rest
+		}
+	}
+	return title
+}
+
+// titleSimilarity compares the full titles and the titles without subtitles, keeping the best
+func titleSimilarity(a, b string) float64 {
+	best := 0.0
+	for _, x := range titleVariants(a) {
+		for _, y := range titleVariants(b) {
+			best = max(best, similarity(x, y))
+		}
+	}
+	return best
+}
+
+func titleVariants(title string) []string {
+	variants := []string{NormalizeTitle(title)}
+	if main, _, ok := strings.Cut(seriesSuffix.ReplaceAllString(title, ""), ":"); ok {
+		variants = append(variants, NormalizeTitle(main))
+	}
+	return variants
+}
+
+// authorSimilarity scores the best pairing of two author lists. Unknown authors score 0.5.
+func authorSimilarity(a, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0.5
+	}
+	best := 0.0
+	for _, x := range a {
+		for _, y := range b {
+			best = max(best, similarity(normalizeAuthor(x), normalizeAuthor(y)))
+		}
+	}
+	return best
+}
+
+// normalizeAuthor turns "Grant, Andrew" and "J.R.R. Tolkien" into "andrewgrant" and "jrrtolkien"
+func normalizeAuthor(name string) string {
+	if last, first, ok := strings.Cut(name, ","); ok {
+		name = first + " " + last
+	}
+	return collapse(name, "")
+}
+
+// positionSimilarity is 1 for equal positions, 0 for different ones and 0.5 when either is unknown
+func positionSimilarity(a, b float64) float64 {
+	switch {
+	case a == 0 || b == 0:
+		return 0.5
+	case a == b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// collapse lower-cases s and joins its runs of letters and digits with sep
+func collapse(s, sep string) string {
+	fields := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(fields, sep)
+}
+
+// similarity is 1 minus the edit distance over the length of the longer string
+func similarity(a, b string) float64 {
+	if a == "" || b == "" {
+		return 0
+	}
+	ra, rb := []rune(a), []rune(b)
+	return 1 - float64(levenshtein(ra, rb))/float64(max(len(ra), len(rb)))
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
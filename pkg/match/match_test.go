@@ -0,0 +1,93 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+)
+
+func TestNormalizeTitle(t *testing.T) {
+	tests := map[string]string{
+		"Die Twice (David Trevellyan, #2)": "die twice",
+		"The Eye of the World":             "eye of the world",
+		"Don't Look Back!":                 "don t look back",
+		"Run & Hide":                       "run and hide",
+		"A":                                "a",
+	}
+	for in, want := range tests {
+		if got := NormalizeTitle(in); got != want {
+			t.Errorf("NormalizeTitle(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestMatcher_Match(t *testing.T) {
+	owned := []Book{
+		{Identifiers: metadata.Identifiers{GoodreadsID: "7315139-die-twice"}, Title: "Die Twice", Authors: []string{"Andrew Grant"}, Position: 2},
+		{Identifiers: metadata.Identifiers{ISBN10: "0312383061"}, Title: "Even", Authors: []string{"Andrew Grant"}},
+		{Title: "Die Trying: A Novel", Authors: []string{"Grant, Andrew"}, Position: 1},
+		{Title: "Run and Hide", Authors: []string{"Andrew Grant"}},
+	}
+
+	tests := []struct {
+		name        string
+		entry       Book
+		wantIndex   int
+		wantVerdict Verdict
+		wantReason  string
+	}{
+		{
+			name:        "slug goodreads ID",
+			entry:       Book{Identifiers: metadata.Identifiers{GoodreadsID: "7315139"}, Title: "Die Twice (David Trevellyan, #2)"},
+			wantIndex:   0,
+			wantVerdict: Matched,
+			wantReason:  "goodreads_id",
+		},
+		{
+			name:        "ISBN-13 against ISBN-10",
+			entry:       Book{Identifiers: metadata.Identifiers{ISBN13: "978-0-312-38306-0"}, Title: "Even"},
+			wantIndex:   1,
+			wantVerdict: Matched,
+			wantReason:  "isbn",
+		},
+		{
+			name:        "title author and position",
+			entry:       Book{Title: "Die Trying (David Trevellyan, #1)", Authors: []string{"Andrew Grant"}, Position: 1},
+			wantIndex:   2,
+			wantVerdict: Matched,
+			wantReason:  "title",
+		},
+		{
+			name:        "close title needs review",
+			entry:       Book{Title: "Running and Hiding", Authors: []string{"Andrew Grant"}},
+			wantIndex:   3,
+			wantVerdict: Review,
+			wantReason:  "title",
+		},
+		{
+			name:        "unrelated book",
+			entry:       Book{Title: "Invincible", Authors: []string{"Someone Else"}, Position: 4},
+			wantVerdict: Missing,
+		},
+	}
+
+	matcher := New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := matcher.Match(owned, tt.entry)
+			if got.Verdict != tt.wantVerdict {
+				t.Fatalf("Match() verdict = %s (score %.2f, index %d), want %s", got.Verdict, got.Score, got.Index, tt.wantVerdict)
+			}
+			if tt.wantVerdict == Missing {
+				return
+			}
+			if got.Index != tt.wantIndex || got.Reason != tt.wantReason {
+				t.Errorf("Match() = index %d reason %q, want index %d reason %q", got.Index, got.Reason, tt.wantIndex, tt.wantReason)
+			}
+		})
+	}
+
+	if got := matcher.Match(nil, Book{Title: "Anything"}); got.Index != -1 || got.Verdict != Missing {
+		t.Errorf("Match() with no owned books = %+v", got)
+	}
+}
@@ -0,0 +1,267 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/identifiers"
+)
+
+// Fallback tries each provider in order and returns the first non-empty answer
+type Fallback struct {
+	providers []Provider
+}
+
+// NewFallback creates a provider that asks the given providers in order
+func NewFallback(providers ...Provider) *Fallback {
+	return &Fallback{providers: providers}
+}
+
+// Name joins the names of the wrapped providers
+func (f *Fallback) Name() string {
+	return joinNames(f.providers, "|")
+}
+
+// SeriesBooks returns the books from the first provider that finds any
+func (f *Fallback) SeriesBooks(ctx context.Context, series SeriesQuery) ([]Book, error) {
+	var errs []error
+	for _, p := range f.providers {
+		books, err := p.SeriesBooks(ctx, series)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", p.Name(), err))
+			continue
+		}
+		if len(books) > 0 {
+			return books, nil
+		}
+	}
+	return nil, errors.Join(errs...)
+}
+
+// Book returns the first provider's match
+func (f *Fallback) Book(ctx context.Context, ids Identifiers) (*Book, error) {
+	var errs []error
+	for _, p := range f.providers {
+		book, err := p.Book(ctx, ids)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", p.Name(), err))
+			continue
+		}
+		if book != nil {
+			return book, nil
+		}
+	}
+	return nil, errors.Join(errs...)
+}
+
+// Search returns the results from the first provider that finds any
+func (f *Fallback) Search(ctx context.Context, query string) ([]Book, error) {
+	var errs []error
+	for _, p := range f.providers {
+		books, err := p.Search(ctx, query)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", p.Name(), err))
+			continue
+		}
+		if len(books) > 0 {
+			return books, nil
+		}
+	}
+	return nil, errors.Join(errs...)
+}
+
+// Merged asks every provider at once and combines their answers.
+// Earlier providers win when two disagree; later ones only fill in blanks.
+type Merged struct {
+	providers []Provider
+}
+
+// NewMerged creates a provider that merges the answers of all the given providers
+func NewMerged(providers ...Provider) *Merged {
+	return &Merged{providers: providers}
+}
+
+// Name joins the names of the wrapped providers
+func (m *Merged) Name() string {
+	return joinNames(m.providers, "+")
+}
+
+// SeriesBooks merges the series listings of every provider that answers
+func (m *Merged) SeriesBooks(ctx context.Context, series SeriesQuery) ([]Book, error) {
+	results, err := collect(m.providers, func(p Provider) ([]Book, error) {
+		return p.SeriesBooks(ctx, series)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return MergeBooks(results...), nil
+}
+
+// Book merges the matches of every provider that finds the book
+func (m *Merged) Book(ctx context.Context, ids Identifiers) (*Book, error) {
+	results, err := collect(m.providers, func(p Provider) ([]Book, error) {
+		book, err := p.Book(ctx, ids)
+		if err != nil || book == nil {
+			return nil, err
+		}
+		return []Book{*book}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var merged *Book
+	for _, books := range results {
+		for i := range books {
+			if merged == nil {
+				b := books[i]
+				merged = &b
+				continue
+			}
+			mergeInto(merged, books[i])
+		}
+	}
+	return merged, nil
+}
+
+// Search merges the search results of every provider
+func (m *Merged) Search(ctx context.Context, query string) ([]Book, error) {
+	results, err := collect(m.providers, func(p Provider) ([]Book, error) {
+		return p.Search(ctx, query)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return MergeBooks(results...), nil
+}
+
+// collect runs fn against every provider concurrently, keeping results in provider order.
+// It only fails when no provider answered.
+func collect(providers []Provider, fn func(Provider) ([]Book, error)) ([][]Book, error) {
+	results := make([][]Book, len(providers))
+	errs := make([]error, len(providers))
+
+	var wg sync.WaitGroup
+	for i, p := range providers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			books, err := fn(p)
+			if err != nil {
+				errs[i] = fmt.Errorf("%s: %w", p.Name(), err)
+				return
+			}
+			results[i] = books
+		}()
+	}
+	wg.Wait()
+
+	failed := 0
+	for _, err := range errs {
+		if err != nil {
+			failed++
+		}
+	}
+	if failed == len(providers) {
+		return nil, errors.Join(errs...)
+	}
+	return results, nil
+}
+
+// MergeBooks combines book lists, matching records that share an identifier or title.
+// The first list sets the order; unmatched books from later lists are appended.
+func MergeBooks(lists ...[]Book) []Book {
+	var merged []Book
+	for _, books := range lists {
+		for _, book := range books {
+			if i := findMatch(merged, book); i >= 0 {
+				mergeInto(&merged[i], book)
+				continue
+			}
+			merged = append(merged, book)
+		}
+	}
+	return merged
+}
+
+// findMatch returns the index of the book in books that describes the same book, or -1
+func findMatch(books []Book, book Book) int {
+	for i, candidate := range books {
+		if sameBook(candidate, book) {
+			return i
+		}
+	}
+	return -1
+}
+
+// sameBook reports whether two records describe the same book
+func sameBook(a, b Book) bool {
+	if identifiers.SameGoodreadsID(a.GoodreadsID, b.GoodreadsID) ||
+		identifiers.SameGoodreadsID(a.GoodreadsWorkID, b.GoodreadsWorkID) {
+		return true
+	}
+	pairs := [][2]string{
+		{a.HardcoverID, b.HardcoverID},
+		{a.ISBN13, b.ISBN13},
+		{a.ISBN10, b.ISBN10},
+		{a.ASIN, b.ASIN},
+	}
+	for _, pair := range pairs {
+		if pair[0] != "" && pair[0] == pair[1] {
+			return true
+		}
+	}
+	if a.HardcoverBookID != 0 && a.HardcoverBookID == b.HardcoverBookID {
+		return true
+	}
+	return a.Title != "" && strings.EqualFold(strings.TrimSpace(a.Title), strings.TrimSpace(b.Title)) &&
+		a.SeriesPosition == b.SeriesPosition
+}
+
+// mergeInto fills the blank fields of dst from src and records src's provenance
+func mergeInto(dst *Book, src Book) {
+	fill := func(field *string, value string) {
+		if *field == "" {
+			*field = value
+		}
+	}
+	fill(&dst.GoodreadsID, src.GoodreadsID)
+	fill(&dst.GoodreadsWorkID, src.GoodreadsWorkID)
+	fill(&dst.HardcoverID, src.HardcoverID)
+	if dst.HardcoverBookID == 0 {
+		dst.HardcoverBookID = src.HardcoverBookID
+	}
+	fill(&dst.GoogleID, src.GoogleID)
+	fill(&dst.ASIN, src.ASIN)
+	fill(&dst.ISBN10, src.ISBN10)
+	fill(&dst.ISBN13, src.ISBN13)
+	fill(&dst.Title, src.Title)
+	fill(&dst.Description, src.Description)
+	fill(&dst.SeriesName, src.SeriesName)
+	fill(&dst.SeriesPosition, src.SeriesPosition)
+	fill(&dst.PublicationDate, src.PublicationDate)
+	fill(&dst.ImageURL, src.ImageURL)
+	fill(&dst.URL, src.URL)
+	if len(dst.Authors) == 0 {
+		dst.Authors = src.Authors
+	}
+	dst.ToBePublished = dst.ToBePublished || src.ToBePublished
+
+	for _, source := range src.Sources {
+		if !slices.Contains(dst.Sources, source) {
+			dst.Sources = append(dst.Sources, source)
+		}
+	}
+}
+
+func joinNames(providers []Provider, sep string) string {
+	names := make([]string, len(providers))
+	for i, p := range providers {
+		names[i] = p.Name()
+	}
+	return strings.Join(names, sep)
+}
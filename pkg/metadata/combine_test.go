@@ -0,0 +1,130 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type fakeProvider struct {
+	name  string
+	books []Book
+	err   error
+}
+
+func (f *fakeProvider) Name() string { return f.name }
+
+func (f *fakeProvider) SeriesBooks(ctx context.Context, series SeriesQuery) ([]Book, error) {
+	return f.books, f.err
+}
+
+func (f *fakeProvider) Book(ctx context.Context, ids Identifiers) (*Book, error) {
+	if f.err != nil || len(f.books) == 0 {
+		return nil, f.err
+	}
+	return &f.books[0], nil
+}
+
+func (f *fakeProvider) Search(ctx context.Context, query string) ([]Book, error) {
+	return f.books, f.err
+}
+
+func TestMergeBooks(t *testing.T) {
+	primary := []Book{
+		{Identifiers: Identifiers{GoodreadsID: "1"}, Title: "One", SeriesPosition: "1", Sources: []string{"goodreads"}},
+		{Identifiers: Identifiers{GoodreadsID: "2"}, Title: "Two", SeriesPosition: "2", Sources: []string{"goodreads"}},
+	}
+	secondary := []Book{
+		{Identifiers: Identifiers{GoodreadsID: "1", ISBN13: "9780000000001"}, Title: "One (Different Title)", Sources: []string{"hardcover"}},
+		{Identifiers: Identifiers{HardcoverID: "99"}, Title: "two", SeriesPosition: "2", PublicationDate: "2027-01-01", Sources: []string{"hardcover"}},
+		{Identifiers: Identifiers{HardcoverID: "100"}, Title: "Three", SeriesPosition: "3", Sources: []string{"hardcover"}},
+	}
+
+	merged := MergeBooks(primary, secondary)
+	if len(merged) != 3 {
+		t.Fatalf("expected 3 books, got %d", len(merged))
+	}
+
+	if merged[0].Title != "One" {
+		t.Errorf("primary title should win, got %q", merged[0].Title)
+	}
+	if merged[0].ISBN13 != "9780000000001" {
+		t.Errorf("ISBN13 not filled in, got %q", merged[0].ISBN13)
+	}
+	if !reflect.DeepEqual(merged[0].Sources, []string{"goodreads", "hardcover"}) {
+		t.Errorf("Sources = %v", merged[0].Sources)
+	}
+	if merged[1].HardcoverID != "99" || merged[1].PublicationDate != "2027-01-01" {
+		t.Errorf("title match not merged: %+v", merged[1])
+	}
+	if merged[2].Title != "Three" {
+		t.Errorf("unmatched book not appended: %+v", merged[2])
+	}
+}
+
+func TestMergeBooksByWork(t *testing.T) {
+	hardcover := []Book{
+		{Identifiers: Identifiers{GoodreadsID: "7315139", GoodreadsWorkID: "7573428"}, Title: "Die Twice", Sources: []string{"hardcover"}},
+	}
+	goodreads := []Book{
+		{Identifiers: Identifiers{GoodreadsID: "18656030", GoodreadsWorkID: "7573428"}, Title: "Die Twice (Reissue)", Sources: []string{"goodreads"}},
+	}
+
+	merged := MergeBooks(hardcover, goodreads)
+	if len(merged) != 1 {
+		t.Fatalf("editions of the same work should merge, got %d books", len(merged))
+	}
+	if merged[0].GoodreadsID != "7315139" {
+		t.Errorf("first edition ID should win, got %q", merged[0].GoodreadsID)
+	}
+}
+
+func TestFallback(t *testing.T) {
+	failing := &fakeProvider{name: "a", err: ErrUnsupported}
+	empty := &fakeProvider{name: "b"}
+	answering := &fakeProvider{name: "c", books: []Book{{Title: "Found"}}}
+
+	books, err := NewFallback(failing, empty, answering).SeriesBooks(context.Background(), SeriesQuery{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(books) != 1 || books[0].Title != "Found" {
+		t.Errorf("unexpected books: %+v", books)
+	}
+
+	_, err = NewFallback(failing).SeriesBooks(context.Background(), SeriesQuery{})
+	if !errors.Is(err, ErrUnsupported) {
+		t.Errorf("expected ErrUnsupported, got %v", err)
+	}
+}
+
+func TestMerged(t *testing.T) {
+	boom := errors.New("boom")
+	a := &fakeProvider{name: "a", books: []Book{{Identifiers: Identifiers{GoodreadsID: "1"}, Title: "One", Sources: []string{"a"}}}}
+	b := &fakeProvider{name: "b", books: []Book{{Identifiers: Identifiers{GoodreadsID: "1", ASIN: "B0"}, Title: "One", Sources: []string{"b"}}}}
+	broken := &fakeProvider{name: "broken", err: boom}
+
+	m := NewMerged(a, broken, b)
+	if m.Name() != "a+broken+b" {
+		t.Errorf("Name() = %q", m.Name())
+	}
+
+	books, err := m.SeriesBooks(context.Background(), SeriesQuery{})
+	if err != nil {
+		t.Fatalf("one failing provider should not fail the merge: %v", err)
+	}
+	if len(books) != 1 || books[0].ASIN != "B0" {
+		t.Errorf("unexpected books: %+v", books)
+	}
+
+	book, err := m.Book(context.Background(), Identifiers{GoodreadsID: "1"})
+	if err != nil || book == nil || book.ASIN != "B0" {
+		t.Errorf("Book() = %+v, %v", book, err)
+	}
+
+	_, err = NewMerged(broken).SeriesBooks(context.Background(), SeriesQuery{})
+	if !errors.Is(err, boom) {
+		t.Errorf("expected boom, got %v", err)
+	}
+}
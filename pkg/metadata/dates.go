@@ -0,0 +1,41 @@
+package metadata
+
+import (
+	"strings"
+	"time"
+)
+
+// dateLayouts are the publication date formats providers use, most precise first
+var dateLayouts = []struct {
+	layout string
+	output string
+}{
+	{"2006-01-02", "2006-01-02"},
+	{"January 2, 2006", "2006-01-02"},
+	{"Jan 2, 2006", "2006-01-02"},
+	{"January 2 2006", "2006-01-02"},
+	{"Jan 2 2006", "2006-01-02"},
+	{"2 January 2006", "2006-01-02"},
+	{"01/02/2006", "2006-01-02"},
+	{"January 2006", "2006-01"},
+	{"Jan 2006", "2006-01"},
+	{"2006-01", "2006-01"},
+	{"2006", "2006"},
+}
+
+// NormalizeDate converts a publication date into a sortable ISO form:
+// "2006-01-02", "2006-01" or "2006" depending on how precise the source is.
+// It returns an empty string when the date can't be parsed.
+func NormalizeDate(raw string) string {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return ""
+	}
+
+	for _, candidate := range dateLayouts {
+		if t, err := time.Parse(candidate.layout, raw); err == nil {
+			return t.Format(candidate.output)
+		}
+	}
+	return ""
+}
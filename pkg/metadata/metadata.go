@@ -0,0 +1,68 @@
+// Package metadata defines a common interface over book metadata sources so series completion
+// isn't tied to a single scraper.
+package metadata
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrUnsupported is returned when a provider can't answer a kind of lookup
+var ErrUnsupported = errors.New("lookup not supported by provider")
+
+// Provider is a source of book and series metadata
+type Provider interface {
+	// Name identifies the provider in configuration and in per-book provenance
+	Name() string
+	// SeriesBooks returns the books in a series in reading order
+	SeriesBooks(ctx context.Context, series SeriesQuery) ([]Book, error)
+	// Book looks up a single book by any of its identifiers
+	Book(ctx context.Context, ids Identifiers) (*Book, error)
+	// Search finds books matching a free text query
+	Search(ctx context.Context, query string) ([]Book, error)
+}
+
+// SeriesQuery describes a series; providers use whichever fields they understand
+type SeriesQuery struct {
+	GoodreadsID string   `json:"goodreads_id,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Authors     []string `json:"authors,omitempty"`
+	// HardcoverBookIDs are Hardcover IDs of books in the series we already own
+	HardcoverBookIDs []int64 `json:"hardcover_book_ids,omitempty"`
+}
+
+// Identifiers are the external IDs a book can be looked up by
+type Identifiers struct {
+	GoodreadsID string `json:"goodreads_id,omitempty"`
+	// GoodreadsWorkID is shared by every edition of the same book
+	GoodreadsWorkID string `json:"goodreads_work_id,omitempty"`
+	// HardcoverID is the Hardcover slug, HardcoverBookID its numeric book ID
+	HardcoverID     string `json:"hardcover_id,omitempty"`
+	HardcoverBookID int64  `json:"hardcover_book_id,omitempty"`
+	GoogleID        string `json:"google_id,omitempty"`
+	ASIN            string `json:"asin,omitempty"`
+	ISBN10          string `json:"isbn10,omitempty"`
+	ISBN13          string `json:"isbn13,omitempty"`
+}
+
+// Author is a book author as reported by a provider
+type Author struct {
+	Name        string `json:"name"`
+	GoodreadsID string `json:"goodreads_id,omitempty"`
+}
+
+// Book is a provider-neutral view of a book
+type Book struct {
+	Identifiers
+	Title           string   `json:"title"`
+	Description     string   `json:"description,omitempty"`
+	Authors         []Author `json:"authors,omitempty"`
+	SeriesName      string   `json:"series_name,omitempty"`
+	SeriesPosition  string   `json:"series_position,omitempty"`
+	PublicationDate string   `json:"publication_date,omitempty"`
+	ToBePublished   bool     `json:"to_be_published"`
+	ImageURL        string   `json:"image_url,omitempty"`
+	URL             string   `json:"url,omitempty"`
+	// Sources lists the providers that contributed to this record, most authoritative first
+	Sources []string `json:"sources"`
+}
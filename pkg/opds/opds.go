@@ -0,0 +1,152 @@
+// Package opds writes OPDS 1.2 catalogs: Atom feeds that e-reader apps can browse.
+package opds
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Media types of catalog feeds, and of plain Atom feeds for feed readers
+const (
+	NavigationType  = "application/atom+xml;profile=opds-catalog;kind=navigation"
+	AcquisitionType = "application/atom+xml;profile=opds-catalog;kind=acquisition"
+	AtomType        = "application/atom+xml"
+)
+
+// Link relations used in catalogs
+const (
+	RelSelf        = "self"
+	RelStart       = "start"
+	RelUp          = "up"
+	RelNext        = "next"
+	RelPrevious    = "previous"
+	RelSubsection  = "subsection"
+	RelAlternate   = "alternate"
+	RelAcquisition = "http://opds-spec.org/acquisition"
+	RelImage       = "http://opds-spec.org/image"
+	RelThumbnail   = "http://opds-spec.org/image/thumbnail"
+)
+
+// Feed is a navigation or acquisition feed
+type Feed struct {
+	ID      string
+	Title   string
+	Updated time.Time
+	Links   []Link
+	Entries []Entry
+}
+
+// Entry is a navigation entry pointing at another feed, or a book
+type Entry struct {
+	ID      string
+	Title   string
+	Updated time.Time
+	Authors []string
+	Summary string
+	// Content is a short description for navigation entries
+	Content string
+	// Identifiers are URNs such as urn:isbn:9780735219342
+	Identifiers []string
+	Language    string
+	// Issued is the publication date as YYYY, YYYY-MM or YYYY-MM-DD
+	Issued     string
+	Categories []Category
+	Links      []Link
+}
+
+// Link is an Atom link
+type Link struct {
+	Rel   string `xml:"rel,attr,omitempty"`
+	Href  string `xml:"href,attr"`
+	Type  string `xml:"type,attr,omitempty"`
+	Title string `xml:"title,attr,omitempty"`
+}
+
+// Category is an Atom category
+type Category struct {
+	Term  string `xml:"term,attr"`
+	Label string `xml:"label,attr,omitempty"`
+}
+
+type xmlFeed struct {
+	XMLName   xml.Name   `xml:"feed"`
+	Xmlns     string     `xml:"xmlns,attr"`
+	XmlnsDC   string     `xml:"xmlns:dc,attr"`
+	XmlnsOPDS string     `xml:"xmlns:opds,attr"`
+	ID        string     `xml:"id"`
+	Title     string     `xml:"title"`
+	Updated   string     `xml:"updated"`
+	Links     []Link     `xml:"link"`
+	Entries   []xmlEntry `xml:"entry"`
+}
+
+type xmlEntry struct {
+	ID          string      `xml:"id"`
+	Title       string      `xml:"title"`
+	Updated     string      `xml:"updated"`
+	Authors     []xmlAuthor `xml:"author"`
+	Identifiers []string    `xml:"dc:identifier"`
+	Language    string      `xml:"dc:language,omitempty"`
+	Issued      string      `xml:"dc:issued,omitempty"`
+	Categories  []Category  `xml:"category"`
+	Summary     *xmlText    `xml:"summary"`
+	Content     *xmlText    `xml:"content"`
+	Links       []Link      `xml:"link"`
+}
+
+type xmlAuthor struct {
+	Name string `xml:"name"`
+}
+
+type xmlText struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// Write serializes the feed as an Atom document
+func (f Feed) Write(w io.Writer) error {
+	feed := xmlFeed{
+		Xmlns:     "http://www.w3.org/2005/Atom",
+		XmlnsDC:   "http://purl.org/dc/terms/",
+		XmlnsOPDS: "http://opds-spec.org/2010/catalog",
+		ID:        f.ID,
+		Title:     f.Title,
+		Updated:   f.Updated.UTC().Format(time.RFC3339),
+		Links:     f.Links,
+		Entries:   make([]xmlEntry, len(f.Entries)),
+	}
+	for i, entry := range f.Entries {
+		e := xmlEntry{
+			ID:          entry.ID,
+			Title:       entry.Title,
+			Updated:     entry.Updated.UTC().Format(time.RFC3339),
+			Identifiers: entry.Identifiers,
+			Language:    entry.Language,
+			Issued:      entry.Issued,
+			Categories:  entry.Categories,
+			Links:       entry.Links,
+		}
+		for _, author := range entry.Authors {
+			e.Authors = append(e.Authors, xmlAuthor{Name: author})
+		}
+		if entry.Summary != "" {
+			e.Summary = &xmlText{Type: "text", Value: entry.Summary}
+		}
+		if entry.Content != "" {
+			e.Content = &xmlText{Type: "text", Value: entry.Content}
+		}
+		feed.Entries[i] = e
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(feed); err != nil {
+		return fmt.Errorf("encoding feed: %w", err)
+	}
+	return enc.Close()
+}
@@ -0,0 +1,64 @@
+package opds
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFeed_Write(t *testing.T) {
+	updated := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	feed := Feed{
+		ID:      "urn:bookscraping:catalog",
+		Title:   "Library",
+		Updated: updated,
+		Links:   []Link{{Rel: RelSelf, Href: "/opds", Type: NavigationType}},
+		Entries: []Entry{{
+			ID:          "urn:bookscraping:book:1",
+			Title:       "Fire & Ice",
+			Updated:     updated,
+			Authors:     []string{"Andrew Grant"},
+			Summary:     "A <thriller>",
+			Identifiers: []string{"urn:isbn:9780735219342"},
+			Issued:      "2017-05",
+			Links:       []Link{{Rel: RelAcquisition, Href: "https://booklore.example/book/1", Type: "text/html"}},
+		}},
+	}
+
+	var b strings.Builder
+	if err := feed.Write(&b); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	out := b.String()
+
+	for _, want := range []string{
+		`<?xml version="1.0" encoding="UTF-8"?>`,
+		`<feed xmlns="http://www.w3.org/2005/Atom" xmlns:dc="http://purl.org/dc/terms/" xmlns:opds="http://opds-spec.org/2010/catalog">`,
+		`<updated>2026-10-18T12:00:00Z</updated>`,
+		`<title>Fire &amp; Ice</title>`,
+		`<summary type="text">A &lt;thriller&gt;</summary>`,
+		`<dc:identifier>urn:isbn:9780735219342</dc:identifier>`,
+		`<dc:issued>2017-05</dc:issued>`,
+		`<link rel="http://opds-spec.org/acquisition" href="https://booklore.example/book/1" type="text/html"></link>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("feed does not contain %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "<content") || strings.Contains(out, "<dc:language") {
+		t.Errorf("empty optional elements were written:\n%s", out)
+	}
+
+	var parsed struct {
+		Entries []struct {
+			Title string `xml:"title"`
+		} `xml:"entry"`
+	}
+	if err := xml.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("feed is not valid XML: %v", err)
+	}
+	if len(parsed.Entries) != 1 || parsed.Entries[0].Title != "Fire & Ice" {
+		t.Errorf("parsed entries = %+v", parsed.Entries)
+	}
+}
@@ -0,0 +1,106 @@
+// Package openlibrary is a client for the Open Library REST API (https://openlibrary.org/developers/api),
+// used to resolve ISBNs to works, editions, series hints and covers.
+package openlibrary
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const (
+	// DefaultBaseURL is the Open Library API root
+	DefaultBaseURL = "https://openlibrary.org"
+	// DefaultCoversURL is the Open Library covers service
+	DefaultCoversURL = "https://covers.openlibrary.org"
+
+	// Open Library asks API users to identify themselves
+	userAgent = "bookscraping (https://github.com/amalgamated-tools/bookscraping)"
+)
+
+// ErrNotFound is returned when Open Library has no record for an ISBN or key
+var ErrNotFound = errors.New("openlibrary: not found")
+
+// Client talks to the Open Library API
+type Client struct {
+	baseURL    string
+	coversURL  string
+	httpClient *http.Client
+}
+
+// ClientOption configures a Client
+type ClientOption func(*Client)
+
+// WithBaseURL points the client at a different API root
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		c.baseURL = baseURL
+	}
+}
+
+// WithCoversURL points cover URLs at a different covers service
+func WithCoversURL(coversURL string) ClientOption {
+	return func(c *Client) {
+		c.coversURL = coversURL
+	}
+}
+
+// NewClient creates an Open Library client
+func NewClient(opts ...ClientOption) *Client {
+	c := &Client{
+		baseURL:    DefaultBaseURL,
+		coversURL:  DefaultCoversURL,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// getJSON fetches a path below the API root and decodes the JSON response into out
+func (c *Client) getJSON(ctx context.Context, path string, out any) error {
+	url := c.baseURL + path
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("fetching %s: %w", path, err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			slog.Error("Failed to close response body", slog.Any("error", err))
+		}
+	}()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return fmt.Errorf("%w: %s", ErrNotFound, path)
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("openlibrary returned status %d for %s", resp.StatusCode, path)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading response: %w", err)
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("decoding %s: %w", path, err)
+	}
+	return nil
+}
+
+// CoverURL returns the URL of a cover image by cover ID; size is S, M or L
+func (c *Client) CoverURL(coverID int, size string) string {
+	return fmt.Sprintf("%s/b/id/%d-%s.jpg", c.coversURL, coverID, size)
+}
@@ -0,0 +1,153 @@
+package openlibrary
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+)
+
+// ISBNResult combines an edition with its work and authors
+type ISBNResult struct {
+	ISBN         string     `json:"isbn"`
+	ISBN10       string     `json:"isbn10,omitempty"`
+	ISBN13       string     `json:"isbn13,omitempty"`
+	EditionKey   string     `json:"edition_key"`
+	WorkKey      string     `json:"work_key,omitempty"`
+	Title        string     `json:"title"`
+	Authors      []string   `json:"authors,omitempty"`
+	Description  string     `json:"description,omitempty"`
+	PublishDate  string     `json:"publish_date,omitempty"`
+	Series       SeriesHint `json:"series"`
+	GoodreadsIDs []string   `json:"goodreads_ids,omitempty"`
+	CoverURL     string     `json:"cover_url,omitempty"`
+}
+
+// SeriesHint is a series name and position parsed from an edition's free text series field
+type SeriesHint struct {
+	Name     string `json:"name,omitempty"`
+	Position string `json:"position,omitempty"`
+}
+
+// GetEdition fetches the edition with the given ISBN-10 or ISBN-13
+func (c *Client) GetEdition(ctx context.Context, isbn string) (*Edition, error) {
+	var edition Edition
+	if err := c.getJSON(ctx, "/isbn/"+url.PathEscape(isbn)+".json", &edition); err != nil {
+		return nil, err
+	}
+	return &edition, nil
+}
+
+// GetWork fetches a work by key, e.g. /works/OL45804W
+func (c *Client) GetWork(ctx context.Context, key string) (*Work, error) {
+	var work Work
+	if err := c.getJSON(ctx, key+".json", &work); err != nil {
+		return nil, err
+	}
+	return &work, nil
+}
+
+// GetAuthor fetches an author by key, e.g. /authors/OL34184A
+func (c *Client) GetAuthor(ctx context.Context, key string) (*Author, error) {
+	var author Author
+	if err := c.getJSON(ctx, key+".json", &author); err != nil {
+		return nil, err
+	}
+	return &author, nil
+}
+
+// LookupISBN resolves an ISBN to its edition, work, authors, series hint and cover.
+// Only the edition is required; a missing work or author just leaves those fields empty.
+func (c *Client) LookupISBN(ctx context.Context, isbn string) (*ISBNResult, error) {
+	isbn = CleanISBN(isbn)
+	edition, err := c.GetEdition(ctx, isbn)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ISBNResult{
+		ISBN:         isbn,
+		EditionKey:   edition.Key,
+		Title:        edition.Title,
+		Description:  string(edition.Description),
+		PublishDate:  metadata.NormalizeDate(edition.PublishDate),
+		GoodreadsIDs: edition.Identifiers["goodreads"],
+	}
+	if len(edition.ISBN10) > 0 {
+		result.ISBN10 = edition.ISBN10[0]
+	}
+	if len(edition.ISBN13) > 0 {
+		result.ISBN13 = edition.ISBN13[0]
+	}
+	if len(edition.Series) > 0 {
+		result.Series = ParseSeriesHint(edition.Series[0])
+	}
+
+	covers := edition.Covers
+	authorRefs := edition.Authors
+
+	if len(edition.Works) > 0 {
+		result.WorkKey = edition.Works[0].Key
+		work, err := c.GetWork(ctx, result.WorkKey)
+		if err != nil {
+			slog.Warn("Failed to fetch Open Library work", slog.String("work", result.WorkKey), slog.Any("error", err))
+		} else {
+			if result.Description == "" {
+				result.Description = string(work.Description)
+			}
+			if len(covers) == 0 {
+				covers = work.Covers
+			}
+			if len(authorRefs) == 0 {
+				for _, a := range work.Authors {
+					authorRefs = append(authorRefs, a.Author)
+				}
+			}
+		}
+	}
+
+	for _, ref := range authorRefs {
+		author, err := c.GetAuthor(ctx, ref.Key)
+		if err != nil {
+			slog.Warn("Failed to fetch Open Library author", slog.String("author", ref.Key), slog.Any("error", err))
+			continue
+		}
+		result.Authors = append(result.Authors, author.Name)
+	}
+
+	// Open Library uses -1 for "no cover"
+	for _, cover := range covers {
+		if cover > 0 {
+			result.CoverURL = c.CoverURL(cover, "L")
+			break
+		}
+	}
+
+	return result, nil
+}
+
+var (
+	numberedSeriesPattern       = regexp.MustCompile(`(?i)^(.*?)[\s,;:]*(?:#|no\.?|number|book|vol\.?|volume)\s*(\d+(?:\.\d+)?)\s*$`)
+	parenthesisSeriesPattern    = regexp.MustCompile(`^(.*?)\s*\((\d+(?:\.\d+)?)\)\s*$`)
+	trailingNumberSeriesPattern = regexp.MustCompile(`^(.*?\D)[\s,;:]+(\d+(?:\.\d+)?)\s*$`)
+)
+
+// ParseSeriesHint splits an edition series field such as "David Trevellyan #2",
+// "Discworld, book 3" or "Discworld (3)" into a name and position
+func ParseSeriesHint(raw string) SeriesHint {
+	raw = strings.TrimSpace(raw)
+	for _, pattern := range []*regexp.Regexp{numberedSeriesPattern, parenthesisSeriesPattern, trailingNumberSeriesPattern} {
+		if m := pattern.FindStringSubmatch(raw); m != nil && strings.TrimSpace(m[1]) != "" {
+			return SeriesHint{Name: strings.TrimSpace(m[1]), Position: m[2]}
+		}
+	}
+	return SeriesHint{Name: raw}
+}
+
+// CleanISBN strips hyphens and spaces from an ISBN
+func CleanISBN(isbn string) string {
+	return strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(isbn))
+}
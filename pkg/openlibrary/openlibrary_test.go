@@ -0,0 +1,138 @@
+package openlibrary
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+)
+
+// newStubClient serves recorded API responses from fixtures, named after the request path
+func newStubClient(t *testing.T) *Client {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/"), ".json")
+		name = strings.ReplaceAll(name, "/", "_") + ".json"
+
+		body, err := os.ReadFile(filepath.Join("fixtures", name))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+
+	return NewClient(WithBaseURL(srv.URL), WithCoversURL("https://covers.example"))
+}
+
+func TestLookupISBN(t *testing.T) {
+	client := newStubClient(t)
+
+	result, err := client.LookupISBN(context.Background(), "978-0-312-38305-3")
+	if err != nil {
+		t.Fatalf("LookupISBN() error = %v", err)
+	}
+
+	if result.EditionKey != "/books/OL24380213M" || result.WorkKey != "/works/OL9830519W" {
+		t.Errorf("unexpected keys: %+v", result)
+	}
+	if result.Series != (SeriesHint{Name: "David Trevellyan", Position: "2"}) {
+		t.Errorf("unexpected series hint: %+v", result.Series)
+	}
+	if len(result.GoodreadsIDs) != 1 || result.GoodreadsIDs[0] != "7315139" {
+		t.Errorf("unexpected Goodreads IDs: %v", result.GoodreadsIDs)
+	}
+	if result.PublishDate != "2010-05-25" {
+		t.Errorf("PublishDate = %q", result.PublishDate)
+	}
+	if result.Description != "Royal Navy Intelligence agent David Trevellyan returns." {
+		t.Errorf("Description = %q", result.Description)
+	}
+	if len(result.Authors) != 1 || result.Authors[0] != "Andrew Grant" {
+		t.Errorf("Authors = %v", result.Authors)
+	}
+	if result.CoverURL != "https://covers.example/b/id/6619371-L.jpg" {
+		t.Errorf("CoverURL = %q", result.CoverURL)
+	}
+}
+
+func TestLookupISBNPartialRecord(t *testing.T) {
+	client := newStubClient(t)
+
+	// The work is missing from the stub and the edition has no cover
+	result, err := client.LookupISBN(context.Background(), "9780312383046")
+	if err != nil {
+		t.Fatalf("LookupISBN() error = %v", err)
+	}
+	if result.Title != "Even" || result.PublishDate != "2009" || result.CoverURL != "" {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if result.Series != (SeriesHint{Name: "David Trevellyan", Position: "1"}) {
+		t.Errorf("unexpected series hint: %+v", result.Series)
+	}
+
+	_, err = client.LookupISBN(context.Background(), "0000000000")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestParseSeriesHint(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected SeriesHint
+	}{
+		{"David Trevellyan #2", SeriesHint{Name: "David Trevellyan", Position: "2"}},
+		{"Discworld, book 3", SeriesHint{Name: "Discworld", Position: "3"}},
+		{"Discworld (3)", SeriesHint{Name: "Discworld", Position: "3"}},
+		{"The Expanse ; no. 4.5", SeriesHint{Name: "The Expanse", Position: "4.5"}},
+		{"Wheel of Time, vol. 10", SeriesHint{Name: "Wheel of Time", Position: "10"}},
+		{"Culture 2", SeriesHint{Name: "Culture", Position: "2"}},
+		{"Penguin classics", SeriesHint{Name: "Penguin classics"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := ParseSeriesHint(tt.input); got != tt.expected {
+				t.Errorf("ParseSeriesHint(%q) = %+v, want %+v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestProvider(t *testing.T) {
+	provider := NewProvider(newStubClient(t))
+
+	book, err := provider.Book(context.Background(), metadata.Identifiers{ISBN13: "9780312383053"})
+	if err != nil {
+		t.Fatalf("Book() error = %v", err)
+	}
+	if book.GoodreadsID != "7315139" || book.SeriesPosition != "2" || book.Sources[0] != ProviderName {
+		t.Errorf("unexpected book: %+v", book)
+	}
+
+	missing, err := provider.Book(context.Background(), metadata.Identifiers{ISBN10: "0000000000"})
+	if err != nil || missing != nil {
+		t.Errorf("expected no book for an unknown ISBN, got %+v, %v", missing, err)
+	}
+
+	books, err := provider.Search(context.Background(), "die twice")
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(books) != 1 || books[0].ISBN13 != "9780312383053" || books[0].PublicationDate != "2009" {
+		t.Errorf("unexpected results: %+v", books)
+	}
+
+	if _, err := provider.SeriesBooks(context.Background(), metadata.SeriesQuery{Name: "x"}); !errors.Is(err, metadata.ErrUnsupported) {
+		t.Errorf("expected ErrUnsupported, got %v", err)
+	}
+}
@@ -0,0 +1,115 @@
+package openlibrary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/identifiers"
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+)
+
+// ProviderName identifies Open Library in provider configuration and book provenance
+const ProviderName = "openlibrary"
+
+// Provider adapts the client to the metadata.Provider interface
+type Provider struct {
+	client *Client
+}
+
+var _ metadata.Provider = (*Provider)(nil)
+
+// NewProvider wraps a client as a metadata provider
+func NewProvider(client *Client) *Provider {
+	return &Provider{client: client}
+}
+
+// Name returns "openlibrary"
+func (p *Provider) Name() string {
+	return ProviderName
+}
+
+// SeriesBooks is unsupported; Open Library only has free text series hints on editions
+func (p *Provider) SeriesBooks(ctx context.Context, series metadata.SeriesQuery) ([]metadata.Book, error) {
+	return nil, fmt.Errorf("%w: Open Library has no series listings", metadata.ErrUnsupported)
+}
+
+// Book looks a book up by ISBN
+func (p *Provider) Book(ctx context.Context, ids metadata.Identifiers) (*metadata.Book, error) {
+	isbn := ids.ISBN13
+	if isbn == "" {
+		isbn = ids.ISBN10
+	}
+	if isbn == "" {
+		return nil, fmt.Errorf("%w: Open Library lookup needs an ISBN", metadata.ErrUnsupported)
+	}
+
+	result, err := p.client.LookupISBN(ctx, isbn)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	book := metadata.Book{
+		Identifiers: metadata.Identifiers{
+			ISBN10: result.ISBN10,
+			ISBN13: result.ISBN13,
+		},
+		Title:           result.Title,
+		Description:     result.Description,
+		SeriesName:      result.Series.Name,
+		SeriesPosition:  result.Series.Position,
+		PublicationDate: result.PublishDate,
+		ImageURL:        result.CoverURL,
+		URL:             p.client.baseURL + result.EditionKey,
+		Sources:         []string{ProviderName},
+	}
+	if len(result.GoodreadsIDs) > 0 {
+		book.GoodreadsID = identifiers.GoodreadsID(result.GoodreadsIDs[0])
+	}
+	for _, name := range result.Authors {
+		book.Authors = append(book.Authors, metadata.Author{Name: name})
+	}
+	return &book, nil
+}
+
+// Search uses the Open Library search API
+func (p *Provider) Search(ctx context.Context, query string) ([]metadata.Book, error) {
+	results, err := p.client.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	books := make([]metadata.Book, len(results))
+	for i, r := range results {
+		book := metadata.Book{
+			Title:   r.Title,
+			URL:     p.client.baseURL + r.Key,
+			Sources: []string{ProviderName},
+		}
+		if r.FirstPublishYear != 0 {
+			book.PublicationDate = strconv.Itoa(r.FirstPublishYear)
+		}
+		if r.CoverID > 0 {
+			book.ImageURL = p.client.CoverURL(r.CoverID, "L")
+		}
+		if len(r.GoodreadsIDs) > 0 {
+			book.GoodreadsID = identifiers.GoodreadsID(r.GoodreadsIDs[0])
+		}
+		for _, isbn := range r.ISBNs {
+			if len(isbn) == 13 && book.ISBN13 == "" {
+				book.ISBN13 = isbn
+			} else if len(isbn) == 10 && book.ISBN10 == "" {
+				book.ISBN10 = isbn
+			}
+		}
+		for _, name := range r.AuthorNames {
+			book.Authors = append(book.Authors, metadata.Author{Name: name})
+		}
+		books[i] = book
+	}
+	return books, nil
+}
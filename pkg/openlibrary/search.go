@@ -0,0 +1,36 @@
+package openlibrary
+
+import (
+	"context"
+	"net/url"
+)
+
+// searchFields limits search responses to what SearchResult uses
+const searchFields = "key,title,author_name,first_publish_year,isbn,cover_i,id_goodreads"
+
+// SearchResult is a work matching a search
+type SearchResult struct {
+	Key              string   `json:"key"`
+	Title            string   `json:"title"`
+	AuthorNames      []string `json:"author_name"`
+	FirstPublishYear int      `json:"first_publish_year"`
+	ISBNs            []string `json:"isbn"`
+	CoverID          int      `json:"cover_i"`
+	GoodreadsIDs     []string `json:"id_goodreads"`
+}
+
+// Search runs a free text search over works
+func (c *Client) Search(ctx context.Context, query string) ([]SearchResult, error) {
+	params := url.Values{}
+	params.Set("q", query)
+	params.Set("limit", "10")
+	params.Set("fields", searchFields)
+
+	var data struct {
+		Docs []SearchResult `json:"docs"`
+	}
+	if err := c.getJSON(ctx, "/search.json?"+params.Encode(), &data); err != nil {
+		return nil, err
+	}
+	return data.Docs, nil
+}
@@ -0,0 +1,65 @@
+package openlibrary
+
+import "encoding/json"
+
+// Edition is an Open Library edition record, as returned for an ISBN
+type Edition struct {
+	Key         string              `json:"key"`
+	Title       string              `json:"title"`
+	Subtitle    string              `json:"subtitle"`
+	Works       []Ref               `json:"works"`
+	Authors     []Ref               `json:"authors"`
+	ISBN10      []string            `json:"isbn_10"`
+	ISBN13      []string            `json:"isbn_13"`
+	PublishDate string              `json:"publish_date"`
+	Series      []string            `json:"series"`
+	Identifiers map[string][]string `json:"identifiers"`
+	Covers      []int               `json:"covers"`
+	Description Text                `json:"description"`
+}
+
+// Work is an Open Library work, which groups the editions of a book
+type Work struct {
+	Key         string       `json:"key"`
+	Title       string       `json:"title"`
+	Description Text         `json:"description"`
+	Covers      []int        `json:"covers"`
+	Authors     []WorkAuthor `json:"authors"`
+}
+
+// WorkAuthor links a work to an author
+type WorkAuthor struct {
+	Author Ref `json:"author"`
+}
+
+// Author is an Open Library author record
+type Author struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+// Ref is a link to another record by key, such as /works/OL45804W
+type Ref struct {
+	Key string `json:"key"`
+}
+
+// Text is a text field that Open Library returns either as a string or as {"type": ..., "value": ...}
+type Text string
+
+// UnmarshalJSON accepts both text representations
+func (t *Text) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*t = Text(s)
+		return nil
+	}
+
+	var typed struct {
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(data, &typed); err != nil {
+		return err
+	}
+	*t = Text(typed.Value)
+	return nil
+}
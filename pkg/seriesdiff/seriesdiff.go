@@ -0,0 +1,116 @@
+// Package seriesdiff compares two fetches of the same series to find newly announced books,
+// books that moved to a different position and books that were dropped from the series.
+package seriesdiff
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/identifiers"
+	"github.com/amalgamated-tools/bookscraping/pkg/match"
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+)
+
+// Kind is the kind of change between two fetches of a series
+type Kind string
+
+const (
+	// Added means the entry is new in the series
+	Added Kind = "added"
+	// PositionChanged means the entry moved to a different series position
+	PositionChanged Kind = "position_changed"
+	// Removed means the entry is no longer listed in the series
+	Removed Kind = "removed"
+)
+
+// Entry is what a snapshot keeps of one book in a series
+type Entry struct {
+	Title           string `json:"title"`
+	Position        string `json:"position,omitempty"`
+	GoodreadsID     string `json:"goodreads_id,omitempty"`
+	GoodreadsWorkID string `json:"goodreads_work_id,omitempty"`
+	HardcoverBookID int64  `json:"hardcover_book_id,omitempty"`
+	PublicationDate string `json:"publication_date,omitempty"`
+}
+
+// Change is a difference between two fetches of a series
+type Change struct {
+	Kind  Kind
+	Entry Entry
+	// OldPosition is the position in the earlier fetch, for moved and removed entries
+	OldPosition string
+}
+
+// Entries builds snapshot entries from a provider's series listing
+func Entries(books []metadata.Book) []Entry {
+	entries := make([]Entry, len(books))
+	for i, book := range books {
+		entries[i] = Entry{
+			Title:           book.Title,
+			Position:        strings.TrimSpace(book.SeriesPosition),
+			GoodreadsID:     identifiers.GoodreadsID(book.GoodreadsID),
+			GoodreadsWorkID: identifiers.GoodreadsID(book.GoodreadsWorkID),
+			HardcoverBookID: book.HardcoverBookID,
+			PublicationDate: book.PublicationDate,
+		}
+	}
+	return entries
+}
+
+// Diff lists the changes from prev to curr: additions and moves in curr's order, then removals in prev's order
+func Diff(prev, curr []Entry) []Change {
+	var changes []Change
+	seen := make([]bool, len(prev))
+
+	for _, entry := range curr {
+		i := find(prev, seen, entry)
+		if i < 0 {
+			changes = append(changes, Change{Kind: Added, Entry: entry})
+			continue
+		}
+		seen[i] = true
+		if !samePosition(prev[i].Position, entry.Position) {
+			changes = append(changes, Change{Kind: PositionChanged, Entry: entry, OldPosition: prev[i].Position})
+		}
+	}
+
+	for i, entry := range prev {
+		if !seen[i] {
+			changes = append(changes, Change{Kind: Removed, Entry: entry, OldPosition: entry.Position})
+		}
+	}
+	return changes
+}
+
+// find returns the index of the first unclaimed entry in entries that is the same book as entry, or -1
+func find(entries []Entry, claimed []bool, entry Entry) int {
+	for i, other := range entries {
+		if !claimed[i] && same(other, entry) {
+			return i
+		}
+	}
+	return -1
+}
+
+// same compares entries by the most stable identifier both have, falling back to the title
+func same(a, b Entry) bool {
+	switch {
+	case a.GoodreadsWorkID != "" && b.GoodreadsWorkID != "":
+		return a.GoodreadsWorkID == b.GoodreadsWorkID
+	case a.GoodreadsID != "" && b.GoodreadsID != "":
+		return a.GoodreadsID == b.GoodreadsID
+	case a.HardcoverBookID != 0 && b.HardcoverBookID != 0:
+		return a.HardcoverBookID == b.HardcoverBookID
+	}
+	return match.NormalizeTitle(a.Title) == match.NormalizeTitle(b.Title)
+}
+
+// samePosition compares positions numerically when it can, so "1" and "1.0" are the same
+func samePosition(a, b string) bool {
+	x, errA := strconv.ParseFloat(a, 64)
+	y, errB := strconv.ParseFloat(b, 64)
+	if errA == nil && errB == nil {
+		return x == y
+	}
+	return a == b
+}
@@ -0,0 +1,65 @@
+package seriesdiff
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	dieTwice := Entry{Title: "Die Twice", Position: "2", GoodreadsID: "7315139", GoodreadsWorkID: "7573428"}
+	runAndHide := Entry{Title: "Run and Hide", Position: "3", GoodreadsID: "555"}
+
+	tests := []struct {
+		name string
+		prev []Entry
+		curr []Entry
+		want []Change
+	}{
+		{
+			name: "no changes",
+			prev: []Entry{dieTwice, runAndHide},
+			curr: []Entry{dieTwice, runAndHide},
+		},
+		{
+			name: "first snapshot adds everything",
+			curr: []Entry{dieTwice},
+			want: []Change{{Kind: Added, Entry: dieTwice}},
+		},
+		{
+			name: "new book announced",
+			prev: []Entry{dieTwice},
+			curr: []Entry{dieTwice, {Title: "Invincible", Position: "4", GoodreadsID: "777"}},
+			want: []Change{{Kind: Added, Entry: Entry{Title: "Invincible", Position: "4", GoodreadsID: "777"}}},
+		},
+		{
+			name: "different edition of the same work is not a change",
+			prev: []Entry{dieTwice},
+			curr: []Entry{{Title: "Die Twice (Reissue)", Position: "2.0", GoodreadsID: "18656030", GoodreadsWorkID: "7573428"}},
+		},
+		{
+			name: "position changed",
+			prev: []Entry{runAndHide},
+			curr: []Entry{{Title: "Run and Hide", Position: "2.5", GoodreadsID: "555"}},
+			want: []Change{{Kind: PositionChanged, Entry: Entry{Title: "Run and Hide", Position: "2.5", GoodreadsID: "555"}, OldPosition: "3"}},
+		},
+		{
+			name: "entry removed",
+			prev: []Entry{dieTwice, runAndHide},
+			curr: []Entry{dieTwice},
+			want: []Change{{Kind: Removed, Entry: runAndHide, OldPosition: "3"}},
+		},
+		{
+			name: "entries without identifiers compare by title",
+			prev: []Entry{{Title: "The Untitled Book", Position: "5"}},
+			curr: []Entry{{Title: "Untitled Book (Series, #5)", Position: "5"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Diff(tt.prev, tt.curr); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
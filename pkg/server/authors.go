@@ -0,0 +1,133 @@
+package server
+
+import (
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+)
+
+// AuthorWithStats is an author with how many of their books we own and miss
+type AuthorWithStats struct {
+	*db.Author
+	SeriesCount  int64 `json:"series_count"`
+	OwnedBooks   int64 `json:"owned_books"`
+	MissingBooks int64 `json:"missing_books"`
+}
+
+// AuthorDetail is an author with their series and books, split into owned and missing
+type AuthorDetail struct {
+	*db.Author
+	Series       []db.GetSeriesForAuthorRow `json:"series"`
+	OwnedBooks   []db.Book                  `json:"owned_books"`
+	MissingBooks []db.Book                  `json:"missing_books"`
+}
+
+// handleListAuthors lists authors by name with their series and book counts
+func (s *Server) handleListAuthors(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	page, perPage := getPagination(r)
+
+	rows, err := s.queries.ListAuthorsWithBookStats(ctx, db.ListAuthorsWithBookStatsParams{
+		Limit:  int64(perPage),
+		Offset: int64((page - 1) * perPage),
+	})
+	if err != nil {
+		slog.Error("Failed to list authors", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to list authors")
+		return
+	}
+
+	total, err := s.queries.CountAuthors(ctx)
+	if err != nil {
+		slog.Error("Failed to count authors", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to count authors")
+		return
+	}
+
+	authors := make([]AuthorWithStats, len(rows))
+	for i, row := range rows {
+		authors[i] = AuthorWithStats{
+			Author: &db.Author{
+				ID:          row.ID,
+				Name:        row.Name,
+				GoodreadsID: row.GoodreadsID,
+			},
+			SeriesCount:  row.SeriesCount,
+			OwnedBooks:   row.OwnedBooks,
+			MissingBooks: row.MissingBooks,
+		}
+	}
+
+	writeJSON(w, PaginatedResponse{
+		Data:    authors,
+		Total:   total,
+		Page:    page,
+		PerPage: perPage,
+	})
+}
+
+// handleGetAuthor returns an author with their series, owned books and missing books
+func (s *Server) handleGetAuthor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid author ID")
+		return
+	}
+
+	format, err := descriptionFormat(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	author, err := s.queries.GetAuthor(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		writeError(w, http.StatusNotFound, "Author not found")
+		return
+	}
+	if err != nil {
+		slog.Error("Failed to get author", slog.Int64("author_id", id), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to get author")
+		return
+	}
+
+	series, err := s.queries.GetSeriesForAuthor(ctx, id)
+	if err != nil {
+		slog.Error("Failed to get series for author", slog.Int64("author_id", id), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to get series for author")
+		return
+	}
+
+	books, err := s.queries.GetBooksByAuthor(ctx, id)
+	if err != nil {
+		slog.Error("Failed to get books for author", slog.Int64("author_id", id), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to get books for author")
+		return
+	}
+	applyDescriptionFormat(books, format)
+
+	detail := AuthorDetail{
+		Author:       &author,
+		Series:       make([]db.GetSeriesForAuthorRow, 0, len(series)),
+		OwnedBooks:   []db.Book{},
+		MissingBooks: []db.Book{},
+	}
+	detail.Series = append(detail.Series, series...)
+	for _, book := range books {
+		// The raw Booklore data is only served with the single book
+		book.Data = nil
+		if book.IsMissing != nil && *book.IsMissing {
+			detail.MissingBooks = append(detail.MissingBooks, book)
+		} else {
+			detail.OwnedBooks = append(detail.OwnedBooks, book)
+		}
+	}
+
+	writeJSON(w, detail)
+}
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServer_handleGetAuthor(t *testing.T) {
+	boolPtr := func(b bool) *bool { return &b }
+
+	t.Run("series and books", func(t *testing.T) {
+		mockQuerier := db.NewMockQuerier(t)
+		mockQuerier.On("GetAuthor", mock.Anything, int64(5)).Return(db.Author{ID: 5, Name: "Andrew Grant"}, nil).Once()
+		mockQuerier.On("GetSeriesForAuthor", mock.Anything, int64(5)).Return([]db.GetSeriesForAuthorRow{
+			{ID: 3, SeriesID: 49126, Name: "David Trevellyan", TotalBooks: 3, MissingBooks: 1},
+		}, nil).Once()
+		mockQuerier.On("GetBooksByAuthor", mock.Anything, int64(5)).Return([]db.Book{
+			{ID: 1, Title: "Die Twice", Data: []byte(`{}`)},
+			{ID: 2, Title: "Run and Hide", IsMissing: boolPtr(false)},
+			{ID: 3, Title: "Invincible", IsMissing: boolPtr(true)},
+		}, nil).Once()
+
+		server := &Server{queries: mockQuerier}
+		req := httptest.NewRequest("GET", "/api/authors/5", nil)
+		req.SetPathValue("id", "5")
+		w := httptest.NewRecorder()
+		server.handleGetAuthor(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("status = %d, body = %s", w.Code, w.Body)
+		}
+		var detail AuthorDetail
+		if err := json.Unmarshal(w.Body.Bytes(), &detail); err != nil {
+			t.Fatalf("decoding response: %v", err)
+		}
+		if detail.Name != "Andrew Grant" || len(detail.Series) != 1 {
+			t.Errorf("unexpected author %+v", detail)
+		}
+		if len(detail.OwnedBooks) != 2 || len(detail.MissingBooks) != 1 || detail.MissingBooks[0].Title != "Invincible" {
+			t.Errorf("owned = %+v, missing = %+v", detail.OwnedBooks, detail.MissingBooks)
+		}
+		if detail.OwnedBooks[0].Data != nil {
+			t.Errorf("expected raw data to be left out, got %v", detail.OwnedBooks[0].Data)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockQuerier := db.NewMockQuerier(t)
+		mockQuerier.On("GetAuthor", mock.Anything, int64(9)).Return(db.Author{}, sql.ErrNoRows).Once()
+
+		server := &Server{queries: mockQuerier}
+		req := httptest.NewRequest("GET", "/api/authors/9", nil)
+		req.SetPathValue("id", "9")
+		w := httptest.NewRecorder()
+		server.handleGetAuthor(w, req)
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("status = %d, want %d", w.Code, http.StatusNotFound)
+		}
+	})
+}
@@ -0,0 +1,222 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+)
+
+// maxRestoreBytes bounds the size of an uploaded snapshot
+const maxRestoreBytes = 1 << 30
+
+// backupStore snapshots and restores the database
+type backupStore interface {
+	// Backup writes a consistent snapshot of the database to a new file at path
+	Backup(ctx context.Context, path string) error
+	// Restore replaces the database with the snapshot at path
+	Restore(ctx context.Context, path string) error
+}
+
+// RestoreResponse reports a restore and where the database it replaced was saved
+type RestoreResponse struct {
+	Status string `json:"status"`
+	// PreviousBackup is the backup of the database taken before restoring
+	PreviousBackup string `json:"previous_backup"`
+}
+
+// handleBackup streams a consistent snapshot of the database as a SQLite file
+func (s *Server) handleBackup(w http.ResponseWriter, r *http.Request) {
+	if s.backups == nil {
+		writeError(w, http.StatusNotImplemented, "Backups are not supported by this database")
+		return
+	}
+	extendDeadlines(w)
+
+	dir, err := os.MkdirTemp("", "bookscraping-backup-")
+	if err != nil {
+		slog.Error("Failed to create backup directory", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to back up database")
+		return
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			slog.Error("Failed to remove backup directory", slog.String("path", dir), slog.Any("error", err))
+		}
+	}()
+
+	name := db.BackupFileName(time.Now())
+	path := filepath.Join(dir, name)
+	if err := s.backups.Backup(r.Context(), path); err != nil {
+		slog.Error("Failed to back up database", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to back up database")
+		return
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		slog.Error("Failed to open backup", slog.String("path", path), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to back up database")
+		return
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			slog.Error("Failed to close backup", slog.String("path", path), slog.Any("error", err))
+		}
+	}()
+
+	w.Header().Set("Content-Type", "application/vnd.sqlite3")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+	if info, err := file.Stat(); err == nil {
+		w.Header().Set("Content-Length", fmt.Sprint(info.Size()))
+	}
+	if _, err := io.Copy(w, file); err != nil {
+		slog.Error("Failed to send backup", slog.Any("error", err))
+	}
+}
+
+// extendDeadlines lifts the server's read and write timeouts to HTTPTransferTimeout for this request,
+// so a large upload or download isn't cut off part way
+func extendDeadlines(w http.ResponseWriter) {
+	rc := http.NewResponseController(w)
+	deadline := time.Now().Add(HTTPTransferTimeout)
+	if err := rc.SetReadDeadline(deadline); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		slog.Warn("Failed to extend read deadline", slog.Any("error", err))
+	}
+	if err := rc.SetWriteDeadline(deadline); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		slog.Warn("Failed to extend write deadline", slog.Any("error", err))
+	}
+}
+
+// handleRestore replaces the database with the SQLite snapshot in the request body.
+// The snapshot is validated first, and the current database is backed up to the backup directory.
+func (s *Server) handleRestore(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if s.backups == nil {
+		writeError(w, http.StatusNotImplemented, "Backups are not supported by this database")
+		return
+	}
+	// The upload and the restore have to fit in the deadlines, or the client never hears how it went
+	extendDeadlines(w)
+
+	upload, err := os.CreateTemp("", "bookscraping-restore-*.db")
+	if err != nil {
+		slog.Error("Failed to create file for uploaded snapshot", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to restore database")
+		return
+	}
+	defer func() {
+		if err := os.Remove(upload.Name()); err != nil {
+			slog.Error("Failed to remove uploaded snapshot", slog.String("path", upload.Name()), slog.Any("error", err))
+		}
+	}()
+
+	_, err = io.Copy(upload, http.MaxBytesReader(w, r.Body, maxRestoreBytes))
+	if closeErr := upload.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, "Snapshot is too large")
+			return
+		}
+		slog.Error("Failed to receive uploaded snapshot", slog.Any("error", err))
+		writeError(w, http.StatusBadRequest, "Failed to read uploaded snapshot")
+		return
+	}
+
+	// Refuse a bad upload before touching the current database
+	if err := db.ValidateSnapshot(ctx, upload.Name()); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Background work would otherwise keep writing row IDs and statuses that don't match the restored data
+	resume := s.pauseBackgroundWork()
+	defer resume()
+
+	previous, err := s.backupToDir(ctx)
+	if err != nil {
+		slog.Error("Failed to back up database before restoring", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to back up the current database, nothing was restored")
+		return
+	}
+
+	if err := s.backups.Restore(ctx, upload.Name()); err != nil {
+		slog.Error("Failed to restore database", slog.String("previous_backup", previous), slog.Any("error", err))
+		if errors.Is(err, db.ErrInvalidSnapshot) {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "Failed to restore database")
+		return
+	}
+
+	slog.Info("Restored database", slog.String("previous_backup", previous))
+	writeJSON(w, RestoreResponse{
+		Status:         "restored",
+		PreviousBackup: previous,
+	})
+}
+
+// runScheduledBackups backs the database up to the backup directory every backupInterval
+func (s *Server) runScheduledBackups(ctx context.Context) {
+	slog.Info("Backing up the database on a schedule",
+		slog.String("dir", s.backupDir),
+		slog.Duration("interval", s.backupInterval),
+		slog.Int("retention", s.backupRetention),
+	)
+
+	ticker := time.NewTicker(s.backupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		s.maintenance.RLock()
+		path, err := s.backupToDir(ctx)
+		s.maintenance.RUnlock()
+		if err != nil {
+			slog.Error("Scheduled backup failed", slog.Any("error", err))
+			continue
+		}
+		slog.Info("Backed up database", slog.String("path", path))
+	}
+}
+
+// backupToDir writes a backup into the backup directory, then removes the oldest beyond the retention count
+func (s *Server) backupToDir(ctx context.Context) (string, error) {
+	if s.backupDir == "" {
+		return "", errors.New("no backup directory configured")
+	}
+	if err := os.MkdirAll(s.backupDir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create backup directory %s: %w", s.backupDir, err)
+	}
+
+	path := filepath.Join(s.backupDir, db.BackupFileName(time.Now()))
+	if err := s.backups.Backup(ctx, path); err != nil {
+		return "", err
+	}
+
+	if s.backupRetention > 0 {
+		removed, err := db.PruneBackups(s.backupDir, s.backupRetention)
+		if err != nil {
+			slog.Error("Failed to remove old backups", slog.Any("error", err))
+		}
+		for _, old := range removed {
+			slog.Debug("Removed old backup", slog.String("path", old))
+		}
+	}
+	return path, nil
+}
@@ -0,0 +1,211 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/match"
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+	"github.com/stretchr/testify/mock"
+)
+
+// blockingProvider holds every series lookup until the run is cancelled
+type blockingProvider struct {
+	seriesProvider
+	started chan struct{}
+}
+
+func (p *blockingProvider) SeriesBooks(ctx context.Context, _ metadata.SeriesQuery) ([]metadata.Book, error) {
+	close(p.started)
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+// writeSnapshot creates an empty database that passes as a snapshot without any migrations
+func writeSnapshot(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "snapshot.db")
+	snapshot, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := snapshot.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	if _, err := snapshot.Exec("CREATE TABLE schema_migrations (version VARCHAR(128) PRIMARY KEY)"); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// fakeBackups writes a fixed body as the backup and records restores
+type fakeBackups struct {
+	restored []string
+	// onRestore, when set, is called as a restore happens
+	onRestore func()
+}
+
+func (f *fakeBackups) Backup(_ context.Context, path string) error {
+	return os.WriteFile(path, []byte("snapshot"), 0o600)
+}
+
+func (f *fakeBackups) Restore(_ context.Context, path string) error {
+	f.restored = append(f.restored, path)
+	if f.onRestore != nil {
+		f.onRestore()
+	}
+	return nil
+}
+
+func TestServer_handleBackup(t *testing.T) {
+	server := &Server{backups: &fakeBackups{}}
+	w := httptest.NewRecorder()
+	server.handleBackup(w, httptest.NewRequest("GET", "/api/admin/backup", nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body)
+	}
+	if got := w.Header().Get("Content-Type"); got != "application/vnd.sqlite3" {
+		t.Errorf("Content-Type = %q", got)
+	}
+	if got := w.Header().Get("Content-Disposition"); !strings.HasPrefix(got, `attachment; filename="bookscraping-`) {
+		t.Errorf("Content-Disposition = %q", got)
+	}
+	if w.Body.String() != "snapshot" {
+		t.Errorf("body = %q", w.Body)
+	}
+}
+
+func TestServer_handleRestore_invalidSnapshot(t *testing.T) {
+	backups := &fakeBackups{}
+	dir := t.TempDir()
+	server := &Server{backups: backups, backupDir: dir}
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/api/admin/restore", strings.NewReader("definitely not a database, just some text that is long enough"))
+	server.handleRestore(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+	if len(backups.restored) != 0 {
+		t.Errorf("restored %v from an invalid snapshot", backups.restored)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("took a backup before rejecting the snapshot: %v", entries)
+	}
+}
+
+func TestServer_handleRestore_pausesCompletionRuns(t *testing.T) {
+	// Snapshots are checked against the migrations in db/migrations
+	t.Chdir("../..")
+
+	mockQuerier := db.NewMockQuerier(t)
+	mockQuerier.On("GetSeries", mock.Anything, int64(1)).Return(db.Series{ID: 1, SeriesID: 100}, nil)
+	mockQuerier.On("GetBooksBySeries", mock.Anything, mock.Anything).Return([]db.Book{}, nil)
+	mockQuerier.On("GetSeriesAuthors", mock.Anything, int64(1)).Return([]db.Author{}, nil)
+
+	// The run is stopped and has stored how it ended before the database is swapped
+	var finished bool
+	mockQuerier.On("FinishCompletionRun", mock.Anything, db.FinishCompletionRunParams{Status: completionInterrupted, ID: 7}).
+		Run(func(mock.Arguments) { finished = true }).Return(nil).Once()
+
+	backups := &fakeBackups{}
+	server := &Server{queries: mockQuerier, matcher: match.New(), backups: backups, backupDir: t.TempDir()}
+	backups.onRestore = func() {
+		if !finished {
+			t.Error("restored while the completion run was still going")
+		}
+		if err := server.reserveCompletionRun(); !errors.Is(err, errRestoreInProgress) {
+			t.Errorf("reserveCompletionRun() during a restore error = %v, want errRestoreInProgress", err)
+		}
+	}
+
+	provider := &blockingProvider{started: make(chan struct{})}
+	if err := server.reserveCompletionRun(); err != nil {
+		t.Fatalf("reserveCompletionRun() error = %v", err)
+	}
+	server.startCompletionRun(db.CompletionRun{ID: 7, Concurrency: 1}, provider, []int64{1})
+	<-provider.started
+
+	snapshot, err := os.ReadFile(writeSnapshot(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := httptest.NewRecorder()
+	server.handleRestore(w, httptest.NewRequest("POST", "/api/admin/restore", strings.NewReader(string(snapshot))))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body)
+	}
+	if len(backups.restored) != 1 {
+		t.Errorf("restored %d times, want 1", len(backups.restored))
+	}
+
+	// Runs can be started again once the restore is done
+	if err := server.reserveCompletionRun(); err != nil {
+		t.Errorf("reserveCompletionRun() after the restore error = %v", err)
+	}
+	server.releaseCompletionRun()
+}
+
+func TestServer_backupToDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "backups")
+	server := &Server{backups: &fakeBackups{}, backupDir: dir, backupRetention: 1}
+
+	old := filepath.Join(dir, "bookscraping-20000101T000000Z.db")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(old, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	path, err := server.backupToDir(context.Background())
+	if err != nil {
+		t.Fatalf("backupToDir() error = %v", err)
+	}
+	if filepath.Dir(path) != dir || !strings.HasPrefix(filepath.Base(path), "bookscraping-"+time.Now().UTC().Format("2006")) {
+		t.Errorf("path = %q", path)
+	}
+	if _, err := os.Stat(old); !os.IsNotExist(err) {
+		t.Errorf("old backup was kept beyond the retention count")
+	}
+}
+
+func TestExtendDeadlines(t *testing.T) {
+	// A response slower than the server's write timeout still reaches the client
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		extendDeadlines(w)
+		time.Sleep(200 * time.Millisecond)
+		_, _ = w.Write([]byte("snapshot"))
+	}))
+	srv.Config.WriteTimeout = 50 * time.Millisecond
+	srv.Start()
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("GET error = %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading body: %v", err)
+	}
+	if string(body) != "snapshot" {
+		t.Errorf("body = %q", body)
+	}
+}
@@ -0,0 +1,242 @@
+package server
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+)
+
+// Book list sort keys; each can be reversed with order=desc
+var bookSorts = []string{"title", "series", "published", "added"}
+
+// BookListItem is a book in GET /api/books. The raw Booklore data is left out of lists.
+type BookListItem struct {
+	*db.Book
+	Authors []string        `json:"authors"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// BookDetail is a book with its authors, its series and the raw Booklore data it was synced from
+type BookDetail struct {
+	*db.Book
+	Authors []db.Author     `json:"authors"`
+	Series  *db.Series      `json:"series"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// handleListBooks lists books a page at a time.
+// Filters: status=owned|missing, series_id, author_id, language, has_goodreads_id=true|false and q, a title substring.
+// sort is title, series, published or added, and order=desc reverses it.
+func (s *Server) handleListBooks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	page, perPage := getPagination(r)
+
+	format, err := descriptionFormat(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filters, err := bookFilters(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	sort, err := listSort(r.URL.Query(), bookSorts, "title")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	books, err := s.queries.ListBooks(ctx, db.ListBooksParams{
+		Missing:        filters.Missing,
+		SeriesID:       filters.SeriesID,
+		AuthorID:       filters.AuthorID,
+		Language:       filters.Language,
+		HasGoodreadsID: filters.HasGoodreadsID,
+		Title:          filters.Title,
+		Sort:           sort,
+		Limit:          int64(perPage),
+		Offset:         int64((page - 1) * perPage),
+	})
+	if err != nil {
+		slog.Error("Failed to list books", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to list books")
+		return
+	}
+	applyDescriptionFormat(books, format)
+
+	total, err := s.queries.CountBooks(ctx, filters)
+	if err != nil {
+		slog.Error("Failed to count books", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to count books")
+		return
+	}
+
+	items := make([]BookListItem, len(books))
+	for i, book := range books {
+		authors, err := s.queries.GetAuthorsForBook(ctx, book.ID)
+		if err != nil {
+			slog.Error("Failed to get authors for book", slog.Int64("book_id", book.ID), slog.Any("error", err))
+			authors = []db.Author{}
+		}
+
+		authorNames := make([]string, len(authors))
+		for j, author := range authors {
+			authorNames[j] = author.Name
+		}
+
+		items[i] = BookListItem{
+			Book:    &books[i],
+			Authors: authorNames,
+		}
+	}
+
+	writeJSON(w, PaginatedResponse{
+		Data:    items,
+		Total:   total,
+		Page:    page,
+		PerPage: perPage,
+	})
+}
+
+// handleGetBook returns a book with its authors, series and raw Booklore data
+func (s *Server) handleGetBook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid book ID")
+		return
+	}
+
+	format, err := descriptionFormat(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	book, err := s.queries.GetBook(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		writeError(w, http.StatusNotFound, "Book not found")
+		return
+	}
+	if err != nil {
+		slog.Error("Failed to get book", slog.Int64("book_id", id), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to get book")
+		return
+	}
+	books := []db.Book{book}
+	applyDescriptionFormat(books, format)
+	book = books[0]
+
+	authors, err := s.queries.GetAuthorsForBook(ctx, book.ID)
+	if err != nil {
+		slog.Error("Failed to get authors for book", slog.Int64("book_id", book.ID), slog.Any("error", err))
+		authors = []db.Author{}
+	}
+
+	detail := BookDetail{
+		Book:    &book,
+		Authors: authors,
+		Data:    bookData(book.Data),
+	}
+
+	if book.SeriesID != nil {
+		series, err := s.queries.GetSeries(ctx, *book.SeriesID)
+		if err != nil {
+			slog.Error("Failed to get series for book", slog.Int64("book_id", book.ID), slog.Int64("series_id", *book.SeriesID), slog.Any("error", err))
+		} else {
+			detail.Series = &series
+		}
+	}
+
+	writeJSON(w, detail)
+}
+
+// bookFilters reads the book list filters from the query string
+func bookFilters(params url.Values) (db.CountBooksParams, error) {
+	var filters db.CountBooksParams
+
+	switch status := params.Get("status"); status {
+	case "":
+	case "missing", "owned":
+		missing := status == "missing"
+		filters.Missing = &missing
+	default:
+		return filters, fmt.Errorf("unknown status %q, expected owned or missing", status)
+	}
+
+	for name, target := range map[string]**int64{"series_id": &filters.SeriesID, "author_id": &filters.AuthorID} {
+		value := params.Get(name)
+		if value == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return filters, fmt.Errorf("invalid %s %q", name, value)
+		}
+		*target = &id
+	}
+
+	if value := params.Get("has_goodreads_id"); value != "" {
+		has, err := strconv.ParseBool(value)
+		if err != nil {
+			return filters, fmt.Errorf("has_goodreads_id must be true or false, got %q", value)
+		}
+		filters.HasGoodreadsID = &has
+	}
+
+	filters.Language = optionalString(params.Get("language"))
+	filters.Title = optionalString(params.Get("q"))
+	return filters, nil
+}
+
+// listSort turns the sort and order parameters into the sort key the list queries understand:
+// the sort name, with a _desc suffix when reversed. An empty sort gives fallback.
+func listSort(params url.Values, sorts []string, fallback string) (string, error) {
+	sort := params.Get("sort")
+	if sort == "" {
+		sort = fallback
+	}
+	if sort != "" && !slices.Contains(sorts, sort) {
+		return "", fmt.Errorf("unknown sort %q, expected one of %s", sort, strings.Join(sorts, ", "))
+	}
+
+	switch order := params.Get("order"); order {
+	case "", "asc":
+		return sort, nil
+	case "desc":
+		if sort == "" {
+			return "", errors.New("order needs a sort")
+		}
+		return sort + "_desc", nil
+	default:
+		return "", fmt.Errorf("unknown order %q, expected asc or desc", order)
+	}
+}
+
+// bookData returns the raw Booklore data stored with a book as JSON, or null when there is none
+func bookData(data any) json.RawMessage {
+	var raw []byte
+	switch data := data.(type) {
+	case []byte:
+		raw = data
+	case string:
+		raw = []byte(data)
+	}
+	if len(raw) == 0 || !json.Valid(raw) {
+		return nil
+	}
+	return raw
+}
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBookFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		check   func(db.CountBooksParams) bool
+		wantErr bool
+	}{
+		{name: "no filters", query: "", check: func(f db.CountBooksParams) bool { return f == db.CountBooksParams{} }},
+		{name: "missing", query: "status=missing", check: func(f db.CountBooksParams) bool { return *f.Missing }},
+		{name: "owned", query: "status=owned", check: func(f db.CountBooksParams) bool { return !*f.Missing }},
+		{
+			name:  "ids, language, goodreads and title",
+			query: "series_id=3&author_id=7&language=en&has_goodreads_id=false&q=die",
+			check: func(f db.CountBooksParams) bool {
+				return *f.SeriesID == 3 && *f.AuthorID == 7 && *f.Language == "en" && !*f.HasGoodreadsID && *f.Title == "die"
+			},
+		},
+		{name: "unknown status", query: "status=lost", wantErr: true},
+		{name: "invalid series", query: "series_id=abc", wantErr: true},
+		{name: "invalid has_goodreads_id", query: "has_goodreads_id=maybe", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, _ := url.ParseQuery(tt.query)
+			filters, err := bookFilters(params)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("bookFilters() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !tt.check(filters) {
+				t.Errorf("bookFilters() = %+v", filters)
+			}
+		})
+	}
+}
+
+func TestListSort(t *testing.T) {
+	tests := []struct {
+		query   string
+		want    string
+		wantErr bool
+	}{
+		{query: "", want: "title"},
+		{query: "sort=series", want: "series"},
+		{query: "sort=added&order=desc", want: "added_desc"},
+		{query: "sort=rating", wantErr: true},
+		{query: "order=sideways", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		params, _ := url.ParseQuery(tt.query)
+		got, err := listSort(params, bookSorts, "title")
+		if (err != nil) != tt.wantErr {
+			t.Errorf("listSort(%q) error = %v, wantErr %v", tt.query, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("listSort(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestServer_handleGetBook(t *testing.T) {
+	seriesID := int64(3)
+	mockQuerier := db.NewMockQuerier(t)
+	mockQuerier.On("GetBook", mock.Anything, int64(1)).
+		Return(db.Book{ID: 1, Title: "Die Twice", SeriesID: &seriesID, Data: []byte(`{"metadata":{"pageCount":320}}`)}, nil).Once()
+	mockQuerier.On("GetAuthorsForBook", mock.Anything, int64(1)).Return([]db.Author{{ID: 2, Name: "Andrew Grant"}}, nil).Once()
+	mockQuerier.On("GetSeries", mock.Anything, seriesID).Return(db.Series{ID: 3, Name: "David Trevellyan"}, nil).Once()
+
+	server := &Server{queries: mockQuerier}
+	req := httptest.NewRequest("GET", "/api/books/1", nil)
+	req.SetPathValue("id", "1")
+	w := httptest.NewRecorder()
+	server.handleGetBook(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body)
+	}
+
+	var got struct {
+		Title   string `json:"title"`
+		Authors []struct {
+			Name string `json:"name"`
+		} `json:"authors"`
+		Series struct {
+			Name string `json:"name"`
+		} `json:"series"`
+		Data struct {
+			Metadata struct {
+				PageCount int `json:"pageCount"`
+			} `json:"metadata"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if got.Title != "Die Twice" || len(got.Authors) != 1 || got.Series.Name != "David Trevellyan" || got.Data.Metadata.PageCount != 320 {
+		t.Errorf("unexpected book %+v", got)
+	}
+}
@@ -0,0 +1,209 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+	"github.com/amalgamated-tools/bookscraping/pkg/seriesdiff"
+)
+
+const (
+	// defaultChangesLimit is how many changes GET /api/series/{id}/changes returns unless asked otherwise
+	defaultChangesLimit = 50
+	// maxChangesLimit bounds the limit a caller can ask for
+	maxChangesLimit = 500
+)
+
+// SeriesChangeEvent is published on the event stream for every change found in a followed series
+type SeriesChangeEvent struct {
+	Type        string `json:"type"`
+	SeriesID    int64  `json:"series_id"`
+	SeriesName  string `json:"series_name"`
+	Kind        string `json:"kind"`
+	Title       string `json:"title"`
+	GoodreadsID string `json:"goodreads_id,omitempty"`
+	OldPosition string `json:"old_position,omitempty"`
+	NewPosition string `json:"new_position,omitempty"`
+}
+
+// handleFollowSeries starts keeping snapshots of a series so that changes to it are reported
+func (s *Server) handleFollowSeries(w http.ResponseWriter, r *http.Request) {
+	s.setSeriesFollowed(w, r, true)
+}
+
+// handleUnfollowSeries stops reporting changes to a series; its change feed is kept
+func (s *Server) handleUnfollowSeries(w http.ResponseWriter, r *http.Request) {
+	s.setSeriesFollowed(w, r, false)
+}
+
+func (s *Server) setSeriesFollowed(w http.ResponseWriter, r *http.Request, followed bool) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid series ID")
+		return
+	}
+
+	updated, err := s.queries.SetSeriesFollowed(r.Context(), db.SetSeriesFollowedParams{Followed: followed, ID: id})
+	if err != nil {
+		slog.Error("Failed to update followed series", slog.Int64("series_id", id), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to update series")
+		return
+	}
+	if updated == 0 {
+		writeError(w, http.StatusNotFound, "Series not found")
+		return
+	}
+
+	writeJSON(w, map[string]any{"id": id, "followed": followed})
+}
+
+// handleListSeriesChanges returns the change feed of a series, newest first
+func (s *Server) handleListSeriesChanges(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid series ID")
+		return
+	}
+
+	limit := int64(defaultChangesLimit)
+	if value := r.URL.Query().Get("limit"); value != "" {
+		limit, err = strconv.ParseInt(value, 10, 64)
+		if err != nil || limit < 1 || limit > maxChangesLimit {
+			writeError(w, http.StatusBadRequest, "limit must be between 1 and "+strconv.Itoa(maxChangesLimit))
+			return
+		}
+	}
+
+	if _, err := s.queries.GetSeries(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(w, http.StatusNotFound, "Series not found")
+			return
+		}
+		slog.Error("Failed to get series", slog.Int64("id", id), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to get series")
+		return
+	}
+
+	changes, err := s.queries.ListSeriesChanges(ctx, db.ListSeriesChangesParams{SeriesID: id, Limit: limit})
+	if err != nil {
+		slog.Error("Failed to list series changes", slog.Int64("series_id", id), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to list series changes")
+		return
+	}
+	if changes == nil {
+		changes = []db.SeriesChange{}
+	}
+
+	writeJSON(w, changes)
+}
+
+// recordSeriesSnapshot stores what a provider listed for a followed series and records how it differs
+// from that provider's previous snapshot, since providers list a series differently. The first snapshot
+// of a series from a provider is only a baseline. It returns the number of changes found.
+func (s *Server) recordSeriesSnapshot(ctx context.Context, series db.Series, provider string, books []metadata.Book) int {
+	// An empty listing is more likely a scraping problem than a series losing every book
+	if len(books) == 0 {
+		return 0
+	}
+
+	var previous []seriesdiff.Entry
+	hasPrevious := false
+	latest, err := s.queries.GetLatestSeriesSnapshot(ctx, db.GetLatestSeriesSnapshotParams{
+		SeriesID: series.ID,
+		Provider: provider,
+	})
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		slog.Error("Failed to get series snapshot", slog.Int64("series_id", series.ID), slog.Any("error", err))
+		return 0
+	default:
+		if err := json.Unmarshal([]byte(latest.Entries), &previous); err != nil {
+			slog.Error("Failed to decode series snapshot", slog.Int64("snapshot_id", latest.ID), slog.Any("error", err))
+		} else {
+			hasPrevious = true
+		}
+	}
+
+	entries := seriesdiff.Entries(books)
+	encoded, err := json.Marshal(entries)
+	if err != nil {
+		slog.Error("Failed to encode series snapshot", slog.Int64("series_id", series.ID), slog.Any("error", err))
+		return 0
+	}
+	snapshot, err := s.queries.CreateSeriesSnapshot(ctx, db.CreateSeriesSnapshotParams{
+		SeriesID: series.ID,
+		Provider: provider,
+		Entries:  string(encoded),
+	})
+	if err != nil {
+		slog.Error("Failed to store series snapshot", slog.Int64("series_id", series.ID), slog.Any("error", err))
+		return 0
+	}
+
+	if !hasPrevious {
+		return 0
+	}
+
+	changes := seriesdiff.Diff(previous, entries)
+	for _, change := range changes {
+		newPosition := change.Entry.Position
+		if change.Kind == seriesdiff.Removed {
+			newPosition = ""
+		}
+
+		err := s.queries.CreateSeriesChange(ctx, db.CreateSeriesChangeParams{
+			SeriesID:    series.ID,
+			SnapshotID:  snapshot.ID,
+			Kind:        string(change.Kind),
+			Title:       change.Entry.Title,
+			GoodreadsID: optionalString(change.Entry.GoodreadsID),
+			OldPosition: optionalString(change.OldPosition),
+			NewPosition: optionalString(newPosition),
+		})
+		if err != nil {
+			slog.Error("Failed to store series change", slog.Int64("series_id", series.ID), slog.String("title", change.Entry.Title), slog.Any("error", err))
+			continue
+		}
+
+		s.publishEvent(SeriesChangeEvent{
+			Type:        "series_change",
+			SeriesID:    series.ID,
+			SeriesName:  series.Name,
+			Kind:        string(change.Kind),
+			Title:       change.Entry.Title,
+			GoodreadsID: change.Entry.GoodreadsID,
+			OldPosition: change.OldPosition,
+			NewPosition: newPosition,
+		})
+	}
+
+	if len(changes) > 0 {
+		slog.Info("Followed series changed", slog.Int64("series_id", series.ID), slog.String("series", series.Name), slog.Int("changes", len(changes)))
+	}
+	return len(changes)
+}
+
+// publishEvent sends an event to the connected event stream clients without blocking
+func (s *Server) publishEvent(event any) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		slog.Error("Failed to encode event", slog.Any("error", err))
+		return
+	}
+
+	select {
+	case s.eventCh <- string(payload):
+	default:
+		slog.Warn("Event channel full, dropping event", slog.String("event", string(payload)))
+	}
+}
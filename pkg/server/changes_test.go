@@ -0,0 +1,66 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServer_recordSeriesSnapshot(t *testing.T) {
+	series := db.Series{ID: 3, SeriesID: 49126, Name: "David Trevellyan", Followed: true}
+	books := []metadata.Book{
+		{Identifiers: metadata.Identifiers{GoodreadsID: "7315139"}, Title: "Die Twice", SeriesPosition: "2"},
+		{Identifiers: metadata.Identifiers{GoodreadsID: "777"}, Title: "Invincible", SeriesPosition: "3"},
+	}
+
+	t.Run("first snapshot is a baseline", func(t *testing.T) {
+		mockQuerier := db.NewMockQuerier(t)
+		mockQuerier.On("GetLatestSeriesSnapshot", mock.Anything, db.GetLatestSeriesSnapshotParams{SeriesID: 3, Provider: "goodreads"}).Return(db.SeriesSnapshot{}, sql.ErrNoRows).Once()
+		mockQuerier.On("CreateSeriesSnapshot", mock.Anything, mock.Anything).Return(db.SeriesSnapshot{ID: 1}, nil).Once()
+
+		server := &Server{queries: mockQuerier, eventCh: make(chan string, 10)}
+		if changes := server.recordSeriesSnapshot(context.Background(), series, "goodreads", books); changes != 0 {
+			t.Errorf("recordSeriesSnapshot() = %d, want 0", changes)
+		}
+		if len(server.eventCh) != 0 {
+			t.Errorf("expected no events, got %d", len(server.eventCh))
+		}
+	})
+
+	t.Run("changes are stored and published", func(t *testing.T) {
+		previous := `[{"title":"Die Twice","position":"2","goodreads_id":"7315139"},{"title":"Die Trying","position":"1","goodreads_id":"1"}]`
+
+		mockQuerier := db.NewMockQuerier(t)
+		mockQuerier.On("GetLatestSeriesSnapshot", mock.Anything, db.GetLatestSeriesSnapshotParams{SeriesID: 3, Provider: "goodreads"}).Return(db.SeriesSnapshot{ID: 1, Entries: previous}, nil).Once()
+		mockQuerier.On("CreateSeriesSnapshot", mock.Anything, mock.MatchedBy(func(arg db.CreateSeriesSnapshotParams) bool {
+			return arg.SeriesID == 3 && arg.Provider == "goodreads"
+		})).Return(db.SeriesSnapshot{ID: 2}, nil).Once()
+		mockQuerier.On("CreateSeriesChange", mock.Anything, mock.MatchedBy(func(arg db.CreateSeriesChangeParams) bool {
+			return arg.SnapshotID == 2 && arg.Kind == "added" && arg.Title == "Invincible" && *arg.NewPosition == "3"
+		})).Return(nil).Once()
+		mockQuerier.On("CreateSeriesChange", mock.Anything, mock.MatchedBy(func(arg db.CreateSeriesChangeParams) bool {
+			return arg.SnapshotID == 2 && arg.Kind == "removed" && arg.Title == "Die Trying" && *arg.OldPosition == "1" && arg.NewPosition == nil
+		})).Return(nil).Once()
+
+		server := &Server{queries: mockQuerier, eventCh: make(chan string, 10)}
+		if changes := server.recordSeriesSnapshot(context.Background(), series, "goodreads", books); changes != 2 {
+			t.Errorf("recordSeriesSnapshot() = %d, want 2", changes)
+		}
+
+		if len(server.eventCh) != 2 {
+			t.Fatalf("expected 2 events, got %d", len(server.eventCh))
+		}
+		var event SeriesChangeEvent
+		if err := json.Unmarshal([]byte(<-server.eventCh), &event); err != nil {
+			t.Fatalf("decoding event: %v", err)
+		}
+		if event.Type != "series_change" || event.Kind != "added" || event.SeriesName != "David Trevellyan" {
+			t.Errorf("unexpected event %+v", event)
+		}
+	})
+}
@@ -0,0 +1,486 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+	"golang.org/x/sync/errgroup"
+)
+
+// Completion run and per-series statuses
+const (
+	completionRunning     = "running"
+	completionCompleted   = "completed"
+	completionCancelled   = "cancelled"
+	completionInterrupted = "interrupted"
+
+	completionPending = "pending"
+	completionDone    = "done"
+	completionFailed  = "failed"
+)
+
+const (
+	// defaultCompletionConcurrency is how many series a bulk completion fetches at once unless asked otherwise
+	defaultCompletionConcurrency = 2
+	// maxCompletionConcurrency bounds the concurrency a caller can ask for; the Goodreads rate limit applies on top
+	maxCompletionConcurrency = 8
+	// completionRunsListed is how many recent runs GET /api/completions returns
+	completionRunsListed = 20
+	// pendingCompletionRun is the slot a run holds while it is being created, before it has an ID
+	pendingCompletionRun = 0
+)
+
+var (
+	// errCompletionRunActive is returned when a run is started while another is in progress
+	errCompletionRunActive = errors.New("a completion run is already in progress")
+	// errRestoreInProgress is returned when a run is started while the database is being restored
+	errRestoreInProgress = errors.New("a database restore is in progress")
+	// errRestoring is the cancel cause of runs stopped so the database can be restored
+	errRestoring = errors.New("database restore")
+	// errCompletionCancelled is the cancel cause of a run stopped through the API
+	errCompletionCancelled = errors.New("completion run cancelled")
+	// errServerStopping is the cancel cause of runs stopped by a shutdown; they can be resumed later
+	errServerStopping = errors.New("server stopping")
+)
+
+// CompletionRun is a bulk series completion with its progress
+type CompletionRun struct {
+	*db.CompletionRun
+	Total   int64                 `json:"total"`
+	Done    int64                 `json:"done"`
+	Failed  int64                 `json:"failed"`
+	Pending int64                 `json:"pending"`
+	Series  []CompletionRunSeries `json:"series,omitempty"`
+}
+
+// CompletionRunSeries is the result of one series in a bulk completion
+type CompletionRunSeries struct {
+	*db.CompletionRunSeries
+	SeriesName string `json:"series_name"`
+}
+
+// handleStartCompletionRun starts completing every mapped series in the background.
+// stale_days limits the run to series that haven't been checked in that many days,
+// provider picks a single metadata provider and concurrency bounds the parallel fetches.
+func (s *Server) handleStartCompletionRun(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := r.URL.Query()
+
+	providerName := params.Get("provider")
+	provider, err := s.metadataProvider(providerName)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	staleDays := int64(0)
+	if value := params.Get("stale_days"); value != "" {
+		staleDays, err = strconv.ParseInt(value, 10, 64)
+		if err != nil || staleDays < 0 {
+			writeError(w, http.StatusBadRequest, "stale_days must be a non-negative number of days")
+			return
+		}
+	}
+
+	concurrency := int64(defaultCompletionConcurrency)
+	if value := params.Get("concurrency"); value != "" {
+		concurrency, err = strconv.ParseInt(value, 10, 64)
+		if err != nil || concurrency < 1 || concurrency > maxCompletionConcurrency {
+			writeError(w, http.StatusBadRequest, "concurrency must be between 1 and "+strconv.Itoa(maxCompletionConcurrency))
+			return
+		}
+	}
+
+	run, err := s.createCompletionRun(ctx, providerName, provider, staleDays, concurrency)
+	if errors.Is(err, errCompletionRunActive) {
+		writeError(w, http.StatusConflict, "A completion run is already in progress")
+		return
+	}
+	if errors.Is(err, errRestoreInProgress) {
+		writeError(w, http.StatusServiceUnavailable, "The database is being restored")
+		return
+	}
+	if err != nil {
+		slog.Error("Failed to start completion run", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to start completion run")
+		return
+	}
+
+	writeJSON(w, run)
+}
+
+// createCompletionRun stores a run over the mapped series not checked in the last staleDays and starts it.
+// A run without any series is stored as completed straight away.
+func (s *Server) createCompletionRun(ctx context.Context, providerName string, provider metadata.Provider, staleDays, concurrency int64) (CompletionRun, error) {
+	if err := s.reserveCompletionRun(); err != nil {
+		return CompletionRun{}, err
+	}
+	defer s.releaseCompletionRun()
+
+	run, err := s.queries.CreateCompletionRun(ctx, db.CreateCompletionRunParams{
+		Provider:    providerName,
+		StaleDays:   staleDays,
+		Concurrency: concurrency,
+	})
+	if err != nil {
+		return CompletionRun{}, fmt.Errorf("creating completion run: %w", err)
+	}
+
+	total, err := s.queries.AddCompletionRunSeries(ctx, db.AddCompletionRunSeriesParams{RunID: run.ID, StaleDays: staleDays})
+	if err != nil {
+		return CompletionRun{}, fmt.Errorf("selecting series for completion run %d: %w", run.ID, err)
+	}
+
+	if total == 0 {
+		if err := s.queries.FinishCompletionRun(ctx, db.FinishCompletionRunParams{Status: completionCompleted, ID: run.ID}); err != nil {
+			slog.Error("Failed to finish completion run", slog.Int64("run_id", run.ID), slog.Any("error", err))
+		}
+		run.Status = completionCompleted
+		return CompletionRun{CompletionRun: &run}, nil
+	}
+
+	seriesIDs, err := s.queries.ListPendingCompletionRunSeries(ctx, run.ID)
+	if err != nil {
+		return CompletionRun{}, fmt.Errorf("listing series for completion run %d: %w", run.ID, err)
+	}
+
+	slog.Info("Starting completion run", slog.Int64("run_id", run.ID), slog.Int64("series", total), slog.Int64("stale_days", staleDays), slog.Int64("concurrency", concurrency))
+	s.startCompletionRun(run, provider, seriesIDs)
+
+	return CompletionRun{CompletionRun: &run, Total: total, Pending: total}, nil
+}
+
+// handleListCompletionRuns lists the most recent completion runs with their progress
+func (s *Server) handleListCompletionRuns(w http.ResponseWriter, r *http.Request) {
+	rows, err := s.queries.ListCompletionRuns(r.Context(), completionRunsListed)
+	if err != nil {
+		slog.Error("Failed to list completion runs", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to list completion runs")
+		return
+	}
+
+	runs := make([]CompletionRun, len(rows))
+	for i, row := range rows {
+		runs[i] = CompletionRun{
+			CompletionRun: &db.CompletionRun{
+				ID:          row.ID,
+				Status:      row.Status,
+				Provider:    row.Provider,
+				StaleDays:   row.StaleDays,
+				Concurrency: row.Concurrency,
+				CreatedAt:   row.CreatedAt,
+				FinishedAt:  row.FinishedAt,
+			},
+			Total:   row.Total,
+			Done:    row.Done,
+			Failed:  row.Failed,
+			Pending: row.Total - row.Done - row.Failed,
+		}
+	}
+
+	writeJSON(w, runs)
+}
+
+// handleGetCompletionRun returns a completion run with the result of every series in it
+func (s *Server) handleGetCompletionRun(w http.ResponseWriter, r *http.Request) {
+	run, ok := s.loadCompletionRun(w, r)
+	if !ok {
+		return
+	}
+
+	rows, err := s.queries.ListCompletionRunSeries(r.Context(), run.ID)
+	if err != nil {
+		slog.Error("Failed to list completion run series", slog.Int64("run_id", run.ID), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to list completion run series")
+		return
+	}
+
+	writeJSON(w, completionRunWithSeries(run, rows))
+}
+
+// handleCancelCompletionRun stops a running completion; the series it didn't reach stay pending
+func (s *Server) handleCancelCompletionRun(w http.ResponseWriter, r *http.Request) {
+	run, ok := s.loadCompletionRun(w, r)
+	if !ok {
+		return
+	}
+
+	s.completionMu.Lock()
+	cancel, running := s.completionRuns[run.ID]
+	s.completionMu.Unlock()
+	if !running {
+		writeError(w, http.StatusConflict, "Completion run is not running")
+		return
+	}
+
+	cancel(errCompletionCancelled)
+	writeJSON(w, map[string]any{"id": run.ID, "status": completionCancelled})
+}
+
+// handleResumeCompletionRun continues a cancelled or interrupted run with the series it didn't reach
+func (s *Server) handleResumeCompletionRun(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	run, ok := s.loadCompletionRun(w, r)
+	if !ok {
+		return
+	}
+
+	provider, err := s.metadataProvider(run.Provider)
+	if err != nil {
+		writeError(w, http.StatusConflict, "The run's metadata provider is no longer configured")
+		return
+	}
+
+	if err := s.reserveCompletionRun(); err != nil {
+		if errors.Is(err, errRestoreInProgress) {
+			writeError(w, http.StatusServiceUnavailable, "The database is being restored")
+			return
+		}
+		writeError(w, http.StatusConflict, "A completion run is already in progress")
+		return
+	}
+	defer s.releaseCompletionRun()
+
+	seriesIDs, err := s.queries.ListPendingCompletionRunSeries(ctx, run.ID)
+	if err != nil {
+		slog.Error("Failed to list series for completion run", slog.Int64("run_id", run.ID), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to list series")
+		return
+	}
+	if len(seriesIDs) == 0 {
+		writeError(w, http.StatusConflict, "Completion run has no series left to complete")
+		return
+	}
+
+	if err := s.queries.ResumeCompletionRun(ctx, run.ID); err != nil {
+		slog.Error("Failed to resume completion run", slog.Int64("run_id", run.ID), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to resume completion run")
+		return
+	}
+	run.Status = completionRunning
+	run.FinishedAt = nil
+
+	slog.Info("Resuming completion run", slog.Int64("run_id", run.ID), slog.Int("series", len(seriesIDs)))
+	s.startCompletionRun(run, provider, seriesIDs)
+
+	writeJSON(w, map[string]any{"id": run.ID, "status": completionRunning, "pending": len(seriesIDs)})
+}
+
+// loadCompletionRun reads the run named in the path, writing an error response when it can't
+func (s *Server) loadCompletionRun(w http.ResponseWriter, r *http.Request) (db.CompletionRun, bool) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid completion run ID")
+		return db.CompletionRun{}, false
+	}
+
+	run, err := s.queries.GetCompletionRun(r.Context(), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		writeError(w, http.StatusNotFound, "Completion run not found")
+		return run, false
+	}
+	if err != nil {
+		slog.Error("Failed to get completion run", slog.Int64("run_id", id), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to get completion run")
+		return run, false
+	}
+	return run, true
+}
+
+// completionRunWithSeries adds the per-series results and their counts to a run
+func completionRunWithSeries(run db.CompletionRun, rows []db.ListCompletionRunSeriesRow) CompletionRun {
+	result := CompletionRun{
+		CompletionRun: &run,
+		Total:         int64(len(rows)),
+		Series:        make([]CompletionRunSeries, len(rows)),
+	}
+	for i, row := range rows {
+		switch row.Status {
+		case completionDone:
+			result.Done++
+		case completionFailed:
+			result.Failed++
+		case completionPending:
+			result.Pending++
+		}
+		result.Series[i] = CompletionRunSeries{
+			CompletionRunSeries: &db.CompletionRunSeries{
+				RunID:           row.RunID,
+				SeriesID:        row.SeriesID,
+				Status:          row.Status,
+				NewMissingBooks: row.NewMissingBooks,
+				ReviewBooks:     row.ReviewBooks,
+				Error:           row.Error,
+				CheckedAt:       row.CheckedAt,
+			},
+			SeriesName: row.SeriesName,
+		}
+	}
+	return result
+}
+
+// reserveCompletionRun claims the slot for a new run. It fails with errCompletionRunActive when a run is
+// already in progress and errRestoreInProgress while the database is being restored.
+// Checking and claiming happen under one lock, so two callers can't both start a run.
+// The reservation is handed over to the run by startCompletionRun, or given back by releaseCompletionRun.
+func (s *Server) reserveCompletionRun() error {
+	s.completionMu.Lock()
+	if s.restoring {
+		s.completionMu.Unlock()
+		return errRestoreInProgress
+	}
+	if len(s.completionRuns) > 0 {
+		s.completionMu.Unlock()
+		return errCompletionRunActive
+	}
+	if s.completionRuns == nil {
+		s.completionRuns = make(map[int64]context.CancelCauseFunc)
+	}
+	s.completionRuns[pendingCompletionRun] = func(error) {}
+	s.completionMu.Unlock()
+
+	// The reservation, and the run it leads to, hold off a restore until the run has finished
+	s.maintenance.RLock()
+	return nil
+}
+
+// releaseCompletionRun gives back a reservation that didn't lead to a run
+func (s *Server) releaseCompletionRun() {
+	s.completionMu.Lock()
+	_, reserved := s.completionRuns[pendingCompletionRun]
+	delete(s.completionRuns, pendingCompletionRun)
+	s.completionMu.Unlock()
+
+	if reserved {
+		s.maintenance.RUnlock()
+	}
+}
+
+// startCompletionRun completes the given series in the background, tracking the run so it can be cancelled.
+// The caller must hold the reservation from reserveCompletionRun.
+func (s *Server) startCompletionRun(run db.CompletionRun, provider metadata.Provider, seriesIDs []int64) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+
+	s.completionMu.Lock()
+	delete(s.completionRuns, pendingCompletionRun)
+	s.completionRuns[run.ID] = cancel
+	if s.restoring {
+		// A restore began after the slot was reserved and is waiting for this run to let go
+		cancel(errRestoring)
+	}
+	s.completionMu.Unlock()
+
+	go func() {
+		defer func() {
+			s.completionMu.Lock()
+			delete(s.completionRuns, run.ID)
+			s.completionMu.Unlock()
+			cancel(nil)
+			s.maintenance.RUnlock()
+		}()
+		s.runCompletion(ctx, run, provider, seriesIDs)
+	}()
+}
+
+// runCompletion completes each series with bounded concurrency and records how the run ended
+func (s *Server) runCompletion(ctx context.Context, run db.CompletionRun, provider metadata.Provider, seriesIDs []int64) {
+	g := new(errgroup.Group)
+	g.SetLimit(max(int(run.Concurrency), 1))
+	for _, seriesID := range seriesIDs {
+		if ctx.Err() != nil {
+			break
+		}
+		g.Go(func() error {
+			s.completeRunSeries(ctx, run.ID, seriesID, provider)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	status := completionCompleted
+	switch {
+	case errors.Is(context.Cause(ctx), errCompletionCancelled):
+		status = completionCancelled
+	case ctx.Err() != nil:
+		status = completionInterrupted
+	}
+
+	// The run context may be cancelled by now, but its outcome still has to be stored
+	err := s.queries.FinishCompletionRun(context.WithoutCancel(ctx), db.FinishCompletionRunParams{Status: status, ID: run.ID})
+	if err != nil {
+		slog.Error("Failed to finish completion run", slog.Int64("run_id", run.ID), slog.Any("error", err))
+	}
+	slog.Info("Completion run finished", slog.Int64("run_id", run.ID), slog.String("status", status))
+}
+
+// completeRunSeries completes one series of a run and stores its result.
+// A series interrupted by cancellation stays pending so that resuming the run picks it up again.
+func (s *Server) completeRunSeries(ctx context.Context, runID, seriesID int64, provider metadata.Provider) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	params := db.FinishCompletionRunSeriesParams{RunID: runID, SeriesID: seriesID, Status: completionDone}
+	response, err := s.completeSeries(ctx, seriesID, provider)
+	switch {
+	case err != nil && ctx.Err() != nil:
+		return
+	case err != nil:
+		message := err.Error()
+		params.Status = completionFailed
+		params.Error = &message
+	default:
+		params.NewMissingBooks = int64(response.NewMissingBooks)
+		params.ReviewBooks = int64(response.ReviewBooks)
+	}
+
+	if err := s.queries.FinishCompletionRunSeries(context.WithoutCancel(ctx), params); err != nil {
+		slog.Error("Failed to record completion result", slog.Int64("run_id", runID), slog.Int64("series_id", seriesID), slog.Any("error", err))
+	}
+}
+
+// stopCompletionRuns cancels the runs in progress when the server shuts down; they are left resumable
+func (s *Server) stopCompletionRuns(_ context.Context) error {
+	s.completionMu.Lock()
+	defer s.completionMu.Unlock()
+	for _, cancel := range s.completionRuns {
+		cancel(errServerStopping)
+	}
+	return nil
+}
+
+// pauseBackgroundWork stops everything that writes to the database in the background, so it can be restored:
+// completion runs are cancelled, running jobs are interrupted and requeued, and scheduled work waits.
+// It returns once all of it has stopped; the returned function lets it carry on.
+func (s *Server) pauseBackgroundWork() (resume func()) {
+	s.completionMu.Lock()
+	s.restoring = true
+	for _, cancel := range s.completionRuns {
+		cancel(errRestoring)
+	}
+	s.completionMu.Unlock()
+
+	if s.jobs != nil {
+		s.jobs.Pause()
+	}
+	// Waits for the cancelled runs to store how they ended, and for scheduled work in progress
+	s.maintenance.Lock()
+
+	return func() {
+		s.maintenance.Unlock()
+		if s.jobs != nil {
+			s.jobs.Resume()
+		}
+		s.completionMu.Lock()
+		s.restoring = false
+		s.completionMu.Unlock()
+	}
+}
@@ -0,0 +1,157 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/goodreads"
+	"github.com/amalgamated-tools/bookscraping/pkg/match"
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+	"github.com/stretchr/testify/mock"
+)
+
+// seriesProvider answers series lookups from a map keyed by Goodreads series ID
+type seriesProvider struct {
+	books  map[string][]metadata.Book
+	errs   map[string]error
+	cancel context.CancelCauseFunc
+}
+
+func (p *seriesProvider) Name() string { return "test" }
+
+func (p *seriesProvider) SeriesBooks(ctx context.Context, series metadata.SeriesQuery) ([]metadata.Book, error) {
+	if p.cancel != nil {
+		// Cancel the run while this series is being fetched
+		p.cancel(errCompletionCancelled)
+		return nil, ctx.Err()
+	}
+	return p.books[series.GoodreadsID], p.errs[series.GoodreadsID]
+}
+
+func (p *seriesProvider) Book(context.Context, metadata.Identifiers) (*metadata.Book, error) {
+	return nil, metadata.ErrUnsupported
+}
+
+func (p *seriesProvider) Search(context.Context, string) ([]metadata.Book, error) {
+	return nil, metadata.ErrUnsupported
+}
+
+func TestServer_runCompletion(t *testing.T) {
+	mockQuerier := db.NewMockQuerier(t)
+	for _, series := range []db.Series{{ID: 1, SeriesID: 100, Name: "One"}, {ID: 2, SeriesID: 200, Name: "Two"}} {
+		mockQuerier.On("GetSeries", mock.Anything, series.ID).Return(series, nil)
+		mockQuerier.On("GetBooksBySeries", mock.Anything, mock.MatchedBy(func(id *int64) bool { return *id == series.ID })).Return([]db.Book{}, nil)
+		mockQuerier.On("GetSeriesAuthors", mock.Anything, series.ID).Return([]db.Author{}, nil)
+	}
+	mockQuerier.On("ListMatchReviewsForSeries", mock.Anything, int64(1)).Return([]db.MatchReview{}, nil)
+	mockQuerier.On("CreateMissingBook", mock.Anything, mock.Anything).Return(db.Book{}, nil).Once()
+	mockQuerier.On("FinishCompletionRunSeries", mock.Anything, mock.MatchedBy(func(arg db.FinishCompletionRunSeriesParams) bool {
+		return arg.SeriesID == 1 && arg.Status == completionDone && arg.NewMissingBooks == 1 && arg.Error == nil
+	})).Return(nil).Once()
+	mockQuerier.On("FinishCompletionRunSeries", mock.Anything, mock.MatchedBy(func(arg db.FinishCompletionRunSeriesParams) bool {
+		return arg.SeriesID == 2 && arg.Status == completionFailed && arg.Error != nil
+	})).Return(nil).Once()
+	mockQuerier.On("FinishCompletionRun", mock.Anything, db.FinishCompletionRunParams{Status: completionCompleted, ID: 7}).Return(nil).Once()
+	mockQuerier.On("SetSeriesCheckResult", mock.Anything, mock.MatchedBy(func(arg db.SetSeriesCheckResultParams) bool {
+		return arg.ID == 1 && *arg.LastCheckStatus == seriesCheckSucceeded && arg.LastError == nil
+	})).Return(nil).Once()
+	mockQuerier.On("SetSeriesCheckResult", mock.Anything, mock.MatchedBy(func(arg db.SetSeriesCheckResultParams) bool {
+		return arg.ID == 2 && *arg.LastCheckStatus == seriesCheckFailed && arg.LastError != nil
+	})).Return(nil).Once()
+
+	provider := &seriesProvider{
+		books: map[string][]metadata.Book{"100": {{Identifiers: metadata.Identifiers{GoodreadsID: "42"}, Title: "Missing"}}},
+		errs:  map[string]error{"200": goodreads.ErrBlocked},
+	}
+	server := &Server{queries: mockQuerier, matcher: match.New()}
+
+	run := db.CompletionRun{ID: 7, Concurrency: 2}
+	server.runCompletion(context.Background(), run, provider, []int64{1, 2})
+}
+
+func TestServer_runCompletionCancelled(t *testing.T) {
+	mockQuerier := db.NewMockQuerier(t)
+	mockQuerier.On("GetSeries", mock.Anything, int64(1)).Return(db.Series{ID: 1, SeriesID: 100}, nil)
+	mockQuerier.On("GetBooksBySeries", mock.Anything, mock.Anything).Return([]db.Book{}, nil)
+	mockQuerier.On("GetSeriesAuthors", mock.Anything, int64(1)).Return([]db.Author{}, nil)
+	// The interrupted series is not recorded, in the run or on the series, so it stays pending for a resume
+	mockQuerier.On("FinishCompletionRun", mock.Anything, db.FinishCompletionRunParams{Status: completionCancelled, ID: 7}).Return(nil).Once()
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+	provider := &seriesProvider{cancel: cancel}
+	server := &Server{queries: mockQuerier, matcher: match.New()}
+
+	run := db.CompletionRun{ID: 7, Concurrency: 1}
+	server.runCompletion(ctx, run, provider, []int64{1, 2})
+
+	if !errors.Is(context.Cause(ctx), errCompletionCancelled) {
+		t.Errorf("expected the run to be cancelled, cause = %v", context.Cause(ctx))
+	}
+}
+
+func TestServer_createCompletionRunExclusive(t *testing.T) {
+	mockQuerier := db.NewMockQuerier(t)
+	server := &Server{queries: mockQuerier, matcher: match.New()}
+	provider := &seriesProvider{}
+
+	// A second run asked for while the first is still being created is turned away
+	var concurrentErr error
+	mockQuerier.On("CreateCompletionRun", mock.Anything, mock.Anything).Run(func(mock.Arguments) {
+		_, concurrentErr = server.createCompletionRun(context.Background(), "", provider, 0, 1)
+	}).Return(db.CompletionRun{ID: 7}, nil).Once()
+	mockQuerier.On("AddCompletionRunSeries", mock.Anything, mock.Anything).Return(int64(0), nil).Once()
+	mockQuerier.On("FinishCompletionRun", mock.Anything, mock.Anything).Return(nil).Once()
+
+	run, err := server.createCompletionRun(context.Background(), "", provider, 0, 1)
+	if err != nil {
+		t.Fatalf("createCompletionRun() error = %v", err)
+	}
+	if run.Status != completionCompleted {
+		t.Errorf("run status = %q, want %q", run.Status, completionCompleted)
+	}
+	if !errors.Is(concurrentErr, errCompletionRunActive) {
+		t.Errorf("concurrent createCompletionRun() error = %v, want errCompletionRunActive", concurrentErr)
+	}
+
+	// The empty run finished straight away, so its slot is free again
+	if err := server.reserveCompletionRun(); err != nil {
+		t.Errorf("reserveCompletionRun() error = %v after the run finished", err)
+	}
+}
+
+func TestServer_pauseBackgroundWork_reservedRun(t *testing.T) {
+	mockQuerier := db.NewMockQuerier(t)
+	// The run starts after the restore began, so it stops before fetching anything
+	mockQuerier.On("FinishCompletionRun", mock.Anything, db.FinishCompletionRunParams{Status: completionInterrupted, ID: 7}).Return(nil).Once()
+
+	server := &Server{queries: mockQuerier, matcher: match.New()}
+	if err := server.reserveCompletionRun(); err != nil {
+		t.Fatalf("reserveCompletionRun() error = %v", err)
+	}
+
+	paused := make(chan func())
+	go func() { paused <- server.pauseBackgroundWork() }()
+	for {
+		server.completionMu.Lock()
+		restoring := server.restoring
+		server.completionMu.Unlock()
+		if restoring {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	provider := &blockingProvider{started: make(chan struct{})}
+	server.startCompletionRun(db.CompletionRun{ID: 7, Concurrency: 1}, provider, []int64{1})
+
+	select {
+	case resume := <-paused:
+		resume()
+	case <-time.After(5 * time.Second):
+		t.Fatal("pauseBackgroundWork() is still waiting for a run started during the restore")
+	}
+}
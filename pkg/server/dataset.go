@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/dataset"
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+)
+
+// maxImportBytes bounds the size of an uploaded dataset
+const maxImportBytes = 256 << 20
+
+// txRunner runs queries in a transaction, so an import that fails part way leaves nothing behind
+type txRunner interface {
+	InTx(ctx context.Context, fn func(db.Querier) error) error
+}
+
+// handleExportDataset downloads the library as a portable JSON dataset, without secret settings
+func (s *Server) handleExportDataset(w http.ResponseWriter, r *http.Request) {
+	extendDeadlines(w)
+	now := time.Now()
+	d, err := dataset.Export(r.Context(), s.queries, now)
+	if err != nil {
+		slog.Error("Failed to export dataset", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to export dataset")
+		return
+	}
+
+	name := fmt.Sprintf("bookscraping-%s.json", now.UTC().Format("20060102T150405Z"))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+	writeJSON(w, d)
+}
+
+// handleImportDataset upserts a JSON dataset from the request body into the library
+func (s *Server) handleImportDataset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	extendDeadlines(w)
+
+	var d dataset.Dataset
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxImportBytes)).Decode(&d); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, "Dataset is too large")
+			return
+		}
+		writeError(w, http.StatusBadRequest, "Invalid dataset: "+err.Error())
+		return
+	}
+
+	var summary dataset.Summary
+	run := func(queries db.Querier) error {
+		var err error
+		summary, err = dataset.Import(ctx, queries, &d)
+		return err
+	}
+
+	var err error
+	if tx, ok := s.queries.(txRunner); ok {
+		err = tx.InTx(ctx, run)
+	} else {
+		err = run(s.queries)
+	}
+	if err != nil {
+		slog.Error("Failed to import dataset", slog.Any("error", err))
+		if errors.Is(err, dataset.ErrUnsupportedVersion) || errors.Is(err, dataset.ErrInvalidDataset) {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "Failed to import dataset")
+		return
+	}
+
+	slog.Info("Imported dataset",
+		slog.Int("series", summary.Series),
+		slog.Int("books", summary.Books),
+		slog.Int("authors", summary.Authors),
+		slog.Int("match_reviews", summary.MatchReviews),
+		slog.Int("settings", summary.Settings),
+	)
+	writeJSON(w, summary)
+}
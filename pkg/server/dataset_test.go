@@ -0,0 +1,56 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServer_handleImportDataset(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		setup      func(*db.MockQuerier)
+		wantStatus int
+	}{
+		{
+			name: "imports settings",
+			body: `{"version":1,"settings":{"serverUrl":"https://booklore.example","password":"hunter2"}}`,
+			setup: func(m *db.MockQuerier) {
+				m.On("SetConfig", mock.Anything, db.SetConfigParams{Key: "serverUrl", Value: "https://booklore.example"}).Return(nil).Once()
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "newer version",
+			body:       `{"version":99}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "not JSON",
+			body:       `version: 1`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQuerier := db.NewMockQuerier(t)
+			if tt.setup != nil {
+				tt.setup(mockQuerier)
+			}
+
+			server := &Server{queries: mockQuerier}
+			w := httptest.NewRecorder()
+			server.handleImportDataset(w, httptest.NewRequest("POST", "/api/admin/import", strings.NewReader(tt.body)))
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d, body = %s", w.Code, tt.wantStatus, w.Body)
+			}
+		})
+	}
+}
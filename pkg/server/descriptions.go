@@ -0,0 +1,45 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/htmltext"
+)
+
+// Description formats accepted by the ?description= query parameter
+const (
+	descriptionText     = "text"
+	descriptionMarkdown = "markdown"
+)
+
+// convertDescription returns the plain text and Markdown forms of an HTML description
+func convertDescription(html string) (string, *string) {
+	return htmltext.ToText(html), optionalString(htmltext.ToMarkdown(html))
+}
+
+// descriptionFormat reads the requested description format, defaulting to plain text
+func descriptionFormat(r *http.Request) (string, error) {
+	switch format := r.URL.Query().Get("description"); format {
+	case "", descriptionText:
+		return descriptionText, nil
+	case descriptionMarkdown:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unknown description format %q, expected text or markdown", format)
+	}
+}
+
+// applyDescriptionFormat puts the requested form in each book's description field.
+// Books stored before Markdown was kept fall back to their plain text.
+func applyDescriptionFormat(books []db.Book, format string) {
+	if format != descriptionMarkdown {
+		return
+	}
+	for i := range books {
+		if books[i].DescriptionMarkdown != nil {
+			books[i].Description = *books[i].DescriptionMarkdown
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+)
+
+func TestApplyDescriptionFormat(t *testing.T) {
+	markdown := "**Die Twice**\n\nA thriller"
+	newBooks := func() []db.Book {
+		return []db.Book{
+			{ID: 1, Description: "Die Twice\n\nA thriller", DescriptionMarkdown: &markdown},
+			{ID: 2, Description: "Synced before Markdown was stored"},
+		}
+	}
+
+	tests := []struct {
+		query   string
+		want    []string
+		wantErr bool
+	}{
+		{query: "", want: []string{"Die Twice\n\nA thriller", "Synced before Markdown was stored"}},
+		{query: "?description=text", want: []string{"Die Twice\n\nA thriller", "Synced before Markdown was stored"}},
+		{query: "?description=markdown", want: []string{markdown, "Synced before Markdown was stored"}},
+		{query: "?description=html", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			format, err := descriptionFormat(httptest.NewRequest("GET", "/api/upcoming"+tt.query, nil))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error for an unknown format")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("descriptionFormat() error = %v", err)
+			}
+
+			books := newBooks()
+			applyDescriptionFormat(books, format)
+			for i, want := range tt.want {
+				if books[i].Description != want {
+					t.Errorf("book %d description = %q, want %q", books[i].ID, books[i].Description, want)
+				}
+			}
+		})
+	}
+}
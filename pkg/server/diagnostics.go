@@ -0,0 +1,62 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/goodreads"
+)
+
+// GoodreadsDiagnostics reports parser health against the embedded fixture and a live page
+type GoodreadsDiagnostics struct {
+	Healthy bool                    `json:"healthy"`
+	Fixture goodreads.ParserHealth  `json:"fixture"`
+	Live    *goodreads.ParserHealth `json:"live,omitempty"`
+}
+
+// handleGoodreadsDiagnostics checks the Goodreads parser against a fixture and, unless live=false, a live series page
+func (s *Server) handleGoodreadsDiagnostics(w http.ResponseWriter, r *http.Request) {
+	report := GoodreadsDiagnostics{
+		Fixture: goodreads.CheckFixture(),
+	}
+	report.Healthy = report.Fixture.OK
+
+	if r.URL.Query().Get("live") != "false" {
+		live := s.grClient.CheckLive(r.URL.Query().Get("series_id"))
+		report.Live = &live
+		report.Healthy = report.Healthy && live.OK
+	}
+
+	if !report.Healthy {
+		slog.Warn("Goodreads parser diagnostics failed",
+			slog.Bool("fixture_ok", report.Fixture.OK),
+			slog.String("fixture_error", report.Fixture.Error),
+		)
+	}
+
+	writeJSON(w, report)
+}
+
+// handleGoodreadsSelectors returns the selector profile the Goodreads client is using
+func (s *Server) handleGoodreadsSelectors(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, s.grClient.Selectors())
+}
+
+// goodreadsErrorStatus maps a Goodreads client error to an HTTP status and a user-facing message
+func goodreadsErrorStatus(err error) (int, string) {
+	var statusErr *goodreads.StatusError
+	switch {
+	case errors.Is(err, goodreads.ErrBlocked):
+		return http.StatusServiceUnavailable, "Goodreads blocked the request with an anti-bot page"
+	case errors.Is(err, goodreads.ErrNotFound):
+		return http.StatusNotFound, "Series not found on Goodreads"
+	case errors.As(err, &statusErr):
+		return http.StatusBadGateway, fmt.Sprintf("Goodreads returned status %d", statusErr.StatusCode)
+	case errors.Is(err, goodreads.ErrLayoutChanged):
+		return http.StatusBadGateway, "Goodreads page layout changed; series data not found"
+	default:
+		return http.StatusInternalServerError, "Failed to fetch from Goodreads"
+	}
+}
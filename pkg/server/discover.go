@@ -0,0 +1,212 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/goodreads"
+)
+
+const (
+	// authorSeriesTTL is how long an author's series list from Goodreads is reused before it is scraped again
+	authorSeriesTTL = 24 * time.Hour
+	// authorSeriesRequeueAfter is how long a queued scrape may take before another one is queued for the author
+	authorSeriesRequeueAfter = time.Hour
+)
+
+// DiscoveredSeries lists Goodreads series by one of our authors that we own nothing from
+type DiscoveredSeries struct {
+	AuthorID          int64                    `json:"author_id"`
+	AuthorName        string                   `json:"author_name"`
+	GoodreadsAuthorID string                   `json:"goodreads_author_id"`
+	Series            []goodreads.AuthorSeries `json:"series"`
+	// Pending is set while the author's series are being fetched from Goodreads; Series may be empty or out of date until then
+	Pending bool   `json:"pending"`
+	Error   string `json:"error,omitempty"`
+}
+
+// handleDiscoverSeries lists series by our authors that have no entry in the series table.
+// Only authors whose Goodreads ID has been captured by a series completion are considered.
+// Author pages are scraped by discover_author_series jobs and cached for authorSeriesTTL; authors
+// without a fresh list get a job queued and are marked pending, so the request never waits on Goodreads.
+func (s *Server) handleDiscoverSeries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	authors, err := s.queries.ListAuthorsWithGoodreadsID(ctx)
+	if err != nil {
+		slog.Error("Failed to list authors with Goodreads IDs", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to list authors")
+		return
+	}
+
+	if idStr := r.URL.Query().Get("author_id"); idStr != "" {
+		authorID, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid author ID")
+			return
+		}
+		var filtered []db.Author
+		for _, author := range authors {
+			if author.ID == authorID {
+				filtered = append(filtered, author)
+			}
+		}
+		if len(filtered) == 0 {
+			writeError(w, http.StatusNotFound, "Author not found or has no Goodreads ID")
+			return
+		}
+		authors = filtered
+	}
+
+	known, err := s.loadKnownSeries(ctx)
+	if err != nil {
+		slog.Error("Failed to list series identifiers", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to list series")
+		return
+	}
+
+	results := make([]DiscoveredSeries, len(authors))
+	now := time.Now()
+	for i, author := range authors {
+		goodreadsID := *author.GoodreadsID
+		cached, refresh := s.authorSeries.lookup(goodreadsID, now)
+		results[i] = DiscoveredSeries{
+			AuthorID:          author.ID,
+			AuthorName:        author.Name,
+			GoodreadsAuthorID: goodreadsID,
+			Series:            []goodreads.AuthorSeries{},
+			Pending:           cached.pending,
+			Error:             cached.err,
+		}
+		if refresh {
+			_, err := s.jobs.Enqueue(ctx, jobDiscoverAuthorSeries, DiscoverAuthorSeriesJob{GoodreadsAuthorID: goodreadsID})
+			if err != nil {
+				slog.Error("Failed to queue author series discovery", slog.String("goodreads_author_id", goodreadsID), slog.Any("error", err))
+				s.authorSeries.unqueue(goodreadsID)
+				results[i].Pending = false
+				results[i].Error = "Failed to queue the Goodreads lookup"
+			}
+		}
+		for _, series := range cached.series {
+			if !known.contains(series) {
+				results[i].Series = append(results[i].Series, series)
+			}
+		}
+	}
+
+	writeJSON(w, results)
+}
+
+// authorSeriesCache keeps the series lists scraped from Goodreads author pages, by Goodreads author ID
+type authorSeriesCache struct {
+	mu      sync.Mutex
+	entries map[string]*authorSeriesEntry
+}
+
+type authorSeriesEntry struct {
+	series []goodreads.AuthorSeries
+	// fetchedAt is when series was scraped; zero until a scrape has succeeded
+	fetchedAt time.Time
+	// queuedAt is when a scrape was queued; zero when none is outstanding
+	queuedAt time.Time
+	// err is why the last scrape failed
+	err string
+}
+
+// cachedAuthorSeries is what the cache knows about an author
+type cachedAuthorSeries struct {
+	series  []goodreads.AuthorSeries
+	pending bool
+	err     string
+}
+
+// lookup returns what is cached for the author. refresh reports that the list is missing or stale and no
+// scrape is outstanding; the caller is expected to queue one, which lookup already counts as queued.
+func (c *authorSeriesCache) lookup(authorID string, now time.Time) (cached cachedAuthorSeries, refresh bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[string]*authorSeriesEntry)
+	}
+	entry, ok := c.entries[authorID]
+	if !ok {
+		entry = &authorSeriesEntry{}
+		c.entries[authorID] = entry
+	}
+
+	fresh := !entry.fetchedAt.IsZero() && now.Sub(entry.fetchedAt) < authorSeriesTTL
+	queued := !entry.queuedAt.IsZero() && now.Sub(entry.queuedAt) < authorSeriesRequeueAfter
+	refresh = !fresh && !queued
+	if refresh {
+		entry.queuedAt = now
+	}
+	return cachedAuthorSeries{series: entry.series, pending: !fresh, err: entry.err}, refresh
+}
+
+// unqueue forgets a scrape that couldn't be queued, so the next lookup tries again
+func (c *authorSeriesCache) unqueue(authorID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry, ok := c.entries[authorID]; ok {
+		entry.queuedAt = time.Time{}
+	}
+}
+
+// store caches a freshly scraped series list
+func (c *authorSeriesCache) store(authorID string, series []goodreads.AuthorSeries, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[string]*authorSeriesEntry)
+	}
+	c.entries[authorID] = &authorSeriesEntry{series: series, fetchedAt: now}
+}
+
+// fail records why a scrape failed; the job retries it, so it stays queued
+func (c *authorSeriesCache) fail(authorID string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry, ok := c.entries[authorID]; ok {
+		entry.err = err.Error()
+	}
+}
+
+// knownSeries holds the Goodreads IDs and names of the series we already track
+type knownSeries struct {
+	goodreadsIDs map[string]bool
+	names        map[string]bool
+}
+
+func (s *Server) loadKnownSeries(ctx context.Context) (knownSeries, error) {
+	rows, err := s.queries.ListSeriesIdentifiers(ctx)
+	if err != nil {
+		return knownSeries{}, err
+	}
+
+	known := knownSeries{
+		goodreadsIDs: make(map[string]bool, len(rows)),
+		names:        make(map[string]bool, len(rows)),
+	}
+	for _, row := range rows {
+		// Series synced from Booklore have no Goodreads ID yet, so match those by name
+		if row.SeriesID != 0 {
+			known.goodreadsIDs[strconv.FormatInt(row.SeriesID, 10)] = true
+		}
+		known.names[normalizeSeriesName(row.Name)] = true
+	}
+	return known, nil
+}
+
+func (k knownSeries) contains(series goodreads.AuthorSeries) bool {
+	return k.goodreadsIDs[series.ID] || k.names[normalizeSeriesName(series.Title)]
+}
+
+func normalizeSeriesName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
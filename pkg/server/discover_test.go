@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/goodreads"
+	"github.com/amalgamated-tools/bookscraping/pkg/jobs"
+	"github.com/stretchr/testify/mock"
+)
+
+const authorSeriesPage = `<html><body><div class="mainContent">
+<div class="seriesDesc">
+<a class="bookTitle" href="/series/53205-david-trevellyan">David Trevellyan</a>
+<span class="greyText">3 primary works &bull; 3 total works</span>
+</div>
+<div class="seriesDesc">
+<a class="bookTitle" href="/series/300001-cooper-fisher">Cooper Fisher</a>
+<span class="greyText">2 primary works &bull; 2 total works</span>
+</div>
+</div></body></html>`
+
+func TestServer_handleDiscoverSeries(t *testing.T) {
+	var scraped atomic.Int32
+	grServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scraped.Add(1)
+		_, _ = w.Write([]byte(authorSeriesPage))
+	}))
+	defer grServer.Close()
+
+	goodreadsID := "2922340"
+	mockQuerier := db.NewMockQuerier(t)
+	mockQuerier.On("ListAuthorsWithGoodreadsID", mock.Anything).Return([]db.Author{{ID: 9, Name: "Andrew Grant", GoodreadsID: &goodreadsID}}, nil)
+	mockQuerier.On("ListSeriesIdentifiers", mock.Anything).Return([]db.ListSeriesIdentifiersRow{{ID: 1, SeriesID: 53205, Name: "David Trevellyan"}}, nil)
+	// Only one scrape is queued, however often the author is asked for before it runs
+	mockQuerier.On("EnqueueJob", mock.Anything, mock.MatchedBy(func(arg db.EnqueueJobParams) bool {
+		return arg.Kind == jobDiscoverAuthorSeries && arg.Payload == `{"goodreads_author_id":"2922340"}`
+	})).Return(db.Job{ID: 1}, nil).Once()
+
+	server := &Server{
+		queries:  mockQuerier,
+		grClient: goodreads.NewClient(goodreads.WithBaseURL(grServer.URL)),
+		jobs:     jobs.New(mockQuerier),
+	}
+	server.registerJobs()
+
+	discover := func() DiscoveredSeries {
+		t.Helper()
+		w := httptest.NewRecorder()
+		server.handleDiscoverSeries(w, httptest.NewRequest("GET", "/api/discover/series", nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("status = %d, body = %s", w.Code, w.Body)
+		}
+		var results []DiscoveredSeries
+		if err := json.NewDecoder(w.Body).Decode(&results); err != nil {
+			t.Fatalf("decoding response: %v", err)
+		}
+		if len(results) != 1 {
+			t.Fatalf("results = %+v, want one author", results)
+		}
+		return results[0]
+	}
+
+	// Nothing is scraped while the request waits
+	for range 2 {
+		if result := discover(); !result.Pending || len(result.Series) != 0 {
+			t.Errorf("before the job ran = %+v, want pending without series", result)
+		}
+	}
+	if got := scraped.Load(); got != 0 {
+		t.Errorf("scraped Goodreads %d times during the requests", got)
+	}
+
+	if err := server.runDiscoverAuthorSeriesJob(context.Background(), DiscoverAuthorSeriesJob{GoodreadsAuthorID: goodreadsID}); err != nil {
+		t.Fatalf("runDiscoverAuthorSeriesJob() error = %v", err)
+	}
+	result := discover()
+	if result.Pending || len(result.Series) != 1 || result.Series[0].ID != "300001" {
+		t.Errorf("after the job ran = %+v, want only Cooper Fisher", result)
+	}
+	if got := scraped.Load(); got != 1 {
+		t.Errorf("scraped Goodreads %d times, want once", got)
+	}
+}
+
+func TestAuthorSeriesCache_lookup(t *testing.T) {
+	var cache authorSeriesCache
+	now := time.Now()
+
+	if _, refresh := cache.lookup("1", now); !refresh {
+		t.Error("lookup() of an unknown author should ask for a refresh")
+	}
+	if _, refresh := cache.lookup("1", now.Add(time.Minute)); refresh {
+		t.Error("lookup() asked for a second refresh while one is queued")
+	}
+	if _, refresh := cache.lookup("1", now.Add(authorSeriesRequeueAfter)); !refresh {
+		t.Error("lookup() should queue again once the queued refresh is overdue")
+	}
+
+	cache.store("1", []goodreads.AuthorSeries{{ID: "5"}}, now)
+	if cached, refresh := cache.lookup("1", now.Add(time.Hour)); refresh || cached.pending || len(cached.series) != 1 {
+		t.Errorf("lookup() of a fresh list = %+v, refresh %v", cached, refresh)
+	}
+	// A stale list is still served while it is refreshed
+	if cached, refresh := cache.lookup("1", now.Add(authorSeriesTTL)); !refresh || !cached.pending || len(cached.series) != 1 {
+		t.Errorf("lookup() of a stale list = %+v, refresh %v", cached, refresh)
+	}
+}
@@ -0,0 +1,196 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/identifiers"
+	"github.com/amalgamated-tools/bookscraping/pkg/openlibrary"
+)
+
+// defaultEnrichLimit bounds how many books one enrichment request looks up
+const defaultEnrichLimit = 20
+
+// enrichRetryDays is how long a book Open Library couldn't help with waits before it is looked up again.
+// Without it, the same unresolvable books would fill every pass and the rest would never be reached.
+const enrichRetryDays = 30
+
+// EnrichResponse reports what an enrichment run changed
+type EnrichResponse struct {
+	GoodreadsIDsFilled int `json:"goodreads_ids_filled"`
+	BooksEnriched      int `json:"books_enriched"`
+	Failed             int `json:"failed"`
+}
+
+// handleEnrichBooks uses Open Library ISBN lookups to fill in missing Goodreads IDs on owned books
+// and covers, descriptions and release dates on missing books
+func (s *Server) handleEnrichBooks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	limit := int64(defaultEnrichLimit)
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		l, err := strconv.ParseInt(limitStr, 10, 64)
+		if err != nil || l < 1 {
+			writeError(w, http.StatusBadRequest, "Invalid limit")
+			return
+		}
+		limit = l
+	}
+
+	response, err := s.enrichBooks(ctx, limit)
+	if err != nil {
+		slog.Error("Failed to enrich books", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to list books")
+		return
+	}
+
+	writeJSON(w, response)
+}
+
+// enrichBooks runs one enrichment pass over at most limit books of each kind
+func (s *Server) enrichBooks(ctx context.Context, limit int64) (EnrichResponse, error) {
+	var response EnrichResponse
+
+	withoutGoodreads, err := s.queries.ListBooksWithoutGoodreadsID(ctx, db.ListBooksWithoutGoodreadsIDParams{
+		RetryDays: enrichRetryDays,
+		Limit:     limit,
+	})
+	if err != nil {
+		return response, fmt.Errorf("listing books without Goodreads IDs: %w", err)
+	}
+	filled, failed := s.fillGoodreadsIDs(ctx, withoutGoodreads)
+	response.GoodreadsIDsFilled = filled
+	response.Failed += failed
+
+	missing, err := s.queries.ListMissingBooksToEnrich(ctx, db.ListMissingBooksToEnrichParams{
+		RetryDays: enrichRetryDays,
+		Limit:     limit,
+	})
+	if err != nil {
+		return response, fmt.Errorf("listing missing books to enrich: %w", err)
+	}
+	for _, book := range missing {
+		result, err := s.lookupISBN(ctx, book)
+		if err := s.queries.MarkEnrichLookup(ctx, book.ID); err != nil {
+			slog.Error("Failed to record enrichment lookup", slog.Int64("book_id", book.ID), slog.Any("error", err))
+		}
+		if err != nil {
+			response.Failed++
+			continue
+		}
+		if result == nil {
+			continue
+		}
+
+		var goodreadsID *string
+		if len(result.GoodreadsIDs) > 0 {
+			goodreadsID = optionalString(identifiers.GoodreadsID(result.GoodreadsIDs[0]))
+		}
+		description, descriptionMarkdown := convertDescription(result.Description)
+		err = s.queries.EnrichBook(ctx, db.EnrichBookParams{
+			CoverUrl:            optionalString(result.CoverURL),
+			Description:         description,
+			DescriptionMarkdown: descriptionMarkdown,
+			PublicationDate:     optionalString(result.PublishDate),
+			GoodreadsID:         goodreadsID,
+			ID:                  book.ID,
+		})
+		if err != nil {
+			slog.Error("Failed to enrich book", slog.Int64("book_id", book.ID), slog.Any("error", err))
+			response.Failed++
+			continue
+		}
+		response.BooksEnriched++
+	}
+
+	return response, nil
+}
+
+// handleLookupISBN resolves an ISBN through Open Library
+func (s *Server) handleLookupISBN(w http.ResponseWriter, r *http.Request) {
+	result, err := s.olClient.LookupISBN(r.Context(), r.PathValue("isbn"))
+	if errors.Is(err, openlibrary.ErrNotFound) {
+		writeError(w, http.StatusNotFound, "ISBN not found on Open Library")
+		return
+	}
+	if err != nil {
+		slog.Error("Failed to look up ISBN", slog.String("isbn", r.PathValue("isbn")), slog.Any("error", err))
+		writeError(w, http.StatusBadGateway, "Open Library lookup failed")
+		return
+	}
+
+	writeJSON(w, result)
+}
+
+// fillGoodreadsIDs looks up books that have an ISBN but no Goodreads ID and stores the ID Open Library knows.
+// Every lookup is recorded, so books Open Library can't resolve wait before they are tried again.
+// The books are updated in place.
+func (s *Server) fillGoodreadsIDs(ctx context.Context, books []db.Book) (filled, failed int) {
+	for i := range books {
+		book := &books[i]
+		if book.GoodreadsID != nil && *book.GoodreadsID != "" || bookISBN(*book) == "" {
+			continue
+		}
+
+		result, err := s.lookupISBN(ctx, *book)
+		if err := s.queries.MarkGoodreadsIDLookup(ctx, book.ID); err != nil {
+			slog.Error("Failed to record Goodreads ID lookup", slog.Int64("book_id", book.ID), slog.Any("error", err))
+		}
+		if err != nil {
+			failed++
+			continue
+		}
+		if result == nil || len(result.GoodreadsIDs) == 0 {
+			continue
+		}
+
+		goodreadsID := identifiers.GoodreadsID(result.GoodreadsIDs[0])
+		if goodreadsID == "" {
+			continue
+		}
+		if err := s.queries.SetBookGoodreadsID(ctx, db.SetBookGoodreadsIDParams{GoodreadsID: &goodreadsID, ID: book.ID}); err != nil {
+			slog.Error("Failed to store Goodreads ID", slog.Int64("book_id", book.ID), slog.Any("error", err))
+			failed++
+			continue
+		}
+		book.GoodreadsID = &goodreadsID
+		slog.Info("Filled Goodreads ID from Open Library", slog.String("title", book.Title), slog.String("goodreads_id", goodreadsID))
+		filled++
+	}
+	return filled, failed
+}
+
+// lookupISBN resolves a book's ISBN-13, or ISBN-10, through Open Library.
+// It returns nil without an error when the book has no ISBN or Open Library doesn't know it.
+func (s *Server) lookupISBN(ctx context.Context, book db.Book) (*openlibrary.ISBNResult, error) {
+	isbn := bookISBN(book)
+	if isbn == "" {
+		return nil, nil
+	}
+
+	result, err := s.olClient.LookupISBN(ctx, isbn)
+	if errors.Is(err, openlibrary.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		slog.Error("Open Library lookup failed", slog.String("isbn", isbn), slog.Any("error", err))
+		return nil, err
+	}
+	return result, nil
+}
+
+// bookISBN returns a book's ISBN-13, or its ISBN-10 when it has no ISBN-13
+func bookISBN(book db.Book) string {
+	if book.Isbn13 != nil && *book.Isbn13 != "" {
+		return *book.Isbn13
+	}
+	if book.Isbn10 != nil && *book.Isbn10 != "" {
+		return *book.Isbn10
+	}
+	return ""
+}
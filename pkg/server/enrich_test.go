@@ -0,0 +1,83 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/openlibrary"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServer_fillGoodreadsIDs(t *testing.T) {
+	olServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/isbn/9780312383053.json":
+			_, _ = w.Write([]byte(`{"key":"/books/OL24380213M","title":"Die Twice","identifiers":{"goodreads":["7315139"]}}`))
+		case "/isbn/9780000000000.json":
+			_, _ = w.Write([]byte(`{"key":"/books/OL1M","title":"No Goodreads"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer olServer.Close()
+
+	strPtr := func(s string) *string { return &s }
+	books := []db.Book{
+		{ID: 1, Title: "Die Twice", Isbn13: strPtr("9780312383053")},
+		{ID: 2, Title: "Already linked", Isbn13: strPtr("9780312383046"), GoodreadsID: strPtr("123")},
+		{ID: 3, Title: "Unknown to Open Library", Isbn10: strPtr("0000000000")},
+		{ID: 4, Title: "No Goodreads identifier", Isbn13: strPtr("9780000000000")},
+		{ID: 5, Title: "No ISBN"},
+	}
+
+	mockQuerier := db.NewMockQuerier(t)
+	mockQuerier.On("SetBookGoodreadsID", mock.Anything, mock.MatchedBy(func(arg db.SetBookGoodreadsIDParams) bool {
+		return arg.ID == 1 && *arg.GoodreadsID == "7315139"
+	})).Return(nil).Once()
+	// Misses are recorded too, so they don't crowd out other books on the next pass
+	for _, id := range []int64{1, 3, 4} {
+		mockQuerier.On("MarkGoodreadsIDLookup", mock.Anything, id).Return(nil).Once()
+	}
+
+	server := &Server{
+		queries:  mockQuerier,
+		olClient: openlibrary.NewClient(openlibrary.WithBaseURL(olServer.URL)),
+	}
+
+	filled, failed := server.fillGoodreadsIDs(context.Background(), books)
+	if filled != 1 || failed != 0 {
+		t.Errorf("fillGoodreadsIDs() = %d filled, %d failed; want 1, 0", filled, failed)
+	}
+	if books[0].GoodreadsID == nil || *books[0].GoodreadsID != "7315139" {
+		t.Errorf("book was not updated in place: %+v", books[0].GoodreadsID)
+	}
+}
+
+func TestServer_enrichBooks_recordsMisses(t *testing.T) {
+	olServer := httptest.NewServer(http.NotFoundHandler())
+	defer olServer.Close()
+
+	isbn := "9780000000000"
+	missing := []db.Book{{ID: 7, Title: "Unknown to Open Library", Isbn13: &isbn}}
+
+	mockQuerier := db.NewMockQuerier(t)
+	mockQuerier.On("ListBooksWithoutGoodreadsID", mock.Anything, db.ListBooksWithoutGoodreadsIDParams{RetryDays: enrichRetryDays, Limit: 5}).Return(nil, nil).Once()
+	mockQuerier.On("ListMissingBooksToEnrich", mock.Anything, db.ListMissingBooksToEnrichParams{RetryDays: enrichRetryDays, Limit: 5}).Return(missing, nil).Once()
+	mockQuerier.On("MarkEnrichLookup", mock.Anything, int64(7)).Return(nil).Once()
+
+	server := &Server{
+		queries:  mockQuerier,
+		olClient: openlibrary.NewClient(openlibrary.WithBaseURL(olServer.URL)),
+	}
+
+	response, err := server.enrichBooks(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("enrichBooks() error = %v", err)
+	}
+	if response.BooksEnriched != 0 || response.Failed != 0 {
+		t.Errorf("enrichBooks() = %+v", response)
+	}
+}
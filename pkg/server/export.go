@@ -0,0 +1,181 @@
+package server
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+)
+
+// MissingBookExport is a missing book as GET /api/export/missing lists it
+type MissingBookExport struct {
+	Title    string   `json:"title"`
+	Series   string   `json:"series"`
+	Position *float64 `json:"position"`
+	// Authors is a comma-separated list of author names
+	Authors         string `json:"authors"`
+	GoodreadsURL    string `json:"goodreads_url"`
+	ISBN            string `json:"isbn"`
+	PublicationDate string `json:"publication_date"`
+	Unreleased      bool   `json:"unreleased"`
+}
+
+// Columns of the CSV and Markdown exports
+var missingExportColumns = []string{"title", "series", "position", "authors", "goodreads_url", "isbn", "publication_date", "unreleased"}
+
+// handleExportMissing exports the missing books as format=json (default), csv or md.
+// Filters: series_id, author_id and status=released|unreleased.
+func (s *Server) handleExportMissing(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+
+	format := params.Get("format")
+	switch format {
+	case "":
+		format = "json"
+	case "json", "csv", "md":
+	default:
+		writeError(w, http.StatusBadRequest, "format must be json, csv or md")
+		return
+	}
+
+	var filters db.ListMissingBooksForExportParams
+	for name, target := range map[string]**int64{"series_id": &filters.SeriesID, "author_id": &filters.AuthorID} {
+		value := params.Get(name)
+		if value == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid %s %q", name, value))
+			return
+		}
+		*target = &id
+	}
+
+	switch status := params.Get("status"); status {
+	case "":
+	case "released", "unreleased":
+		unreleased := status == "unreleased"
+		filters.Unreleased = &unreleased
+	default:
+		writeError(w, http.StatusBadRequest, "status must be released or unreleased")
+		return
+	}
+
+	rows, err := s.queries.ListMissingBooksForExport(r.Context(), filters)
+	if err != nil {
+		slog.Error("Failed to list missing books for export", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to export missing books")
+		return
+	}
+
+	books := make([]MissingBookExport, len(rows))
+	for i, row := range rows {
+		books[i] = missingBookExport(row)
+	}
+
+	switch format {
+	case "json":
+		writeJSON(w, books)
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="missing-books.csv"`)
+		if err := writeMissingCSV(w, books); err != nil {
+			slog.Error("Failed to write missing books CSV", slog.Any("error", err))
+		}
+	case "md":
+		w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+		w.Header().Set("Content-Disposition", `inline; filename="missing-books.md"`)
+		if err := writeMissingMarkdown(w, books); err != nil {
+			slog.Error("Failed to write missing books Markdown", slog.Any("error", err))
+		}
+	}
+}
+
+// missingBookExport flattens an export row, preferring the ISBN-13 over the ISBN-10
+func missingBookExport(row db.ListMissingBooksForExportRow) MissingBookExport {
+	book := MissingBookExport{
+		Title:           row.Title,
+		Series:          row.SeriesName,
+		Position:        row.SeriesNumber,
+		Authors:         row.Authors,
+		PublicationDate: stringValue(row.PublicationDate),
+		Unreleased:      row.Unreleased,
+	}
+	if id := stringValue(row.GoodreadsID); id != "" {
+		book.GoodreadsURL = "https://www.goodreads.com/book/show/" + id
+	}
+	book.ISBN = stringValue(row.Isbn13)
+	if book.ISBN == "" {
+		book.ISBN = stringValue(row.Isbn10)
+	}
+	return book
+}
+
+// fields returns the book's values in missingExportColumns order
+func (b MissingBookExport) fields() []string {
+	position := ""
+	if b.Position != nil {
+		position = strconv.FormatFloat(*b.Position, 'f', -1, 64)
+	}
+	return []string{b.Title, b.Series, position, b.Authors, b.GoodreadsURL, b.ISBN, b.PublicationDate, strconv.FormatBool(b.Unreleased)}
+}
+
+// writeMissingCSV writes the books as CSV with a header row
+func writeMissingCSV(w io.Writer, books []MissingBookExport) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(missingExportColumns); err != nil {
+		return err
+	}
+	for _, book := range books {
+		fields := book.fields()
+		for i, field := range fields {
+			fields[i] = csvCell(field)
+		}
+		if err := cw.Write(fields); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// csvCell stops a spreadsheet from reading a value as a formula, by prefixing it with a quote
+// when it starts with a character that would begin one
+func csvCell(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// writeMissingMarkdown writes the books as a Markdown table
+func writeMissingMarkdown(w io.Writer, books []MissingBookExport) error {
+	var b strings.Builder
+	b.WriteString("| " + strings.Join(missingExportColumns, " | ") + " |\n")
+	b.WriteString(strings.Repeat("| --- ", len(missingExportColumns)) + "|\n")
+	for _, book := range books {
+		fields := book.fields()
+		for i, field := range fields {
+			fields[i] = markdownCell(field)
+		}
+		if book.GoodreadsURL != "" {
+			fields[4] = "[Goodreads](" + book.GoodreadsURL + ")"
+		}
+		b.WriteString("| " + strings.Join(fields, " | ") + " |\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// markdownCell escapes a value for a Markdown table cell
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.Join(strings.Fields(s), " ")
+}
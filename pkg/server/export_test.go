@@ -0,0 +1,117 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServer_handleExportMissing(t *testing.T) {
+	position := 2.0
+	goodreadsID := "7315139"
+	isbn10 := "0735219346"
+	seriesID := int64(3)
+	unreleased := true
+	rows := []db.ListMissingBooksForExportRow{{
+		ID:           1,
+		Title:        "Die Twice",
+		SeriesName:   "David Trevellyan",
+		SeriesNumber: &position,
+		Authors:      "Andrew Grant",
+		GoodreadsID:  &goodreadsID,
+		Isbn10:       &isbn10,
+		Unreleased:   true,
+	}}
+
+	tests := []struct {
+		name        string
+		query       string
+		filters     db.ListMissingBooksForExportParams
+		contentType string
+		want        []string
+	}{
+		{
+			name:        "json",
+			query:       "",
+			contentType: "application/json",
+			want:        []string{`"goodreads_url":"https://www.goodreads.com/book/show/7315139"`, `"isbn":"0735219346"`, `"position":2`},
+		},
+		{
+			name:        "csv",
+			query:       "format=csv",
+			contentType: "text/csv; charset=utf-8",
+			want: []string{
+				"title,series,position,authors,goodreads_url,isbn,publication_date,unreleased\n",
+				"Die Twice,David Trevellyan,2,Andrew Grant,https://www.goodreads.com/book/show/7315139,0735219346,,true\n",
+			},
+		},
+		{
+			name:        "markdown with filters",
+			query:       "format=md&series_id=3&status=unreleased",
+			filters:     db.ListMissingBooksForExportParams{SeriesID: &seriesID, Unreleased: &unreleased},
+			contentType: "text/markdown; charset=utf-8",
+			want:        []string{"| Die Twice | David Trevellyan | 2 | Andrew Grant | [Goodreads](https://www.goodreads.com/book/show/7315139) | 0735219346 |  | true |\n"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQuerier := db.NewMockQuerier(t)
+			mockQuerier.On("ListMissingBooksForExport", mock.Anything, tt.filters).Return(rows, nil).Once()
+
+			server := &Server{queries: mockQuerier}
+			req := httptest.NewRequest("GET", "/api/export/missing?"+tt.query, nil)
+			w := httptest.NewRecorder()
+			server.handleExportMissing(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("status = %d, body = %s", w.Code, w.Body)
+			}
+			if got := w.Header().Get("Content-Type"); got != tt.contentType {
+				t.Errorf("Content-Type = %q, want %q", got, tt.contentType)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(w.Body.String(), want) {
+					t.Errorf("body %q does not contain %q", w.Body, want)
+				}
+			}
+		})
+	}
+
+	t.Run("unknown format", func(t *testing.T) {
+		server := &Server{queries: db.NewMockQuerier(t)}
+		req := httptest.NewRequest("GET", "/api/export/missing?format=xlsx", nil)
+		w := httptest.NewRecorder()
+		server.handleExportMissing(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("status = %d, want %d", w.Code, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestCSVCell(t *testing.T) {
+	for value, want := range map[string]string{
+		"=HYPERLINK(\"http://evil\")": "'=HYPERLINK(\"http://evil\")",
+		"+1":                          "'+1",
+		"-1+2":                        "'-1+2",
+		"@SUM(A1)":                    "'@SUM(A1)",
+		"\t=1":                        "'\t=1",
+		"Die Twice":                   "Die Twice",
+		"":                            "",
+	} {
+		if got := csvCell(value); got != want {
+			t.Errorf("csvCell(%q) = %q, want %q", value, got, want)
+		}
+	}
+}
+
+func TestMarkdownCell(t *testing.T) {
+	if got := markdownCell("Fire | Ice\n\\ Part 2"); got != `Fire \| Ice \\ Part 2` {
+		t.Errorf("markdownCell() = %q", got)
+	}
+}
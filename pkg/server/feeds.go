@@ -0,0 +1,63 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/opds"
+)
+
+// feedLimit is how many books the Atom feeds list
+const feedLimit = 50
+
+// handleMissingFeed serves an Atom feed of missing books, most recently discovered first
+func (s *Server) handleMissingFeed(w http.ResponseWriter, r *http.Request) {
+	books, err := s.queries.ListRecentlyDiscoveredBooks(r.Context(), feedLimit)
+	if err != nil {
+		slog.Error("Failed to list recently discovered books", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to list missing books")
+		return
+	}
+
+	s.writeBookFeed(w, r, "missing", "Newly discovered missing books", books)
+}
+
+// handleUpcomingFeed serves an Atom feed of unreleased missing books, most recently discovered first
+func (s *Server) handleUpcomingFeed(w http.ResponseWriter, r *http.Request) {
+	books, err := s.queries.ListRecentlyDiscoveredUpcomingBooks(r.Context(), feedLimit)
+	if err != nil {
+		slog.Error("Failed to list recently discovered upcoming books", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to list upcoming books")
+		return
+	}
+
+	s.writeBookFeed(w, r, "upcoming", "Newly discovered upcoming books", books)
+}
+
+// writeBookFeed writes books as the Atom feed /feeds/<name>.atom, updated when its newest book was discovered
+func (s *Server) writeBookFeed(w http.ResponseWriter, r *http.Request, name, title string, books []db.Book) {
+	entries := s.bookEntries(r.Context(), books)
+	for i, book := range books {
+		entries[i].Title = bookDisplayTitle(book)
+	}
+
+	updated := time.Now()
+	if len(books) > 0 && books[0].DiscoveredAt != nil {
+		updated = *books[0].DiscoveredAt
+	}
+
+	feed := opds.Feed{
+		ID:      "urn:bookscraping:feeds:" + name,
+		Title:   title,
+		Updated: updated,
+		Links:   []opds.Link{{Rel: opds.RelSelf, Href: "/feeds/" + name + ".atom", Type: opds.AtomType}},
+		Entries: entries,
+	}
+
+	w.Header().Set("Content-Type", opds.AtomType+"; charset=utf-8")
+	if err := feed.Write(w); err != nil {
+		slog.Error("Failed to write Atom feed", slog.String("feed", name), slog.Any("error", err))
+	}
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServer_handleMissingFeed(t *testing.T) {
+	missing := true
+	seriesName := "David Trevellyan"
+	position := 3.0
+	newer := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	older := time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+
+	mockQuerier := db.NewMockQuerier(t)
+	mockQuerier.On("ListRecentlyDiscoveredBooks", mock.Anything, int64(feedLimit)).Return([]db.Book{
+		{ID: 2, Title: "Invincible", IsMissing: &missing, SeriesName: &seriesName, SeriesNumber: &position, DiscoveredAt: &newer},
+		{ID: 1, Title: "Die Twice", IsMissing: &missing, DiscoveredAt: &older},
+	}, nil).Once()
+	mockQuerier.On("GetConfig", mock.Anything, "serverUrl").Return("", nil).Once()
+	mockQuerier.On("GetAuthorsForBook", mock.Anything, mock.Anything).Return([]db.Author{}, nil).Twice()
+
+	server := &Server{queries: mockQuerier}
+	req := httptest.NewRequest("GET", "/feeds/missing.atom", nil)
+	w := httptest.NewRecorder()
+	server.handleMissingFeed(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body)
+	}
+	if got := w.Header().Get("Content-Type"); got != "application/atom+xml; charset=utf-8" {
+		t.Errorf("Content-Type = %q", got)
+	}
+
+	body := w.Body.String()
+	for _, want := range []string{
+		`<link rel="self" href="/feeds/missing.atom" type="application/atom+xml"></link>`,
+		"<title>Invincible (David Trevellyan, #3)</title>",
+		"<updated>2026-10-01T08:00:00Z</updated>",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("feed does not contain %q:\n%s", want, body)
+		}
+	}
+	// The feed is as fresh as its newest book
+	if !strings.Contains(body, "<title>Newly discovered missing books</title>\n  <updated>2026-10-18T09:30:00Z</updated>") {
+		t.Errorf("feed updated is not the newest discovery:\n%s", body)
+	}
+	if strings.Index(body, "Invincible") > strings.Index(body, "Die Twice") {
+		t.Errorf("books are not newest first:\n%s", body)
+	}
+}
@@ -0,0 +1,277 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/goodreads"
+	"github.com/amalgamated-tools/bookscraping/pkg/jobs"
+)
+
+// Job kinds the server knows how to run
+const (
+	jobCompleteSeries       = "complete_series"
+	jobEnrichBooks          = "enrich_books"
+	jobDiscoverAuthorSeries = "discover_author_series"
+)
+
+const (
+	// defaultJobsListed is how many jobs GET /api/jobs returns unless asked otherwise
+	defaultJobsListed = 50
+	// maxJobsListed bounds the limit a caller can ask for
+	maxJobsListed = 500
+)
+
+// CompleteSeriesJob is the payload of a complete_series job
+type CompleteSeriesJob struct {
+	SeriesID int64  `json:"series_id"`
+	Provider string `json:"provider,omitempty"`
+}
+
+// EnrichBooksJob is the payload of an enrich_books job
+type EnrichBooksJob struct {
+	Limit int64 `json:"limit,omitempty"`
+}
+
+// DiscoverAuthorSeriesJob is the payload of a discover_author_series job
+type DiscoverAuthorSeriesJob struct {
+	GoodreadsAuthorID string `json:"goodreads_author_id"`
+}
+
+// Job is a queued job with its payload as JSON rather than a string
+type Job struct {
+	*db.Job
+	Payload json.RawMessage `json:"payload"`
+}
+
+// JobsResponse lists jobs along with how many jobs are in each status
+type JobsResponse struct {
+	Jobs   []Job            `json:"jobs"`
+	Counts map[string]int64 `json:"counts"`
+	Kinds  []string         `json:"kinds"`
+}
+
+// EnqueueJobRequest is the body of POST /api/jobs
+type EnqueueJobRequest struct {
+	Kind    string          `json:"kind"`
+	Payload json.RawMessage `json:"payload"`
+	// Delay is how many seconds to wait before running the job
+	Delay int64 `json:"delay"`
+}
+
+// registerJobs registers the handlers for the job kinds the server runs
+func (s *Server) registerJobs() {
+	jobs.Register(s.jobs, jobCompleteSeries, s.runCompleteSeriesJob)
+	jobs.Register(s.jobs, jobEnrichBooks, s.runEnrichBooksJob)
+	jobs.Register(s.jobs, jobDiscoverAuthorSeries, s.runDiscoverAuthorSeriesJob)
+}
+
+// runCompleteSeriesJob completes one series from its metadata provider
+func (s *Server) runCompleteSeriesJob(ctx context.Context, job CompleteSeriesJob) error {
+	provider, err := s.metadataProvider(job.Provider)
+	if err != nil {
+		return jobs.Permanent(err)
+	}
+
+	response, err := s.completeSeries(ctx, job.SeriesID, provider)
+	var completionErr *completionError
+	if errors.As(err, &completionErr) && completionErr.status == http.StatusNotFound {
+		return jobs.Permanent(err)
+	}
+	if err != nil {
+		return err
+	}
+
+	slog.Info("Completed series from job", slog.Int64("series_id", job.SeriesID), slog.Int("new_missing_books", response.NewMissingBooks))
+	return nil
+}
+
+// runEnrichBooksJob runs one Open Library enrichment pass
+func (s *Server) runEnrichBooksJob(ctx context.Context, job EnrichBooksJob) error {
+	limit := job.Limit
+	if limit < 1 {
+		limit = defaultEnrichLimit
+	}
+
+	response, err := s.enrichBooks(ctx, limit)
+	if err != nil {
+		return err
+	}
+
+	slog.Info("Enriched books from job",
+		slog.Int("goodreads_ids_filled", response.GoodreadsIDsFilled),
+		slog.Int("books_enriched", response.BooksEnriched),
+		slog.Int("failed", response.Failed),
+	)
+	return nil
+}
+
+// runDiscoverAuthorSeriesJob scrapes an author's series list from Goodreads into the discovery cache
+func (s *Server) runDiscoverAuthorSeriesJob(ctx context.Context, job DiscoverAuthorSeriesJob) error {
+	series, err := s.grClient.GetAuthorSeries(ctx, job.GoodreadsAuthorID)
+	if err != nil {
+		if ctx.Err() == nil {
+			s.authorSeries.fail(job.GoodreadsAuthorID, err)
+		}
+		if errors.Is(err, goodreads.ErrNotFound) {
+			return jobs.Permanent(err)
+		}
+		return err
+	}
+
+	s.authorSeries.store(job.GoodreadsAuthorID, series, time.Now())
+	slog.Info("Discovered author series", slog.String("goodreads_author_id", job.GoodreadsAuthorID), slog.Int("series", len(series)))
+	return nil
+}
+
+// handleListJobs lists recent jobs, optionally filtered by status and kind
+func (s *Server) handleListJobs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := r.URL.Query()
+
+	limit := int64(defaultJobsListed)
+	if value := params.Get("limit"); value != "" {
+		l, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || l < 1 || l > maxJobsListed {
+			writeError(w, http.StatusBadRequest, "limit must be between 1 and "+strconv.Itoa(maxJobsListed))
+			return
+		}
+		limit = l
+	}
+
+	rows, err := s.queries.ListJobs(ctx, db.ListJobsParams{
+		Status: optionalString(params.Get("status")),
+		Kind:   optionalString(params.Get("kind")),
+		Limit:  limit,
+	})
+	if err != nil {
+		slog.Error("Failed to list jobs", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to list jobs")
+		return
+	}
+
+	counts, err := s.queries.CountJobsByStatus(ctx)
+	if err != nil {
+		slog.Error("Failed to count jobs", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to count jobs")
+		return
+	}
+
+	response := JobsResponse{
+		Jobs:   make([]Job, 0, len(rows)),
+		Counts: make(map[string]int64, len(counts)),
+		Kinds:  s.jobs.Kinds(),
+	}
+	for i := range rows {
+		response.Jobs = append(response.Jobs, jobResponse(&rows[i]))
+	}
+	for _, count := range counts {
+		response.Counts[count.Status] = count.Count
+	}
+
+	writeJSON(w, response)
+}
+
+// handleEnqueueJob queues a job of a registered kind
+func (s *Server) handleEnqueueJob(w http.ResponseWriter, r *http.Request) {
+	var req EnqueueJobRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if req.Delay < 0 {
+		writeError(w, http.StatusBadRequest, "delay must not be negative")
+		return
+	}
+	if len(req.Payload) == 0 {
+		req.Payload = json.RawMessage("{}")
+	}
+
+	job, err := s.jobs.Enqueue(r.Context(), req.Kind, req.Payload, jobs.WithDelay(time.Duration(req.Delay)*time.Second))
+	if errors.Is(err, jobs.ErrUnknownKind) {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Unknown job kind %q", req.Kind))
+		return
+	}
+	if err != nil {
+		slog.Error("Failed to enqueue job", slog.String("kind", req.Kind), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to enqueue job")
+		return
+	}
+
+	writeJSON(w, jobResponse(&job))
+}
+
+// handleGetJob returns a single job
+func (s *Server) handleGetJob(w http.ResponseWriter, r *http.Request) {
+	job, ok := s.loadJob(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, jobResponse(&job))
+}
+
+// handleRetryJob puts a dead-lettered job back on the queue with a fresh set of attempts
+func (s *Server) handleRetryJob(w http.ResponseWriter, r *http.Request) {
+	job, ok := s.loadJob(w, r)
+	if !ok {
+		return
+	}
+
+	requeued, err := s.queries.RequeueJob(r.Context(), job.ID)
+	if err != nil {
+		slog.Error("Failed to requeue job", slog.Int64("job_id", job.ID), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to requeue job")
+		return
+	}
+	if requeued == 0 {
+		writeError(w, http.StatusConflict, "Only dead-lettered jobs can be retried")
+		return
+	}
+
+	job, err = s.queries.GetJob(r.Context(), job.ID)
+	if err != nil {
+		slog.Error("Failed to get job", slog.Int64("job_id", job.ID), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to get job")
+		return
+	}
+
+	writeJSON(w, jobResponse(&job))
+}
+
+// loadJob looks up the job named in the request path, writing the error response if it can't
+func (s *Server) loadJob(w http.ResponseWriter, r *http.Request) (db.Job, bool) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid job ID")
+		return db.Job{}, false
+	}
+
+	job, err := s.queries.GetJob(r.Context(), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		writeError(w, http.StatusNotFound, "Job not found")
+		return job, false
+	}
+	if err != nil {
+		slog.Error("Failed to get job", slog.Int64("job_id", id), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to get job")
+		return job, false
+	}
+	return job, true
+}
+
+func jobResponse(job *db.Job) Job {
+	payload := json.RawMessage(job.Payload)
+	if !json.Valid(payload) {
+		payload, _ = json.Marshal(job.Payload)
+	}
+	return Job{Job: job, Payload: payload}
+}
@@ -0,0 +1,195 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strconv"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/identifiers"
+	"github.com/amalgamated-tools/bookscraping/pkg/match"
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+)
+
+// Match review statuses
+const (
+	reviewPending  = "pending"
+	reviewAccepted = "accepted"
+	reviewRejected = "rejected"
+)
+
+var errNoBookIdentifier = errors.New("book has no usable identifier")
+
+// matchOutcome counts what happened to the entries of a series
+type matchOutcome struct {
+	matched int
+	review  int
+	created int
+}
+
+// matchSeriesBooks compares the entries of a series against the books we have.
+// Entries matching an owned book are skipped, uncertain ones are queued for review
+// (or settled by an earlier review), and the rest are created as missing books.
+func (s *Server) matchSeriesBooks(ctx context.Context, series db.Series, existing []db.Book, entries []metadata.Book) matchOutcome {
+	var owned, missing []db.Book
+	for _, book := range existing {
+		if book.IsMissing != nil && *book.IsMissing {
+			missing = append(missing, book)
+		} else {
+			owned = append(owned, book)
+		}
+	}
+	ownedMatch := s.matchBooks(ctx, owned)
+
+	reviews := make(map[[2]int64]string)
+	stored, err := s.queries.ListMatchReviewsForSeries(ctx, series.ID)
+	if err != nil {
+		slog.Error("Failed to list match reviews", slog.Int64("series_id", series.ID), slog.Any("error", err))
+	}
+	for _, review := range stored {
+		reviews[[2]int64{review.OwnedBookID, review.CandidateBookID}] = review.Status
+	}
+
+	var outcome matchOutcome
+	for _, entry := range entries {
+		// Missing books we created earlier only match on identifiers
+		if hasBook(missing, entry) {
+			continue
+		}
+
+		result := s.matcher.Match(ownedMatch, toMatchBook(entry))
+		switch result.Verdict {
+		case match.Matched:
+			outcome.matched++
+			s.linkMatchedBook(ctx, &owned[result.Index], entry, result.Reason, result.Score)
+			continue
+		case match.Review:
+			candidateID, ok := syntheticBookID(entry)
+			if !ok {
+				break
+			}
+			ownedBook := owned[result.Index]
+			switch reviews[[2]int64{ownedBook.ID, candidateID}] {
+			case reviewAccepted:
+				outcome.matched++
+				continue
+			case reviewRejected:
+				// Settled as a different book; fall through to creating it
+			default:
+				if err := s.queueMatchReview(ctx, series, ownedBook, candidateID, entry, result.Score); err != nil {
+					slog.Error("Failed to queue match review", slog.String("title", entry.Title), slog.Any("error", err))
+				}
+				outcome.review++
+				continue
+			}
+		}
+
+		if err := s.createMissingBook(ctx, series, entry); err != nil {
+			if errors.Is(err, errNoBookIdentifier) {
+				slog.Warn("Skipping book without a usable identifier", slog.String("title", entry.Title), slog.Any("sources", entry.Sources))
+			} else {
+				slog.Error("Failed to create missing book", slog.String("book_title", entry.Title), slog.Any("error", err))
+			}
+			continue
+		}
+		outcome.created++
+	}
+	return outcome
+}
+
+// matchBooks converts stored books, with their authors, into the matcher's form
+func (s *Server) matchBooks(ctx context.Context, books []db.Book) []match.Book {
+	out := make([]match.Book, len(books))
+	for i, book := range books {
+		out[i] = match.Book{
+			Identifiers: bookIdentifiers(book),
+			Title:       book.Title,
+		}
+		if book.SeriesNumber != nil {
+			out[i].Position = *book.SeriesNumber
+		}
+
+		authors, err := s.queries.GetAuthorsForBook(ctx, book.ID)
+		if err != nil {
+			slog.Error("Failed to get authors for book", slog.Int64("book_id", book.ID), slog.Any("error", err))
+			continue
+		}
+		for _, author := range authors {
+			out[i].Authors = append(out[i].Authors, author.Name)
+		}
+	}
+	return out
+}
+
+// toMatchBook converts a provider book into the matcher's form
+func toMatchBook(book metadata.Book) match.Book {
+	mb := match.Book{Identifiers: book.Identifiers, Title: book.Title}
+	for _, author := range book.Authors {
+		mb.Authors = append(mb.Authors, author.Name)
+	}
+	if n, err := strconv.ParseFloat(book.SeriesPosition, 64); err == nil {
+		mb.Position = n
+	}
+	return mb
+}
+
+// bookIdentifiers collects the external identifiers of a stored book
+func bookIdentifiers(book db.Book) metadata.Identifiers {
+	value := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+	ids := metadata.Identifiers{
+		GoodreadsID:     value(book.GoodreadsID),
+		GoodreadsWorkID: value(book.GoodreadsWorkID),
+		HardcoverID:     value(book.HardcoverID),
+		GoogleID:        value(book.GoogleID),
+		ASIN:            value(book.Asin),
+		ISBN10:          value(book.Isbn10),
+		ISBN13:          value(book.Isbn13),
+	}
+	if book.HardcoverBookID != nil {
+		ids.HardcoverBookID = *book.HardcoverBookID
+	}
+	return ids
+}
+
+// linkMatchedBook records the Goodreads ID of a series entry on an owned book matched without one
+func (s *Server) linkMatchedBook(ctx context.Context, book *db.Book, entry metadata.Book, reason string, score float64) {
+	goodreadsID := identifiers.GoodreadsID(entry.GoodreadsID)
+	if goodreadsID == "" || (book.GoodreadsID != nil && *book.GoodreadsID != "") {
+		return
+	}
+
+	if err := s.queries.SetBookGoodreadsID(ctx, db.SetBookGoodreadsIDParams{GoodreadsID: &goodreadsID, ID: book.ID}); err != nil {
+		slog.Error("Failed to store Goodreads ID", slog.Int64("book_id", book.ID), slog.Any("error", err))
+		return
+	}
+	book.GoodreadsID = &goodreadsID
+	slog.Info("Matched owned book to series entry", slog.String("title", book.Title), slog.String("goodreads_id", goodreadsID), slog.String("reason", reason), slog.Float64("score", score))
+}
+
+// queueMatchReview stores an uncertain match for someone to accept or reject
+func (s *Server) queueMatchReview(ctx context.Context, series db.Series, owned db.Book, candidateID int64, entry metadata.Book, score float64) error {
+	candidate, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	_, err = s.queries.UpsertMatchReview(ctx, db.UpsertMatchReviewParams{
+		SeriesID:        series.ID,
+		OwnedBookID:     owned.ID,
+		CandidateBookID: candidateID,
+		CandidateTitle:  entry.Title,
+		Candidate:       string(candidate),
+		Score:           score,
+	})
+	if err != nil {
+		return err
+	}
+	slog.Info("Queued match for review", slog.String("owned", owned.Title), slog.String("candidate", entry.Title), slog.Float64("score", score))
+	return nil
+}
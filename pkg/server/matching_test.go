@@ -0,0 +1,54 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/match"
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServer_matchSeriesBooks(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+	boolPtr := func(b bool) *bool { return &b }
+
+	series := db.Series{ID: 3, SeriesID: 49126, Name: "David Trevellyan"}
+	existing := []db.Book{
+		{ID: 1, Title: "Die Twice", GoodreadsID: strPtr("7315139-die-twice")},
+		{ID: 2, Title: "Run and Hide"},
+		{ID: 3, Title: "Even", GoodreadsID: strPtr("999"), IsMissing: boolPtr(true)},
+		{ID: 4, Title: "Die Trying"},
+	}
+	grant := []metadata.Author{{Name: "Andrew Grant"}}
+	entries := []metadata.Book{
+		{Identifiers: metadata.Identifiers{GoodreadsID: "7315139"}, Title: "Die Twice (David Trevellyan, #2)"},
+		{Identifiers: metadata.Identifiers{GoodreadsID: "555"}, Title: "Running and Hiding", Authors: grant},
+		{Identifiers: metadata.Identifiers{GoodreadsID: "999"}, Title: "Even"},
+		{Identifiers: metadata.Identifiers{GoodreadsID: "777"}, Title: "Invincible", Authors: grant},
+		{Identifiers: metadata.Identifiers{GoodreadsID: "888"}, Title: "Die Trying Again", Authors: grant},
+	}
+
+	mockQuerier := db.NewMockQuerier(t)
+	mockQuerier.On("GetAuthorsForBook", mock.Anything, mock.Anything).Return([]db.Author{{Name: "Andrew Grant"}}, nil)
+	mockQuerier.On("ListMatchReviewsForSeries", mock.Anything, int64(3)).Return([]db.MatchReview{
+		{ID: 1, SeriesID: 3, OwnedBookID: 4, CandidateBookID: goodreadsBookIDOffset + 888, Status: reviewRejected},
+	}, nil)
+	mockQuerier.On("UpsertMatchReview", mock.Anything, mock.MatchedBy(func(arg db.UpsertMatchReviewParams) bool {
+		return arg.OwnedBookID == 2 && arg.CandidateBookID == goodreadsBookIDOffset+555
+	})).Return(db.MatchReview{}, nil).Once()
+	mockQuerier.On("CreateMissingBook", mock.Anything, mock.MatchedBy(func(arg db.CreateMissingBookParams) bool {
+		return arg.BookID == goodreadsBookIDOffset+777 || arg.BookID == goodreadsBookIDOffset+888
+	})).Return(db.Book{}, nil).Twice()
+	// Missing books are credited to the listed authors we know
+	mockQuerier.On("GetAuthorByName", mock.Anything, "Andrew Grant").Return(db.Author{ID: 5, Name: "Andrew Grant"}, nil).Twice()
+	mockQuerier.On("LinkBookAuthor", mock.Anything, db.LinkBookAuthorParams{AuthorID: 5}).Return(nil).Twice()
+
+	server := &Server{queries: mockQuerier, matcher: match.New()}
+
+	outcome := server.matchSeriesBooks(context.Background(), series, existing, entries)
+	if outcome.matched != 1 || outcome.review != 1 || outcome.created != 2 {
+		t.Errorf("matchSeriesBooks() = %+v, want 1 matched, 1 review, 2 created", outcome)
+	}
+}
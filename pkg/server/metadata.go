@@ -0,0 +1,142 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/hardcover"
+	"github.com/amalgamated-tools/bookscraping/pkg/identifiers"
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+)
+
+// Synthetic book_id ranges for missing books, kept well above Booklore IDs
+const (
+	goodreadsBookIDOffset = 10000000000
+	hardcoverBookIDOffset = 20000000000
+)
+
+// MetadataProviders describes the configured providers
+type MetadataProviders struct {
+	Providers []string `json:"providers"`
+	Merge     bool     `json:"merge"`
+}
+
+// metadataProvider returns the named provider, or all configured providers combined when name is empty
+func (s *Server) metadataProvider(name string) (metadata.Provider, error) {
+	if name == "" {
+		if s.mergeMetadata {
+			return metadata.NewMerged(s.providers...), nil
+		}
+		return metadata.NewFallback(s.providers...), nil
+	}
+
+	for _, p := range s.providers {
+		if p.Name() == name {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown metadata provider %q", name)
+}
+
+// handleListMetadataProviders returns the configured providers in priority order
+func (s *Server) handleListMetadataProviders(w http.ResponseWriter, r *http.Request) {
+	names := make([]string, len(s.providers))
+	for i, p := range s.providers {
+		names[i] = p.Name()
+	}
+	writeJSON(w, MetadataProviders{Providers: names, Merge: s.mergeMetadata})
+}
+
+// handleMetadataSearch searches the metadata providers for books
+func (s *Server) handleMetadataSearch(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query().Get("q")
+	if query == "" {
+		writeError(w, http.StatusBadRequest, "Missing search query")
+		return
+	}
+
+	provider, err := s.metadataProvider(r.URL.Query().Get("provider"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	books, err := provider.Search(r.Context(), query)
+	if err != nil {
+		slog.Error("Metadata search failed", slog.String("provider", provider.Name()), slog.String("query", query), slog.Any("error", err))
+		status, message := metadataErrorStatus(err)
+		writeError(w, status, message)
+		return
+	}
+	if books == nil {
+		books = []metadata.Book{}
+	}
+
+	writeJSON(w, books)
+}
+
+// metadataErrorStatus maps a provider error to an HTTP status and message
+func metadataErrorStatus(err error) (int, string) {
+	var gqlErr *hardcover.GraphQLError
+	switch {
+	case errors.Is(err, metadata.ErrUnsupported):
+		return http.StatusUnprocessableEntity, "No configured metadata provider supports this lookup"
+	case errors.Is(err, hardcover.ErrUnauthorized):
+		return http.StatusBadGateway, "Hardcover rejected the API token"
+	case errors.Is(err, hardcover.ErrNotFound):
+		return http.StatusNotFound, "Series not found on Hardcover"
+	case errors.As(err, &gqlErr):
+		return http.StatusBadGateway, "Hardcover query failed"
+	}
+	return goodreadsErrorStatus(err)
+}
+
+// hasBook reports whether a provider book is already in the series
+func hasBook(existing []db.Book, book metadata.Book) bool {
+	matches := func(stored *string, value string) bool {
+		return value != "" && stored != nil && *stored == value
+	}
+	for _, b := range existing {
+		if (b.GoodreadsID != nil && identifiers.SameGoodreadsID(*b.GoodreadsID, book.GoodreadsID)) ||
+			(b.GoodreadsWorkID != nil && identifiers.SameGoodreadsID(*b.GoodreadsWorkID, book.GoodreadsWorkID)) ||
+			matches(b.HardcoverID, book.HardcoverID) ||
+			matches(b.Isbn13, book.ISBN13) {
+			return true
+		}
+		if book.HardcoverBookID != 0 && b.HardcoverBookID != nil && *b.HardcoverBookID == book.HardcoverBookID {
+			return true
+		}
+	}
+	return false
+}
+
+// syntheticBookID derives a stable book_id for a missing book from its provider identifiers
+func syntheticBookID(book metadata.Book) (int64, bool) {
+	if id, err := strconv.ParseInt(identifiers.GoodreadsID(book.GoodreadsID), 10, 64); err == nil {
+		return goodreadsBookIDOffset + id, true
+	}
+	if book.HardcoverBookID != 0 {
+		return hardcoverBookIDOffset + book.HardcoverBookID, true
+	}
+	return 0, false
+}
+
+// optionalString returns nil for an empty string
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// stringValue returns the string value points to, or an empty string for nil
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,385 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/opds"
+)
+
+// opdsCatalogID is the Atom ID of the catalog root; feeds and entries get IDs under it
+const opdsCatalogID = "urn:bookscraping:catalog"
+
+// handleOPDSRoot serves the catalog root, a navigation feed of series, authors, owned books and missing books
+func (s *Server) handleOPDSRoot(w http.ResponseWriter, r *http.Request) {
+	now := time.Now()
+	writeOPDS(w, opds.Feed{
+		ID:      opdsCatalogID,
+		Title:   "Bookscraping",
+		Updated: now,
+		Links: []opds.Link{
+			{Rel: opds.RelSelf, Href: "/opds", Type: opds.NavigationType},
+			{Rel: opds.RelStart, Href: "/opds", Type: opds.NavigationType},
+		},
+		Entries: []opds.Entry{
+			navigationEntry("series", "Series", "Browse by series", "/opds/series", opds.NavigationType, now),
+			navigationEntry("authors", "Authors", "Browse by author", "/opds/authors", opds.NavigationType, now),
+			navigationEntry("books", "Owned books", "Books in the library", "/opds/books", opds.AcquisitionType, now),
+			navigationEntry("missing", "Missing books", "Books missing from series in the library", "/opds/missing", opds.AcquisitionType, now),
+		},
+	}, opds.NavigationType)
+}
+
+// handleOPDSSeries serves a navigation feed of series by name, a page at a time
+func (s *Server) handleOPDSSeries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	page, perPage := getPagination(r)
+
+	rows, err := s.queries.ListSeriesWithBookStats(ctx, db.ListSeriesWithBookStatsParams{
+		Sort:   "name",
+		Limit:  int64(perPage),
+		Offset: int64((page - 1) * perPage),
+	})
+	if err != nil {
+		slog.Error("Failed to list series for catalog", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to list series")
+		return
+	}
+
+	total, err := s.queries.CountSeries(ctx, db.CountSeriesParams{})
+	if err != nil {
+		slog.Error("Failed to count series for catalog", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to count series")
+		return
+	}
+
+	now := time.Now()
+	feed := opds.Feed{
+		ID:      opdsCatalogID + ":series",
+		Title:   "Series",
+		Updated: now,
+		Links:   opdsPageLinks("/opds/series", opds.NavigationType, page, perPage, total),
+		Entries: make([]opds.Entry, len(rows)),
+	}
+	for i, row := range rows {
+		feed.Entries[i] = navigationEntry(
+			fmt.Sprintf("series:%d", row.ID),
+			row.Name,
+			fmt.Sprintf("%d books, %d missing", row.TotalBooks, row.MissingBooks),
+			fmt.Sprintf("/opds/series/%d", row.ID),
+			opds.AcquisitionType,
+			now,
+		)
+	}
+
+	writeOPDS(w, feed, opds.NavigationType)
+}
+
+// handleOPDSSeriesBooks serves an acquisition feed of a series' owned and missing books
+func (s *Server) handleOPDSSeriesBooks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid series ID")
+		return
+	}
+
+	series, err := s.queries.GetSeries(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		writeError(w, http.StatusNotFound, "Series not found")
+		return
+	}
+	if err != nil {
+		slog.Error("Failed to get series for catalog", slog.Int64("series_id", id), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to get series")
+		return
+	}
+
+	books, err := s.queries.GetBooksBySeries(ctx, &id)
+	if err != nil {
+		slog.Error("Failed to get books for catalog", slog.Int64("series_id", id), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to get series books")
+		return
+	}
+
+	self := fmt.Sprintf("/opds/series/%d", id)
+	writeOPDS(w, opds.Feed{
+		ID:      fmt.Sprintf("%s:series:%d", opdsCatalogID, id),
+		Title:   series.Name,
+		Updated: time.Now(),
+		Links: []opds.Link{
+			{Rel: opds.RelSelf, Href: self, Type: opds.AcquisitionType},
+			{Rel: opds.RelStart, Href: "/opds", Type: opds.NavigationType},
+			{Rel: opds.RelUp, Href: "/opds/series", Type: opds.NavigationType},
+		},
+		Entries: s.bookEntries(ctx, books),
+	}, opds.AcquisitionType)
+}
+
+// handleOPDSAuthors serves a navigation feed of authors by name, a page at a time
+func (s *Server) handleOPDSAuthors(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	page, perPage := getPagination(r)
+
+	rows, err := s.queries.ListAuthorsWithBookStats(ctx, db.ListAuthorsWithBookStatsParams{
+		Limit:  int64(perPage),
+		Offset: int64((page - 1) * perPage),
+	})
+	if err != nil {
+		slog.Error("Failed to list authors for catalog", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to list authors")
+		return
+	}
+
+	total, err := s.queries.CountAuthors(ctx)
+	if err != nil {
+		slog.Error("Failed to count authors for catalog", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to count authors")
+		return
+	}
+
+	now := time.Now()
+	feed := opds.Feed{
+		ID:      opdsCatalogID + ":authors",
+		Title:   "Authors",
+		Updated: now,
+		Links:   opdsPageLinks("/opds/authors", opds.NavigationType, page, perPage, total),
+		Entries: make([]opds.Entry, len(rows)),
+	}
+	for i, row := range rows {
+		feed.Entries[i] = navigationEntry(
+			fmt.Sprintf("author:%d", row.ID),
+			row.Name,
+			fmt.Sprintf("%d owned, %d missing", row.OwnedBooks, row.MissingBooks),
+			fmt.Sprintf("/opds/authors/%d", row.ID),
+			opds.AcquisitionType,
+			now,
+		)
+	}
+
+	writeOPDS(w, feed, opds.NavigationType)
+}
+
+// handleOPDSAuthorBooks serves an acquisition feed of an author's owned and missing books
+func (s *Server) handleOPDSAuthorBooks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid author ID")
+		return
+	}
+
+	author, err := s.queries.GetAuthor(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		writeError(w, http.StatusNotFound, "Author not found")
+		return
+	}
+	if err != nil {
+		slog.Error("Failed to get author for catalog", slog.Int64("author_id", id), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to get author")
+		return
+	}
+
+	books, err := s.queries.GetBooksByAuthor(ctx, id)
+	if err != nil {
+		slog.Error("Failed to get books for catalog", slog.Int64("author_id", id), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to get books for author")
+		return
+	}
+
+	self := fmt.Sprintf("/opds/authors/%d", id)
+	writeOPDS(w, opds.Feed{
+		ID:      fmt.Sprintf("%s:author:%d", opdsCatalogID, id),
+		Title:   author.Name,
+		Updated: time.Now(),
+		Links: []opds.Link{
+			{Rel: opds.RelSelf, Href: self, Type: opds.AcquisitionType},
+			{Rel: opds.RelStart, Href: "/opds", Type: opds.NavigationType},
+			{Rel: opds.RelUp, Href: "/opds/authors", Type: opds.NavigationType},
+		},
+		Entries: s.bookEntries(ctx, books),
+	}, opds.AcquisitionType)
+}
+
+// handleOPDSBooks serves an acquisition feed of owned books by title, a page at a time
+func (s *Server) handleOPDSBooks(w http.ResponseWriter, r *http.Request) {
+	s.serveOPDSBooks(w, r, false)
+}
+
+// handleOPDSMissing serves an acquisition feed of missing books by series, a page at a time
+func (s *Server) handleOPDSMissing(w http.ResponseWriter, r *http.Request) {
+	s.serveOPDSBooks(w, r, true)
+}
+
+func (s *Server) serveOPDSBooks(w http.ResponseWriter, r *http.Request, missing bool) {
+	ctx := r.Context()
+	page, perPage := getPagination(r)
+
+	path, title, sort := "/opds/books", "Owned books", "title"
+	if missing {
+		path, title, sort = "/opds/missing", "Missing books", "series"
+	}
+
+	books, err := s.queries.ListBooks(ctx, db.ListBooksParams{
+		Missing: &missing,
+		Sort:    sort,
+		Limit:   int64(perPage),
+		Offset:  int64((page - 1) * perPage),
+	})
+	if err != nil {
+		slog.Error("Failed to list books for catalog", slog.Bool("missing", missing), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to list books")
+		return
+	}
+
+	total, err := s.queries.CountBooks(ctx, db.CountBooksParams{Missing: &missing})
+	if err != nil {
+		slog.Error("Failed to count books for catalog", slog.Bool("missing", missing), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to count books")
+		return
+	}
+
+	writeOPDS(w, opds.Feed{
+		ID:      opdsCatalogID + ":" + strings.TrimPrefix(path, "/opds/"),
+		Title:   title,
+		Updated: time.Now(),
+		Links:   opdsPageLinks(path, opds.AcquisitionType, page, perPage, total),
+		Entries: s.bookEntries(ctx, books),
+	}, opds.AcquisitionType)
+}
+
+// bookEntries converts books into catalog entries, updated when a missing book was discovered.
+// Owned books link to Booklore when its URL is configured; missing books are marked with a "missing" category.
+// Both link to Goodreads when the book has a Goodreads ID.
+func (s *Server) bookEntries(ctx context.Context, books []db.Book) []opds.Entry {
+	booklore := s.bookloreURL(ctx)
+	now := time.Now()
+
+	entries := make([]opds.Entry, len(books))
+	for i, book := range books {
+		entry := opds.Entry{
+			ID:       fmt.Sprintf("%s:book:%d", opdsCatalogID, book.ID),
+			Title:    book.Title,
+			Updated:  now,
+			Summary:  book.Description,
+			Language: stringValue(book.Language),
+			Issued:   stringValue(book.PublicationDate),
+		}
+
+		if book.DiscoveredAt != nil {
+			entry.Updated = *book.DiscoveredAt
+		}
+
+		authors, err := s.queries.GetAuthorsForBook(ctx, book.ID)
+		if err != nil {
+			slog.Error("Failed to get authors for book", slog.Int64("book_id", book.ID), slog.Any("error", err))
+		}
+		for _, author := range authors {
+			entry.Authors = append(entry.Authors, author.Name)
+		}
+
+		for _, isbn := range []*string{book.Isbn13, book.Isbn10} {
+			if isbn := stringValue(isbn); isbn != "" {
+				entry.Identifiers = append(entry.Identifiers, "urn:isbn:"+isbn)
+			}
+		}
+
+		if book.SeriesName != nil && *book.SeriesName != "" {
+			label := *book.SeriesName
+			if book.SeriesNumber != nil && *book.SeriesNumber > 0 {
+				label = fmt.Sprintf("%s #%s", label, strconv.FormatFloat(*book.SeriesNumber, 'f', -1, 64))
+			}
+			entry.Categories = append(entry.Categories, opds.Category{Term: "series", Label: label})
+		}
+
+		if cover := stringValue(book.CoverUrl); cover != "" {
+			entry.Links = append(entry.Links,
+				opds.Link{Rel: opds.RelImage, Href: cover},
+				opds.Link{Rel: opds.RelThumbnail, Href: cover},
+			)
+		}
+
+		missing := book.IsMissing != nil && *book.IsMissing
+		if missing {
+			entry.Categories = append(entry.Categories, opds.Category{Term: "missing", Label: "Missing"})
+		} else if booklore != "" {
+			entry.Links = append(entry.Links, opds.Link{
+				Rel:   opds.RelAcquisition,
+				Href:  fmt.Sprintf("%s/book/%d", booklore, book.BookID),
+				Type:  "text/html",
+				Title: "Open in Booklore",
+			})
+		}
+
+		if id := stringValue(book.GoodreadsID); id != "" {
+			entry.Links = append(entry.Links, opds.Link{
+				Rel:   opds.RelAlternate,
+				Href:  "https://www.goodreads.com/book/show/" + id,
+				Type:  "text/html",
+				Title: "Goodreads",
+			})
+		}
+
+		entries[i] = entry
+	}
+	return entries
+}
+
+// bookloreURL returns the configured Booklore server URL without a trailing slash, or "" when there is none
+func (s *Server) bookloreURL(ctx context.Context) string {
+	serverURL, err := s.queries.GetConfig(ctx, "serverUrl")
+	if err != nil || serverURL == "" {
+		serverURL = os.Getenv("BOOKLORE_SERVER")
+	}
+	return strings.TrimSuffix(serverURL, "/")
+}
+
+// navigationEntry is an entry linking to another catalog feed
+func navigationEntry(id, title, content, href, feedType string, updated time.Time) opds.Entry {
+	return opds.Entry{
+		ID:      opdsCatalogID + ":" + id,
+		Title:   title,
+		Updated: updated,
+		Content: content,
+		Links:   []opds.Link{{Rel: opds.RelSubsection, Href: href, Type: feedType}},
+	}
+}
+
+// opdsPageLinks returns the self, start, up and paging links of a paginated feed
+func opdsPageLinks(path, feedType string, page, perPage int, total int64) []opds.Link {
+	pageURL := func(page int) string {
+		return fmt.Sprintf("%s?page=%d&per_page=%d", path, page, perPage)
+	}
+
+	links := []opds.Link{
+		{Rel: opds.RelSelf, Href: pageURL(page), Type: feedType},
+		{Rel: opds.RelStart, Href: "/opds", Type: opds.NavigationType},
+		{Rel: opds.RelUp, Href: "/opds", Type: opds.NavigationType},
+	}
+	if page > 1 {
+		links = append(links, opds.Link{Rel: opds.RelPrevious, Href: pageURL(page - 1), Type: feedType})
+	}
+	if int64(page*perPage) < total {
+		links = append(links, opds.Link{Rel: opds.RelNext, Href: pageURL(page + 1), Type: feedType})
+	}
+	return links
+}
+
+// writeOPDS writes a catalog feed with its OPDS media type
+func writeOPDS(w http.ResponseWriter, feed opds.Feed, feedType string) {
+	w.Header().Set("Content-Type", feedType+";charset=utf-8")
+	if err := feed.Write(w); err != nil {
+		slog.Error("Failed to write catalog feed", slog.String("feed", feed.ID), slog.Any("error", err))
+	}
+}
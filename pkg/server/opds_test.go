@@ -0,0 +1,77 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/opds"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServer_handleOPDSSeriesBooks(t *testing.T) {
+	seriesID := int64(3)
+	owned, missing := false, true
+	goodreadsID := "7315139"
+	isbn13 := "9780735219342"
+
+	mockQuerier := db.NewMockQuerier(t)
+	mockQuerier.On("GetSeries", mock.Anything, seriesID).Return(db.Series{ID: 3, Name: "David Trevellyan"}, nil).Once()
+	mockQuerier.On("GetBooksBySeries", mock.Anything, &seriesID).Return([]db.Book{
+		{ID: 1, BookID: 42, Title: "Die Twice", IsMissing: &owned, Isbn13: &isbn13},
+		{ID: 2, BookID: 1000000001, Title: "Invincible", IsMissing: &missing, GoodreadsID: &goodreadsID},
+	}, nil).Once()
+	mockQuerier.On("GetConfig", mock.Anything, "serverUrl").Return("https://booklore.example/", nil).Once()
+	mockQuerier.On("GetAuthorsForBook", mock.Anything, mock.Anything).Return([]db.Author{{Name: "Andrew Grant"}}, nil).Twice()
+
+	server := &Server{queries: mockQuerier}
+	req := httptest.NewRequest("GET", "/opds/series/3", nil)
+	req.SetPathValue("id", "3")
+	w := httptest.NewRecorder()
+	server.handleOPDSSeriesBooks(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body)
+	}
+	if got := w.Header().Get("Content-Type"); got != opds.AcquisitionType+";charset=utf-8" {
+		t.Errorf("Content-Type = %q", got)
+	}
+	body := w.Body.String()
+	for _, want := range []string{
+		`<title>David Trevellyan</title>`,
+		`<link rel="http://opds-spec.org/acquisition" href="https://booklore.example/book/42" type="text/html" title="Open in Booklore"></link>`,
+		`<dc:identifier>urn:isbn:9780735219342</dc:identifier>`,
+		`<category term="missing" label="Missing"></category>`,
+		`<link rel="alternate" href="https://www.goodreads.com/book/show/7315139" type="text/html" title="Goodreads"></link>`,
+		`<name>Andrew Grant</name>`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("feed does not contain %q:\n%s", want, body)
+		}
+	}
+	if strings.Contains(body, "book/1000000001") {
+		t.Errorf("missing book links to Booklore:\n%s", body)
+	}
+}
+
+func TestOPDSPageLinks(t *testing.T) {
+	rels := func(links []opds.Link) map[string]string {
+		m := make(map[string]string)
+		for _, link := range links {
+			m[link.Rel] = link.Href
+		}
+		return m
+	}
+
+	first := rels(opdsPageLinks("/opds/books", opds.AcquisitionType, 1, 20, 45))
+	if first[opds.RelNext] != "/opds/books?page=2&per_page=20" || first[opds.RelPrevious] != "" {
+		t.Errorf("first page links = %v", first)
+	}
+
+	last := rels(opdsPageLinks("/opds/books", opds.AcquisitionType, 3, 20, 45))
+	if last[opds.RelNext] != "" || last[opds.RelPrevious] != "/opds/books?page=2&per_page=20" {
+		t.Errorf("last page links = %v", last)
+	}
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+// seriesRefreshInterval is how often the refresher looks for stale series
+const seriesRefreshInterval = time.Hour
+
+// refreshStaleSeries periodically starts a completion run over the series not checked in refreshDays
+func (s *Server) refreshStaleSeries(ctx context.Context) {
+	slog.Info("Refreshing stale series in the background", slog.Int64("max_age_days", s.refreshDays), slog.Duration("interval", seriesRefreshInterval))
+
+	ticker := time.NewTicker(seriesRefreshInterval)
+	defer ticker.Stop()
+	for {
+		s.startStaleSeriesRun(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// startStaleSeriesRun starts a completion run when there are stale series and no run is in progress
+func (s *Server) startStaleSeriesRun(ctx context.Context) {
+	stale, err := s.queries.CountStaleSeries(ctx, s.refreshDays)
+	if err != nil {
+		slog.Error("Failed to count stale series", slog.Any("error", err))
+		return
+	}
+	if stale == 0 {
+		return
+	}
+
+	provider, err := s.metadataProvider("")
+	if err != nil {
+		slog.Error("No metadata provider for refreshing series", slog.Any("error", err))
+		return
+	}
+
+	run, err := s.createCompletionRun(ctx, "", provider, s.refreshDays, defaultCompletionConcurrency)
+	switch {
+	case errors.Is(err, errCompletionRunActive):
+		slog.Debug("Skipping series refresh while a completion run is in progress", slog.Int64("stale_series", stale))
+	case errors.Is(err, errRestoreInProgress):
+		slog.Debug("Skipping series refresh while the database is restored", slog.Int64("stale_series", stale))
+	case err != nil:
+		slog.Error("Failed to start series refresh", slog.Any("error", err))
+	default:
+		slog.Info("Started series refresh", slog.Int64("run_id", run.ID), slog.Int64("series", run.Total))
+	}
+}
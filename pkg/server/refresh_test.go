@@ -0,0 +1,45 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServer_startStaleSeriesRun(t *testing.T) {
+	t.Run("nothing stale", func(t *testing.T) {
+		mockQuerier := db.NewMockQuerier(t)
+		mockQuerier.On("CountStaleSeries", mock.Anything, int64(30)).Return(int64(0), nil).Once()
+
+		server := &Server{queries: mockQuerier, refreshDays: 30}
+		server.startStaleSeriesRun(context.Background())
+	})
+
+	t.Run("run already in progress", func(t *testing.T) {
+		mockQuerier := db.NewMockQuerier(t)
+		mockQuerier.On("CountStaleSeries", mock.Anything, int64(30)).Return(int64(4), nil).Once()
+
+		server := &Server{
+			queries:        mockQuerier,
+			refreshDays:    30,
+			providers:      []metadata.Provider{&seriesProvider{}},
+			completionRuns: map[int64]context.CancelCauseFunc{1: func(error) {}},
+		}
+		server.startStaleSeriesRun(context.Background())
+	})
+
+	t.Run("stale series start a run", func(t *testing.T) {
+		mockQuerier := db.NewMockQuerier(t)
+		mockQuerier.On("CountStaleSeries", mock.Anything, int64(30)).Return(int64(1), nil).Once()
+		mockQuerier.On("CreateCompletionRun", mock.Anything, db.CreateCompletionRunParams{StaleDays: 30, Concurrency: defaultCompletionConcurrency}).
+			Return(db.CompletionRun{ID: 9, Status: completionRunning}, nil).Once()
+		mockQuerier.On("AddCompletionRunSeries", mock.Anything, db.AddCompletionRunSeriesParams{RunID: 9, StaleDays: 30}).Return(int64(0), nil).Once()
+		mockQuerier.On("FinishCompletionRun", mock.Anything, db.FinishCompletionRunParams{Status: completionCompleted, ID: 9}).Return(nil).Once()
+
+		server := &Server{queries: mockQuerier, refreshDays: 30, providers: []metadata.Provider{&seriesProvider{}}}
+		server.startStaleSeriesRun(context.Background())
+	})
+}
@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+)
+
+// MatchReview is an uncertain match between an owned book and a series entry
+type MatchReview struct {
+	ID          int64         `json:"id"`
+	SeriesID    int64         `json:"series_id"`
+	SeriesName  string        `json:"series_name"`
+	OwnedBookID int64         `json:"owned_book_id"`
+	OwnedTitle  string        `json:"owned_title"`
+	Score       float64       `json:"score"`
+	Status      string        `json:"status"`
+	Candidate   metadata.Book `json:"candidate"`
+}
+
+// handleListMatchReviews lists match reviews, pending ones unless ?status= says otherwise
+func (s *Server) handleListMatchReviews(w http.ResponseWriter, r *http.Request) {
+	status := r.URL.Query().Get("status")
+	switch status {
+	case "":
+		status = reviewPending
+	case reviewPending, reviewAccepted, reviewRejected:
+	default:
+		writeError(w, http.StatusBadRequest, "status must be pending, accepted or rejected")
+		return
+	}
+
+	rows, err := s.queries.ListMatchReviews(r.Context(), status)
+	if err != nil {
+		slog.Error("Failed to list match reviews", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to list match reviews")
+		return
+	}
+
+	reviews := make([]MatchReview, 0, len(rows))
+	for _, row := range rows {
+		review := MatchReview{
+			ID:          row.ID,
+			SeriesID:    row.SeriesID,
+			SeriesName:  row.SeriesName,
+			OwnedBookID: row.OwnedBookID,
+			OwnedTitle:  row.OwnedTitle,
+			Score:       row.Score,
+			Status:      row.Status,
+		}
+		if err := json.Unmarshal([]byte(row.Candidate), &review.Candidate); err != nil {
+			slog.Warn("Failed to decode review candidate", slog.Int64("review_id", row.ID), slog.Any("error", err))
+			review.Candidate.Title = row.CandidateTitle
+		}
+		reviews = append(reviews, review)
+	}
+
+	writeJSON(w, reviews)
+}
+
+// handleAcceptMatchReview confirms that the owned book is the series entry
+func (s *Server) handleAcceptMatchReview(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	review, candidate, ok := s.loadMatchReview(w, r)
+	if !ok {
+		return
+	}
+
+	if !s.decideMatchReview(ctx, w, review, reviewAccepted) {
+		return
+	}
+
+	owned, err := s.queries.GetBook(ctx, review.OwnedBookID)
+	if err == nil {
+		s.linkMatchedBook(ctx, &owned, candidate, "review", review.Score)
+	} else {
+		slog.Error("Failed to get matched book", slog.Int64("book_id", review.OwnedBookID), slog.Any("error", err))
+	}
+
+	writeJSON(w, map[string]any{"id": review.ID, "status": reviewAccepted})
+}
+
+// handleRejectMatchReview records that the series entry is a different book and adds it as missing
+func (s *Server) handleRejectMatchReview(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	review, candidate, ok := s.loadMatchReview(w, r)
+	if !ok {
+		return
+	}
+
+	if !s.decideMatchReview(ctx, w, review, reviewRejected) {
+		return
+	}
+
+	if err := s.createReviewedMissingBook(ctx, review, candidate); err != nil {
+		slog.Error("Failed to create missing book for rejected review", slog.Int64("review_id", review.ID), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Review rejected, but the missing book could not be created")
+		return
+	}
+
+	writeJSON(w, map[string]any{"id": review.ID, "status": reviewRejected})
+}
+
+// decideMatchReview settles a pending review, writing an error response when it can't.
+// Only pending reviews can be settled, so a review is never both accepted and rejected.
+func (s *Server) decideMatchReview(ctx context.Context, w http.ResponseWriter, review db.MatchReview, status string) bool {
+	decided, err := s.queries.DecideMatchReview(ctx, db.DecideMatchReviewParams{Status: status, ID: review.ID})
+	if err != nil {
+		slog.Error("Failed to settle match review", slog.Int64("review_id", review.ID), slog.String("status", status), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to settle match review")
+		return false
+	}
+	if decided == 0 {
+		writeError(w, http.StatusConflict, "Match review has already been settled")
+		return false
+	}
+	return true
+}
+
+// loadMatchReview reads the review named in the path, writing an error response when it can't
+func (s *Server) loadMatchReview(w http.ResponseWriter, r *http.Request) (db.MatchReview, metadata.Book, bool) {
+	var candidate metadata.Book
+
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid review ID")
+		return db.MatchReview{}, candidate, false
+	}
+
+	review, err := s.queries.GetMatchReview(r.Context(), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		writeError(w, http.StatusNotFound, "Match review not found")
+		return review, candidate, false
+	}
+	if err != nil {
+		slog.Error("Failed to get match review", slog.Int64("review_id", id), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to get match review")
+		return review, candidate, false
+	}
+
+	if err := json.Unmarshal([]byte(review.Candidate), &candidate); err != nil {
+		slog.Error("Failed to decode review candidate", slog.Int64("review_id", id), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Stored review candidate is invalid")
+		return review, candidate, false
+	}
+	return review, candidate, true
+}
+
+// createReviewedMissingBook adds the candidate of a rejected review to its series as a missing book
+func (s *Server) createReviewedMissingBook(ctx context.Context, review db.MatchReview, candidate metadata.Book) error {
+	series, err := s.queries.GetSeries(ctx, review.SeriesID)
+	if err != nil {
+		return fmt.Errorf("getting series %d: %w", review.SeriesID, err)
+	}
+	return s.createMissingBook(ctx, series, candidate)
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServer_handleRejectMatchReview(t *testing.T) {
+	review := db.MatchReview{ID: 5, SeriesID: 1, OwnedBookID: 3, CandidateBookID: 42, Candidate: `{"title":"Die Twice","goodreads_id":"42"}`, Status: reviewPending}
+
+	tests := []struct {
+		name     string
+		decided  int64
+		setup    func(*db.MockQuerier)
+		wantCode int
+	}{
+		{
+			name:    "pending",
+			decided: 1,
+			setup: func(m *db.MockQuerier) {
+				m.On("GetSeries", mock.Anything, int64(1)).Return(db.Series{ID: 1, SeriesID: 100}, nil).Once()
+				m.On("CreateMissingBook", mock.Anything, mock.Anything).Return(db.Book{ID: 9}, nil).Once()
+				m.On("GetSeriesAuthors", mock.Anything, int64(1)).Return([]db.Author{}, nil).Once()
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			// Settled already, e.g. rejected twice or accepted before: no second missing book is created
+			name:     "already settled",
+			decided:  0,
+			wantCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQuerier := db.NewMockQuerier(t)
+			mockQuerier.On("GetMatchReview", mock.Anything, review.ID).Return(review, nil).Once()
+			mockQuerier.On("DecideMatchReview", mock.Anything, db.DecideMatchReviewParams{Status: reviewRejected, ID: review.ID}).Return(tt.decided, nil).Once()
+			if tt.setup != nil {
+				tt.setup(mockQuerier)
+			}
+
+			server := &Server{queries: mockQuerier}
+			req := httptest.NewRequest("POST", "/api/reviews/5/reject", nil)
+			req.SetPathValue("id", "5")
+			w := httptest.NewRecorder()
+			server.handleRejectMatchReview(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("status = %d, want %d (body %s)", w.Code, tt.wantCode, w.Body)
+			}
+		})
+	}
+}
+
+func TestServer_handleAcceptMatchReview_settled(t *testing.T) {
+	review := db.MatchReview{ID: 5, SeriesID: 1, OwnedBookID: 3, Candidate: `{"title":"Die Twice"}`, Status: reviewRejected}
+
+	mockQuerier := db.NewMockQuerier(t)
+	mockQuerier.On("GetMatchReview", mock.Anything, review.ID).Return(review, nil).Once()
+	// The owned book isn't linked to a review that was already rejected
+	mockQuerier.On("DecideMatchReview", mock.Anything, db.DecideMatchReviewParams{Status: reviewAccepted, ID: review.ID}).Return(int64(0), nil).Once()
+
+	server := &Server{queries: mockQuerier}
+	req := httptest.NewRequest("POST", "/api/reviews/5/accept", nil)
+	req.SetPathValue("id", "5")
+	w := httptest.NewRecorder()
+	server.handleAcceptMatchReview(w, req)
+
+	if w.Code != http.StatusConflict {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusConflict)
+	}
+}
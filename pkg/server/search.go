@@ -0,0 +1,114 @@
+package server
+
+import (
+	"html"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+)
+
+const (
+	// defaultSearchLimit is how many results GET /api/search returns unless asked otherwise
+	defaultSearchLimit = 20
+	// maxSearchLimit bounds the limit a caller can ask for
+	maxSearchLimit = 100
+)
+
+// Kinds of search results; these are the kind column of the search index
+var searchKinds = map[string]bool{
+	"book":   true,
+	"series": true,
+	"author": true,
+}
+
+// SearchResult is a book, series or author matching a search
+type SearchResult struct {
+	Kind  string `json:"kind"`
+	ID    int64  `json:"id"`
+	Title string `json:"title"`
+	// Highlight and Snippet are HTML with the matched terms wrapped in <mark>
+	Highlight string  `json:"highlight"`
+	Snippet   string  `json:"snippet"`
+	Rank      float64 `json:"rank"`
+}
+
+// SearchResponse lists search results, best match first
+type SearchResponse struct {
+	Query   string         `json:"query"`
+	Results []SearchResult `json:"results"`
+}
+
+// handleSearch searches book titles, descriptions, author names and series names.
+// type limits the results to book, series or author.
+func (s *Server) handleSearch(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+
+	query := params.Get("q")
+	match := ftsQuery(query)
+	if match == "" {
+		writeError(w, http.StatusBadRequest, "q must contain at least one word")
+		return
+	}
+
+	kind := params.Get("type")
+	if kind != "" && !searchKinds[kind] {
+		writeError(w, http.StatusBadRequest, "type must be book, series or author")
+		return
+	}
+
+	limit := int64(defaultSearchLimit)
+	if value := params.Get("limit"); value != "" {
+		l, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || l < 1 || l > maxSearchLimit {
+			writeError(w, http.StatusBadRequest, "limit must be between 1 and "+strconv.Itoa(maxSearchLimit))
+			return
+		}
+		limit = l
+	}
+
+	rows, err := s.queries.SearchLibrary(r.Context(), db.SearchLibraryParams{
+		Query: match,
+		Kind:  optionalString(kind),
+		Limit: limit,
+	})
+	if err != nil {
+		slog.Error("Failed to search library", slog.String("query", query), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to search library")
+		return
+	}
+
+	response := SearchResponse{Query: query, Results: make([]SearchResult, len(rows))}
+	for i, row := range rows {
+		response.Results[i] = SearchResult{
+			Kind:      row.Kind,
+			ID:        row.RefID,
+			Title:     row.Title,
+			Highlight: highlightHTML(row.HighlightedTitle),
+			Snippet:   highlightHTML(row.Snippet),
+			Rank:      row.Rank,
+		}
+	}
+
+	writeJSON(w, response)
+}
+
+// ftsQuery turns free text into an FTS5 query that matches every word as a prefix.
+// Words are quoted, so FTS5 operators and punctuation in the input are searched for literally instead of parsed.
+func ftsQuery(text string) string {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, word := range words {
+		words[i] = `"` + word + `"*`
+	}
+	return strings.Join(words, " ")
+}
+
+// highlightHTML escapes text marked up by the search index and turns its markers into <mark> tags
+func highlightHTML(text string) string {
+	return strings.NewReplacer("\x02", "<mark>", "\x03", "</mark>").Replace(html.EscapeString(text))
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFTSQuery(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "die twice", want: `"die"* "twice"*`},
+		{input: `  "Grant" AND -NOT* `, want: `"Grant"* "AND"* "NOT"*`},
+		{input: "Brontë's", want: `"Brontë"* "s"*`},
+		{input: "*** ()", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := ftsQuery(tt.input); got != tt.want {
+			t.Errorf("ftsQuery(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestHighlightHTML(t *testing.T) {
+	got := highlightHTML("Tom & Jerry <3 \x02Chicago\x03")
+	want := "Tom &amp; Jerry &lt;3 <mark>Chicago</mark>"
+	if got != want {
+		t.Errorf("highlightHTML() = %q, want %q", got, want)
+	}
+}
+
+func TestServer_handleSearch(t *testing.T) {
+	t.Run("results", func(t *testing.T) {
+		mockQuerier := db.NewMockQuerier(t)
+		mockQuerier.On("SearchLibrary", mock.Anything, mock.MatchedBy(func(arg db.SearchLibraryParams) bool {
+			return arg.Query == `"grant"*` && *arg.Kind == "author" && arg.Limit == defaultSearchLimit
+		})).Return([]db.SearchLibraryRow{
+			{Kind: "author", RefID: 2, Title: "Andrew Grant", HighlightedTitle: "Andrew \x02Grant\x03", Snippet: "Andrew \x02Grant\x03", Rank: -1.5},
+		}, nil).Once()
+
+		server := &Server{queries: mockQuerier}
+		w := httptest.NewRecorder()
+		server.handleSearch(w, httptest.NewRequest("GET", "/api/search?q=grant&type=author", nil))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("status = %d, body = %s", w.Code, w.Body)
+		}
+		var response SearchResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatalf("decoding response: %v", err)
+		}
+		if len(response.Results) != 1 || response.Results[0].ID != 2 || response.Results[0].Highlight != "Andrew <mark>Grant</mark>" {
+			t.Errorf("unexpected results %+v", response.Results)
+		}
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		server := &Server{queries: db.NewMockQuerier(t)}
+		for _, target := range []string{"/api/search", "/api/search?q=%22%22", "/api/search?q=a&type=shelf", "/api/search?q=a&limit=500"} {
+			w := httptest.NewRecorder()
+			server.handleSearch(w, httptest.NewRequest("GET", target, nil))
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("GET %s status = %d, want %d", target, w.Code, http.StatusBadRequest)
+			}
+		}
+	})
+}
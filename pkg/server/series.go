@@ -2,13 +2,18 @@ package server
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 
 	"github.com/amalgamated-tools/bookscraping/pkg/db"
 	"github.com/amalgamated-tools/bookscraping/pkg/goodreads"
+	"github.com/amalgamated-tools/bookscraping/pkg/identifiers"
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
 )
 
 // SeriesWithAuthors wraps a Series with its authors
@@ -39,18 +44,52 @@ type SyncSeriesResponse struct {
 	ExistingBooks   int    `json:"existing_books"`
 	MissingBooks    int    `json:"missing_books"`
 	NewMissingBooks int    `json:"new_missing_books"`
+	ReviewBooks     int    `json:"review_books"`
+	// Changes counts what changed since the last fetch; only followed series are compared
+	Changes int `json:"changes"`
 }
 
+// Outcomes stored in series.last_check_status
+const (
+	seriesCheckSucceeded = "success"
+	seriesCheckFailed    = "failed"
+)
+
+// Series list sort keys; each can be reversed with order=desc.
+// completion is the share of a series we own; checked is when Goodreads was last checked.
+var seriesSorts = []string{"name", "total", "missing", "completion", "checked"}
+
 // Series handlers
+
+// handleListSeries lists series a page at a time.
+// Filters: incomplete=true|false, author_id, unmapped=true|false and q, a name substring.
+// sort is name, total, missing, completion or checked, and order=desc reverses it.
 func (s *Server) handleListSeries(w http.ResponseWriter, r *http.Request) {
 	page, perPage := getPagination(r)
 	offset := (page - 1) * perPage
 
+	filters, err := seriesFilters(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	sort, err := listSort(r.URL.Query(), seriesSorts, "")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	ctx := context.Background()
 
 	series, err := s.queries.ListSeries(ctx, db.ListSeriesParams{
-		Limit:  int64(perPage),
-		Offset: int64(offset),
+		Incomplete: filters.Incomplete,
+		AuthorID:   filters.AuthorID,
+		Unmapped:   filters.Unmapped,
+		Name:       filters.Name,
+		Sort:       sort,
+		Limit:      int64(perPage),
+		Offset:     int64(offset),
 	})
 	if err != nil {
 		slog.Error("Failed to list series", slog.Any("error", err))
@@ -78,7 +117,7 @@ func (s *Server) handleListSeries(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	total, err := s.queries.CountSeries(ctx)
+	total, err := s.queries.CountSeries(ctx, filters)
 	if err != nil {
 		slog.Error("Failed to count series", slog.Any("error", err))
 		writeError(w, http.StatusInternalServerError, "Failed to count series")
@@ -93,16 +132,34 @@ func (s *Server) handleListSeries(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
-// handleListSeriesWithStats returns series with book statistics in a single query
+// handleListSeriesWithStats returns series with book statistics in a single query.
+// It takes the same filters and sorts as handleListSeries.
 func (s *Server) handleListSeriesWithStats(w http.ResponseWriter, r *http.Request) {
 	page, perPage := getPagination(r)
 	offset := (page - 1) * perPage
 
+	filters, err := seriesFilters(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	sort, err := listSort(r.URL.Query(), seriesSorts, "")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	ctx := context.Background()
 
 	seriesRows, err := s.queries.ListSeriesWithBookStats(ctx, db.ListSeriesWithBookStatsParams{
-		Limit:  int64(perPage),
-		Offset: int64(offset),
+		Incomplete: filters.Incomplete,
+		AuthorID:   filters.AuthorID,
+		Unmapped:   filters.Unmapped,
+		Name:       filters.Name,
+		Sort:       sort,
+		Limit:      int64(perPage),
+		Offset:     int64(offset),
 	})
 	if err != nil {
 		slog.Error("Failed to list series with stats", slog.Any("error", err))
@@ -140,12 +197,16 @@ func (s *Server) handleListSeriesWithStats(w http.ResponseWriter, r *http.Reques
 
 		seriesWithStats[i] = SeriesWithStats{
 			Series: &db.Series{
-				ID:          row.ID,
-				SeriesID:    row.SeriesID,
-				Name:        row.Name,
-				Description: row.Description,
-				Url:         row.Url,
-				Data:        row.Data,
+				ID:              row.ID,
+				SeriesID:        row.SeriesID,
+				Name:            row.Name,
+				Description:     row.Description,
+				Url:             row.Url,
+				Data:            row.Data,
+				LastCheckedAt:   row.LastCheckedAt,
+				LastCheckStatus: row.LastCheckStatus,
+				LastError:       row.LastError,
+				Followed:        row.Followed,
 			},
 			Authors:      authors,
 			TotalBooks:   row.TotalBooks,
@@ -153,7 +214,7 @@ func (s *Server) handleListSeriesWithStats(w http.ResponseWriter, r *http.Reques
 		}
 	}
 
-	total, err := s.queries.CountSeries(ctx)
+	total, err := s.queries.CountSeries(ctx, filters)
 	if err != nil {
 		slog.Error("Failed to count series", slog.Any("error", err))
 		writeError(w, http.StatusInternalServerError, "Failed to count series")
@@ -212,6 +273,12 @@ func (s *Server) handleGetSeriesBooks(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	format, err := descriptionFormat(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	ctx := context.Background()
 
 	books, err := s.queries.GetBooksBySeries(ctx, &id)
@@ -220,6 +287,7 @@ func (s *Server) handleGetSeriesBooks(w http.ResponseWriter, r *http.Request) {
 		writeError(w, http.StatusInternalServerError, "Failed to get books for series")
 		return
 	}
+	applyDescriptionFormat(books, format)
 
 	// Fetch authors for each book
 	booksWithAuthors := make([]BookWithAuthors, len(books))
@@ -244,7 +312,8 @@ func (s *Server) handleGetSeriesBooks(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, booksWithAuthors)
 }
 
-// handleGetSeriesFromGoodreads fetches series data from Goodreads and creates missing books
+// handleGetSeriesFromGoodreads fetches series data from the metadata providers and creates missing books.
+// The optional provider query parameter restricts the lookup to a single configured provider.
 func (s *Server) handleGetSeriesFromGoodreads(w http.ResponseWriter, r *http.Request) {
 	idStr := r.PathValue("id")
 	seriesID, err := strconv.ParseInt(idStr, 10, 64)
@@ -253,128 +322,266 @@ func (s *Server) handleGetSeriesFromGoodreads(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	ctx := context.Background()
+	provider, err := s.metadataProvider(r.URL.Query().Get("provider"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response, err := s.completeSeries(context.Background(), seriesID, provider)
+	if err != nil {
+		var completionErr *completionError
+		if errors.As(err, &completionErr) {
+			writeError(w, completionErr.status, completionErr.message)
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "Failed to sync series")
+		return
+	}
+
+	writeJSON(w, response)
+}
+
+// completionError is a failed series completion, with the HTTP status and message to report it with
+type completionError struct {
+	status  int
+	message string
+	err     error
+}
+
+func (e *completionError) Error() string {
+	return fmt.Sprintf("%s: %v", e.message, e.err)
+}
+
+func (e *completionError) Unwrap() error {
+	return e.err
+}
 
+// completeSeries fetches a series from the metadata provider and adds the entries we don't own as missing books
+func (s *Server) completeSeries(ctx context.Context, seriesID int64, provider metadata.Provider) (SyncSeriesResponse, error) {
 	// Get the series info
 	series, err := s.queries.GetSeries(ctx, seriesID)
 	if err != nil {
 		slog.Error("Failed to get series", slog.Int64("id", seriesID), slog.Any("error", err))
-		writeError(w, http.StatusNotFound, "Series not found")
-		return
+		return SyncSeriesResponse{}, &completionError{status: http.StatusNotFound, message: "Series not found", err: err}
 	}
 
 	// Get existing books in this series
 	existingBooks, err := s.queries.GetBooksBySeries(ctx, &seriesID)
 	if err != nil {
 		slog.Error("Failed to get books for series", slog.Int64("series_id", seriesID), slog.Any("error", err))
-		writeError(w, http.StatusInternalServerError, "Failed to fetch series books")
-		return
+		return SyncSeriesResponse{}, &completionError{status: http.StatusInternalServerError, message: "Failed to fetch series books", err: err}
 	}
 
-	// Build a set of existing Goodreads IDs to avoid duplicates
-	existingGoodreadsIDs := make(map[string]bool)
+	query := metadata.SeriesQuery{Name: series.Name}
+	// Goodreads series ID is stored in the series_id field; 0 means the series was never mapped
+	if series.SeriesID != 0 {
+		query.GoodreadsID = strconv.FormatInt(series.SeriesID, 10)
+	}
+	if authors, err := s.queries.GetSeriesAuthors(ctx, seriesID); err == nil {
+		for _, author := range authors {
+			query.Authors = append(query.Authors, author.Name)
+		}
+	}
 	for _, book := range existingBooks {
-		if book.GoodreadsID != nil && *book.GoodreadsID != "" {
-			existingGoodreadsIDs[*book.GoodreadsID] = true
+		if book.HardcoverBookID != nil && *book.HardcoverBookID != 0 {
+			query.HardcoverBookIDs = append(query.HardcoverBookIDs, *book.HardcoverBookID)
+		}
+	}
+
+	// Fetch series from the metadata providers
+	books, err := provider.SeriesBooks(ctx, query)
+	if err != nil {
+		slog.Error("Failed to fetch series metadata", slog.String("provider", provider.Name()), slog.String("goodreads_id", query.GoodreadsID), slog.String("error_kind", goodreads.ErrorKind(err)), slog.Any("error", err))
+		status, message := metadataErrorStatus(err)
+		// A cancelled bulk run says nothing about the series, so only real failures are recorded
+		if ctx.Err() == nil {
+			s.recordSeriesCheck(ctx, seriesID, err)
 		}
+		return SyncSeriesResponse{}, &completionError{status: status, message: message, err: err}
 	}
 
-	// Goodreads series ID is stored in the series_id field (as int64, but represents Goodreads ID)
-	goodreadsSeriesID := strconv.FormatInt(series.SeriesID, 10)
+	slog.Info("Fetched series books", slog.String("provider", provider.Name()), slog.Int("count", len(books)), slog.String("series_id", query.GoodreadsID))
 
-	// Fetch series from Goodreads
-	grClient := goodreads.NewClient()
-	booksWithPosition, err := grClient.GetSeriesBooks(goodreadsSeriesID)
+	s.recordGoodreadsAuthors(ctx, books)
+
+	// Owned books may be a different edition than the one the series lists, so compare works
+	if needsWorkIDs(existingBooks, books) {
+		s.resolveWorkIDs(ctx, seriesID, existingBooks)
+	}
+
+	// Match the series against what we own; uncertain matches wait for review
+	outcome := s.matchSeriesBooks(ctx, series, existingBooks, books)
+
+	s.recordSeriesCheck(ctx, seriesID, nil)
+
+	changes := 0
+	if series.Followed {
+		changes = s.recordSeriesSnapshot(ctx, series, provider.Name(), books)
+	}
+
+	return SyncSeriesResponse{
+		Status:          "success",
+		Message:         "Successfully synced with " + provider.Name(),
+		SeriesID:        seriesID,
+		ExistingBooks:   len(existingBooks),
+		MissingBooks:    len(books),
+		NewMissingBooks: outcome.created,
+		ReviewBooks:     outcome.review,
+		Changes:         changes,
+	}, nil
+}
+
+// recordSeriesCheck stores when a series was last fetched from the metadata providers and how that went
+func (s *Server) recordSeriesCheck(ctx context.Context, seriesID int64, checkErr error) {
+	status := seriesCheckSucceeded
+	var lastError *string
+	if checkErr != nil {
+		status = seriesCheckFailed
+		message := checkErr.Error()
+		lastError = &message
+	}
+
+	err := s.queries.SetSeriesCheckResult(ctx, db.SetSeriesCheckResultParams{
+		LastCheckStatus: &status,
+		LastError:       lastError,
+		ID:              seriesID,
+	})
 	if err != nil {
-		slog.Error("Failed to fetch Goodreads series", slog.String("goodreads_id", goodreadsSeriesID), slog.Any("error", err))
-		writeError(w, http.StatusInternalServerError, "Failed to fetch from Goodreads")
-		return
+		slog.Error("Failed to record series check", slog.Int64("series_id", seriesID), slog.Any("error", err))
 	}
+}
 
-	slog.Info("Fetched books from Goodreads", slog.Int("count", len(booksWithPosition)), slog.String("series_id", goodreadsSeriesID))
+// createMissingBook stores a series entry we don't own as a missing book in the series
+func (s *Server) createMissingBook(ctx context.Context, series db.Series, book metadata.Book) error {
+	syntheticBookID, ok := syntheticBookID(book)
+	if !ok {
+		return errNoBookIdentifier
+	}
 
-	// Create missing books
-	newMissingCount := 0
-	for _, bp := range booksWithPosition {
-		// Skip books we already have
-		if existingGoodreadsIDs[bp.Book.BookID] {
-			continue
+	// Parse series number from position string (e.g., "1", "1.5", "2")
+	seriesNumber := 0.0
+	if book.SeriesPosition != "" {
+		// Try to parse as float
+		if n, err := strconv.ParseFloat(book.SeriesPosition, 64); err == nil {
+			seriesNumber = n
 		}
+	}
 
-		// Parse series number from position string (e.g., "1", "1.5", "2")
-		seriesNumber := 0.0
-		if bp.SeriesPosition != "" {
-			// Try to parse as float
-			if n, err := strconv.ParseFloat(bp.SeriesPosition, 64); err == nil {
-				seriesNumber = n
-			}
-		}
+	description, descriptionMarkdown := convertDescription(book.Description)
+	toBePublished := book.ToBePublished
+	source := strings.Join(book.Sources, ",")
+	var hardcoverBookID *int64
+	if book.HardcoverBookID != 0 {
+		hardcoverBookID = &book.HardcoverBookID
+	}
 
-		// Extract plain text description from HTML
-		description := bp.Book.Description.TruncatedHTML
-		if description == "" {
-			description = bp.Book.Description.HTML
-		}
-		// Basic HTML stripping
-		description = stripHTML(description)
-
-		// Create a synthetic book_id from Goodreads ID to ensure uniqueness
-		// Use a large offset (10 billion) to avoid conflicts with Booklore IDs
-		goodreadsIDNum, _ := strconv.ParseInt(bp.Book.BookID, 10, 64)
-		syntheticBookID := 10000000000 + goodreadsIDNum
-
-		// Create the missing book entry
-		_, err := s.queries.CreateMissingBook(ctx, db.CreateMissingBookParams{
-			BookID:       syntheticBookID,
-			Title:        bp.Book.Title,
-			Description:  description,
-			SeriesName:   &series.Name,
-			SeriesNumber: &seriesNumber,
-			GoodreadsID:  &bp.Book.BookID,
-			SeriesID:     &seriesID,
-		})
+	created, err := s.queries.CreateMissingBook(ctx, db.CreateMissingBookParams{
+		BookID:              syntheticBookID,
+		Title:               book.Title,
+		Description:         description,
+		DescriptionMarkdown: descriptionMarkdown,
+		SeriesName:          &series.Name,
+		SeriesNumber:        &seriesNumber,
+		Asin:                optionalString(book.ASIN),
+		Isbn10:              optionalString(book.ISBN10),
+		Isbn13:              optionalString(book.ISBN13),
+		HardcoverID:         optionalString(book.HardcoverID),
+		HardcoverBookID:     hardcoverBookID,
+		GoodreadsID:         optionalString(identifiers.GoodreadsID(book.GoodreadsID)),
+		GoogleID:            optionalString(book.GoogleID),
+		SeriesID:            &series.ID,
+		PublicationDate:     optionalString(book.PublicationDate),
+		ToBePublished:       &toBePublished,
+		MetadataSource:      optionalString(source),
+		CoverUrl:            optionalString(book.ImageURL),
+		GoodreadsWorkID:     optionalString(identifiers.GoodreadsID(book.GoodreadsWorkID)),
+	})
+	if err != nil {
+		return fmt.Errorf("creating missing book %q: %w", book.Title, err)
+	}
+	s.linkMissingBookAuthors(ctx, series, created.ID, book.Authors)
+
+	slog.Info("Created missing book", slog.String("title", book.Title), slog.String("goodreads_id", book.GoodreadsID), slog.String("source", source))
+	return nil
+}
 
+// linkMissingBookAuthors credits a missing book to the authors the provider listed that are in our library.
+// When none of them are, the book is credited to the series' authors instead.
+func (s *Server) linkMissingBookAuthors(ctx context.Context, series db.Series, bookID int64, authors []metadata.Author) {
+	var authorIDs []int64
+	for _, author := range authors {
+		known, err := s.queries.GetAuthorByName(ctx, author.Name)
 		if err != nil {
-			slog.Error("Failed to create missing book", slog.String("book_title", bp.Book.Title), slog.Any("error", err))
 			continue
 		}
+		authorIDs = append(authorIDs, known.ID)
+	}
 
-		slog.Info("Created missing book", slog.String("title", bp.Book.Title), slog.String("goodreads_id", bp.Book.BookID))
-		newMissingCount++
+	if len(authorIDs) == 0 {
+		seriesAuthors, err := s.queries.GetSeriesAuthors(ctx, series.ID)
+		if err != nil {
+			slog.Error("Failed to get authors for series", slog.Int64("series_id", series.ID), slog.Any("error", err))
+			return
+		}
+		for _, author := range seriesAuthors {
+			authorIDs = append(authorIDs, author.ID)
+		}
 	}
 
-	response := SyncSeriesResponse{
-		Status:          "success",
-		Message:         "Successfully synced with Goodreads",
-		SeriesID:        seriesID,
-		ExistingBooks:   len(existingBooks),
-		MissingBooks:    len(booksWithPosition),
-		NewMissingBooks: newMissingCount,
+	for _, authorID := range authorIDs {
+		if err := s.queries.LinkBookAuthor(ctx, db.LinkBookAuthorParams{BookID: bookID, AuthorID: authorID}); err != nil {
+			slog.Error("Failed to link missing book to author", slog.Int64("book_id", bookID), slog.Int64("author_id", authorID), slog.Any("error", err))
+		}
 	}
+}
 
-	writeJSON(w, response)
+// recordGoodreadsAuthors stores the Goodreads author IDs found in series data on the matching authors
+func (s *Server) recordGoodreadsAuthors(ctx context.Context, books []metadata.Book) {
+	seen := make(map[string]bool)
+	for _, book := range books {
+		for _, author := range book.Authors {
+			if author.GoodreadsID == "" || author.Name == "" || seen[author.GoodreadsID] {
+				continue
+			}
+			seen[author.GoodreadsID] = true
+
+			goodreadsID := author.GoodreadsID
+			err := s.queries.SetAuthorGoodreadsID(ctx, db.SetAuthorGoodreadsIDParams{
+				GoodreadsID: &goodreadsID,
+				Name:        author.Name,
+			})
+			if err != nil {
+				slog.Error("Failed to store Goodreads author ID", slog.String("author", author.Name), slog.String("goodreads_id", goodreadsID), slog.Any("error", err))
+			}
+		}
+	}
 }
 
-// stripHTML removes HTML tags from a string
-func stripHTML(html string) string {
-	// Simple HTML tag removal - not production grade
-	result := html
-	// Remove common HTML tags
-	tags := []string{"<p>", "</p>", "<br>", "<br/>", "<div>", "</div>", "<span>", "</span>"}
-	for _, tag := range tags {
-		result = strings.ReplaceAll(result, tag, " ")
-	}
-	// Remove any remaining tags
-	inTag := false
-	var cleaned strings.Builder
-	for _, c := range result {
-		if c == '<' {
-			inTag = true
-		} else if c == '>' {
-			inTag = false
-		} else if !inTag {
-			cleaned.WriteRune(c)
+// seriesFilters reads the series list filters from the query string
+func seriesFilters(params url.Values) (db.CountSeriesParams, error) {
+	var filters db.CountSeriesParams
+
+	for name, target := range map[string]**bool{"incomplete": &filters.Incomplete, "unmapped": &filters.Unmapped} {
+		value := params.Get(name)
+		if value == "" {
+			continue
 		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return filters, fmt.Errorf("%s must be true or false, got %q", name, value)
+		}
+		*target = &b
+	}
+
+	if value := params.Get("author_id"); value != "" {
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return filters, fmt.Errorf("invalid author_id %q", value)
+		}
+		filters.AuthorID = &id
 	}
-	return strings.TrimSpace(cleaned.String())
+
+	filters.Name = optionalString(params.Get("q"))
+	return filters, nil
 }
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSeriesFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		check   func(db.CountSeriesParams) bool
+		wantErr bool
+	}{
+		{name: "no filters", query: "", check: func(f db.CountSeriesParams) bool { return f == db.CountSeriesParams{} }},
+		{name: "incomplete", query: "incomplete=true", check: func(f db.CountSeriesParams) bool { return *f.Incomplete && f.Unmapped == nil }},
+		{
+			name:  "author, unmapped and name",
+			query: "author_id=7&unmapped=false&q=trev",
+			check: func(f db.CountSeriesParams) bool {
+				return *f.AuthorID == 7 && !*f.Unmapped && *f.Name == "trev" && f.Incomplete == nil
+			},
+		},
+		{name: "invalid incomplete", query: "incomplete=maybe", wantErr: true},
+		{name: "invalid author", query: "author_id=abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, _ := url.ParseQuery(tt.query)
+			filters, err := seriesFilters(params)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("seriesFilters() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !tt.check(filters) {
+				t.Errorf("seriesFilters() = %+v", filters)
+			}
+		})
+	}
+}
+
+func TestServer_handleListSeriesWithStats(t *testing.T) {
+	t.Run("filters and sort", func(t *testing.T) {
+		incomplete := true
+		mockQuerier := db.NewMockQuerier(t)
+		mockQuerier.On("ListSeriesWithBookStats", mock.Anything, db.ListSeriesWithBookStatsParams{
+			Incomplete: &incomplete,
+			Sort:       "completion_desc",
+			Limit:      20,
+			Offset:     0,
+		}).Return([]db.ListSeriesWithBookStatsRow{{ID: 3, Name: "David Trevellyan", TotalBooks: 3, MissingBooks: 1}}, nil).Once()
+		mockQuerier.On("GetAuthorsForMultipleSeries", mock.Anything, []int64{3}).Return([]db.GetAuthorsForMultipleSeriesRow{}, nil).Once()
+		mockQuerier.On("CountSeries", mock.Anything, db.CountSeriesParams{Incomplete: &incomplete}).Return(int64(1), nil).Once()
+
+		server := &Server{queries: mockQuerier}
+		req := httptest.NewRequest("GET", "/api/series/with-stats?incomplete=true&sort=completion&order=desc", nil)
+		w := httptest.NewRecorder()
+		server.handleListSeriesWithStats(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("status = %d, body = %s", w.Code, w.Body)
+		}
+	})
+
+	t.Run("unknown sort", func(t *testing.T) {
+		server := &Server{queries: db.NewMockQuerier(t)}
+		req := httptest.NewRequest("GET", "/api/series/with-stats?sort=rating", nil)
+		w := httptest.NewRecorder()
+		server.handleListSeriesWithStats(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("status = %d, want %d", w.Code, http.StatusBadRequest)
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"embed"
 	"encoding/json"
+	"fmt"
 	"io/fs"
 	"log/slog"
 	"net"
@@ -16,6 +17,10 @@ import (
 	"github.com/amalgamated-tools/bookscraping/pkg/booklore"
 	"github.com/amalgamated-tools/bookscraping/pkg/db"
 	"github.com/amalgamated-tools/bookscraping/pkg/goodreads"
+	"github.com/amalgamated-tools/bookscraping/pkg/jobs"
+	"github.com/amalgamated-tools/bookscraping/pkg/match"
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+	"github.com/amalgamated-tools/bookscraping/pkg/openlibrary"
 	"github.com/amalgamated-tools/bookscraping/pkg/otel"
 	"github.com/amalgamated-tools/bookscraping/pkg/server/middleware"
 	"github.com/justinas/alice"
@@ -36,6 +41,8 @@ const (
 	HTTPIdleTimeout = 30 * time.Second
 	// HTTPRequestTimeout is the maximum duration for handling a single HTTP request.
 	HTTPRequestTimeout = 10 * time.Second
+	// HTTPTransferTimeout is the read and write timeout of requests that upload or download a whole database or dataset.
+	HTTPTransferTimeout = 30 * time.Minute
 	// ShutdownGracePeriod is the time we allow for graceful shutdown of the http server
 	// Should be longer than HTTPWriteTimeout, but shorter than the k8s terminationGracePeriodSeconds (30 seconds)
 	ShutdownGracePeriod = 15 * time.Second
@@ -50,6 +57,13 @@ type Server struct {
 	queries  db.Querier
 	grClient *goodreads.Client
 	blClient *booklore.Client
+	olClient *openlibrary.Client
+
+	providers     []metadata.Provider
+	mergeMetadata bool
+	matcher       *match.Matcher
+	// refreshDays is the age in days at which series are re-checked in the background; 0 disables it
+	refreshDays int64
 
 	Address string
 	port    int
@@ -63,6 +77,31 @@ type Server struct {
 	// SSE client tracking
 	sseClients map[string]chan string
 	sseMu      sync.RWMutex
+
+	// Bulk completion runs in progress, by run ID
+	completionRuns map[int64]context.CancelCauseFunc
+	completionMu   sync.Mutex
+	// restoring turns new completion runs away while the database is being restored; guarded by completionMu
+	restoring bool
+
+	// maintenance is held for reading by background work that writes to the database,
+	// and for writing while the database is restored underneath it
+	maintenance sync.RWMutex
+
+	// jobs runs queued background work
+	jobs *jobs.Queue
+
+	// authorSeries caches Goodreads author series lists for series discovery
+	authorSeries authorSeriesCache
+
+	// backups snapshots and restores the database; nil when the querier can't
+	backups backupStore
+	// backupDir receives scheduled backups and the backup taken before a restore
+	backupDir string
+	// backupInterval is how often a scheduled backup is taken; 0 disables scheduled backups
+	backupInterval time.Duration
+	// backupRetention is how many backups are kept in backupDir
+	backupRetention int
 }
 
 // NewServer creates a new server instance
@@ -71,6 +110,8 @@ func NewServer(ctx context.Context, opts ...ServerOption) *Server {
 		mux:        http.NewServeMux(),
 		eventCh:    make(chan string, 100),
 		sseClients: make(map[string]chan string),
+
+		completionRuns: make(map[int64]context.CancelCauseFunc),
 	}
 	for _, opt := range opts {
 		opt(s)
@@ -78,6 +119,27 @@ func NewServer(ctx context.Context, opts ...ServerOption) *Server {
 	if s.port == 0 {
 		s.port = 8080
 	}
+	if s.grClient == nil {
+		s.grClient = goodreads.NewClient()
+	}
+	if s.olClient == nil {
+		s.olClient = openlibrary.NewClient()
+	}
+	if len(s.providers) == 0 {
+		s.providers = []metadata.Provider{goodreads.NewProvider(s.grClient)}
+	}
+	if s.matcher == nil {
+		s.matcher = match.New()
+	}
+	if s.jobs == nil {
+		s.jobs = jobs.New(s.queries)
+	}
+	s.registerJobs()
+	if s.backups == nil {
+		if store, ok := s.queries.(backupStore); ok {
+			s.backups = store
+		}
+	}
 	s.Address = net.JoinHostPort("0.0.0.0", strconv.Itoa(s.port))
 
 	s.setupRoutes()
@@ -105,6 +167,11 @@ func (s *Server) Run(ctx context.Context) error {
 		IdleTimeout:  HTTPIdleTimeout,
 	}
 
+	// Runs left "running" by a previous process can only be resumed
+	if err := s.queries.InterruptCompletionRuns(ctx); err != nil {
+		slog.Error("Failed to mark interrupted completion runs", slog.Any("error", err))
+	}
+
 	go func() {
 		err := s.httpServer.ListenAndServe()
 		if err != nil && err != http.ErrServerClosed {
@@ -117,14 +184,37 @@ func (s *Server) Run(ctx context.Context) error {
 		}
 	}()
 
-	s.shutdownFuncs = append(s.shutdownFuncs, s.httpServer.Shutdown)
+	s.shutdownFuncs = append(s.shutdownFuncs, s.httpServer.Shutdown, s.stopCompletionRuns)
+
+	if s.refreshDays > 0 {
+		go s.refreshStaleSeries(ctx)
+	}
+	jobsDone := make(chan struct{})
+	go func() {
+		defer close(jobsDone)
+		s.jobs.Run(ctx)
+	}()
+	// Workers store the outcome of the jobs they were running before they stop
+	s.shutdownFuncs = append(s.shutdownFuncs, func(ctx context.Context) error {
+		select {
+		case <-jobsDone:
+			return nil
+		case <-ctx.Done():
+			return fmt.Errorf("job workers did not stop in time: %w", ctx.Err())
+		}
+	})
+	go s.snapshotLibrary(ctx)
+	if s.backupInterval > 0 && s.backups != nil {
+		go s.runScheduledBackups(ctx)
+	}
 
 	<-ctx.Done()
 	return s.shutdown(ctx)
 }
 
 func (s *Server) shutdown(ctx context.Context) error {
-	ctx, cancel := context.WithTimeout(ctx, ShutdownGracePeriod)
+	// ctx is already cancelled when shutting down; the grace period starts now
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), ShutdownGracePeriod)
 	defer cancel()
 
 	shutdownGroup, ctx := errgroup.WithContext(ctx)
@@ -145,17 +235,78 @@ func (s *Server) setupRoutes() {
 	s.mux.HandleFunc("POST /api/config", s.handleSaveConfig)
 	s.mux.HandleFunc("POST /api/testConnection", s.handleTestConnection)
 
+	s.mux.HandleFunc("GET /api/books", s.handleListBooks)
+	s.mux.HandleFunc("GET /api/books/{id}", s.handleGetBook)
+
+	s.mux.HandleFunc("GET /api/authors", s.handleListAuthors)
+	s.mux.HandleFunc("GET /api/authors/{id}", s.handleGetAuthor)
+
+	s.mux.HandleFunc("GET /api/search", s.handleSearch)
+
+	s.mux.HandleFunc("GET /api/stats", s.handleGetStats)
+	s.mux.HandleFunc("GET /api/stats/history", s.handleGetStatsHistory)
+	s.mux.HandleFunc("GET /api/export/missing", s.handleExportMissing)
+
 	s.mux.HandleFunc("GET /api/series", s.handleListSeries)
 	s.mux.HandleFunc("GET /api/series/with-stats", s.handleListSeriesWithStats)
 	s.mux.HandleFunc("GET /api/series/{id}", s.handleGetSeries)
 	s.mux.HandleFunc("GET /api/series/{id}/books", s.handleGetSeriesBooks)
 	s.mux.HandleFunc("POST /api/series/{id}/goodreads", s.handleGetSeriesFromGoodreads)
+	s.mux.HandleFunc("PUT /api/series/{id}/follow", s.handleFollowSeries)
+	s.mux.HandleFunc("DELETE /api/series/{id}/follow", s.handleUnfollowSeries)
+	s.mux.HandleFunc("GET /api/series/{id}/changes", s.handleListSeriesChanges)
+
+	s.mux.HandleFunc("GET /api/completions", s.handleListCompletionRuns)
+	s.mux.HandleFunc("POST /api/completions", s.handleStartCompletionRun)
+	s.mux.HandleFunc("GET /api/completions/{id}", s.handleGetCompletionRun)
+	s.mux.HandleFunc("POST /api/completions/{id}/cancel", s.handleCancelCompletionRun)
+	s.mux.HandleFunc("POST /api/completions/{id}/resume", s.handleResumeCompletionRun)
+
+	s.mux.HandleFunc("GET /api/jobs", s.handleListJobs)
+	s.mux.HandleFunc("POST /api/jobs", s.handleEnqueueJob)
+	s.mux.HandleFunc("GET /api/jobs/{id}", s.handleGetJob)
+	s.mux.HandleFunc("POST /api/jobs/{id}/retry", s.handleRetryJob)
+
+	s.mux.HandleFunc("GET /api/discover/series", s.handleDiscoverSeries)
+
+	s.mux.HandleFunc("GET /api/metadata/providers", s.handleListMetadataProviders)
+	s.mux.HandleFunc("GET /api/metadata/search", s.handleMetadataSearch)
+	s.mux.HandleFunc("GET /api/openlibrary/isbn/{isbn}", s.handleLookupISBN)
+	s.mux.HandleFunc("POST /api/books/enrich", s.handleEnrichBooks)
+
+	s.mux.HandleFunc("GET /api/reviews", s.handleListMatchReviews)
+	s.mux.HandleFunc("POST /api/reviews/{id}/accept", s.handleAcceptMatchReview)
+	s.mux.HandleFunc("POST /api/reviews/{id}/reject", s.handleRejectMatchReview)
+
+	s.mux.HandleFunc("GET /api/upcoming", s.handleListUpcoming)
+	s.mux.HandleFunc("GET /api/upcoming.ics", s.handleUpcomingCalendar)
 
 	s.mux.HandleFunc("POST /api/sync", s.handleSync)
 
+	s.mux.HandleFunc("GET /api/diagnostics/goodreads", s.handleGoodreadsDiagnostics)
+	s.mux.HandleFunc("GET /api/diagnostics/goodreads/selectors", s.handleGoodreadsSelectors)
+
 	s.mux.HandleFunc("GET /api/events", s.handleEvents)
 	s.mux.HandleFunc("POST /api/events/trigger", s.handleTriggerEvent)
 
+	s.mux.HandleFunc("GET /api/admin/backup", s.handleBackup)
+	s.mux.HandleFunc("POST /api/admin/restore", s.handleRestore)
+	s.mux.HandleFunc("GET /api/admin/export", s.handleExportDataset)
+	s.mux.HandleFunc("POST /api/admin/import", s.handleImportDataset)
+
+	// OPDS catalog for e-reader apps
+	s.mux.HandleFunc("GET /opds", s.handleOPDSRoot)
+	s.mux.HandleFunc("GET /opds/series", s.handleOPDSSeries)
+	s.mux.HandleFunc("GET /opds/series/{id}", s.handleOPDSSeriesBooks)
+	s.mux.HandleFunc("GET /opds/authors", s.handleOPDSAuthors)
+	s.mux.HandleFunc("GET /opds/authors/{id}", s.handleOPDSAuthorBooks)
+	s.mux.HandleFunc("GET /opds/books", s.handleOPDSBooks)
+	s.mux.HandleFunc("GET /opds/missing", s.handleOPDSMissing)
+
+	// Atom feeds for feed readers
+	s.mux.HandleFunc("GET /feeds/missing.atom", s.handleMissingFeed)
+	s.mux.HandleFunc("GET /feeds/upcoming.atom", s.handleUpcomingFeed)
+
 	// Serve embedded frontend
 	distContent, err := fs.Sub(distFS, "dist")
 	if err != nil {
@@ -1,9 +1,15 @@
 package server
 
 import (
+	"time"
+
 	"github.com/amalgamated-tools/bookscraping/pkg/booklore"
 	"github.com/amalgamated-tools/bookscraping/pkg/db"
 	"github.com/amalgamated-tools/bookscraping/pkg/goodreads"
+	"github.com/amalgamated-tools/bookscraping/pkg/jobs"
+	"github.com/amalgamated-tools/bookscraping/pkg/match"
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+	"github.com/amalgamated-tools/bookscraping/pkg/openlibrary"
 )
 
 type ServerOption func(*Server)
@@ -20,12 +26,64 @@ func WithGoodreadsClient(client *goodreads.Client) ServerOption {
 	}
 }
 
+// WithMetadataProviders sets the providers used for series completion, in priority order
+func WithMetadataProviders(providers ...metadata.Provider) ServerOption {
+	return func(s *Server) {
+		s.providers = providers
+	}
+}
+
+// WithMergedMetadata merges the answers of all providers instead of using the first that answers
+func WithMergedMetadata(merge bool) ServerOption {
+	return func(s *Server) {
+		s.mergeMetadata = merge
+	}
+}
+
+// WithMatcher sets the matcher that compares series entries against owned books
+func WithMatcher(matcher *match.Matcher) ServerOption {
+	return func(s *Server) {
+		s.matcher = matcher
+	}
+}
+
+// WithSeriesRefresh re-checks series in the background once they haven't been checked for days; 0 disables it
+func WithSeriesRefresh(days int64) ServerOption {
+	return func(s *Server) {
+		s.refreshDays = days
+	}
+}
+
+// WithJobQueue sets the queue that runs background jobs; by default one is created on the server's querier
+func WithJobQueue(queue *jobs.Queue) ServerOption {
+	return func(s *Server) {
+		s.jobs = queue
+	}
+}
+
+// WithBackups sets where database backups go, how often one is taken (0 disables scheduled backups)
+// and how many are kept
+func WithBackups(dir string, interval time.Duration, retention int) ServerOption {
+	return func(s *Server) {
+		s.backupDir = dir
+		s.backupInterval = interval
+		s.backupRetention = retention
+	}
+}
+
 func WithBookloreClient(client *booklore.Client) ServerOption {
 	return func(s *Server) {
 		s.blClient = client
 	}
 }
 
+// WithOpenLibraryClient sets the client used for ISBN lookups
+func WithOpenLibraryClient(client *openlibrary.Client) ServerOption {
+	return func(s *Server) {
+		s.olClient = client
+	}
+}
+
 func WithAddr(addr string) ServerOption {
 	return func(s *Server) {
 		s.addr = addr
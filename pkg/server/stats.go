@@ -0,0 +1,231 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+)
+
+const (
+	// libraryStatsInterval is how often today's library snapshot is brought up to date
+	libraryStatsInterval = time.Hour
+	// defaultTopAuthors is how many authors GET /api/stats ranks unless asked otherwise
+	defaultTopAuthors = 10
+	// maxTopAuthors bounds the top_authors a caller can ask for
+	maxTopAuthors = 100
+	// defaultHistoryDays is how far back GET /api/stats/history goes unless asked otherwise
+	defaultHistoryDays = 90
+	// maxHistoryDays bounds the days a caller can ask for
+	maxHistoryDays = 3650
+)
+
+// Completion buckets, by the share of a series' books we own
+var completionBuckets = []string{"0-24", "25-49", "50-74", "75-99", "100"}
+
+// LibraryStats is an overview of the library and its gaps
+type LibraryStats struct {
+	Books              BookStats              `json:"books"`
+	Series             SeriesStats            `json:"series"`
+	Completion         []CompletionBucket     `json:"completion"`
+	TopAuthors         []AuthorMissingBooks   `json:"top_authors"`
+	MissingIdentifiers MissingIdentifierStats `json:"missing_identifiers"`
+}
+
+// BookStats counts books by whether we own them
+type BookStats struct {
+	Total   int64 `json:"total"`
+	Owned   int64 `json:"owned"`
+	Missing int64 `json:"missing"`
+	// Unreleased counts the missing books that aren't out yet
+	Unreleased int64 `json:"unreleased"`
+}
+
+// SeriesStats counts series by how complete they are
+type SeriesStats struct {
+	Total      int64 `json:"total"`
+	Complete   int64 `json:"complete"`
+	Incomplete int64 `json:"incomplete"`
+	// Empty counts series without any books
+	Empty    int64 `json:"empty"`
+	Followed int64 `json:"followed"`
+	// Unmapped counts series without a Goodreads series ID
+	Unmapped int64 `json:"unmapped"`
+}
+
+// CompletionBucket counts the series whose owned share of books falls in a percentage range
+type CompletionBucket struct {
+	Bucket string `json:"bucket"`
+	Series int64  `json:"series"`
+}
+
+// AuthorMissingBooks is an author with how many of their books we miss
+type AuthorMissingBooks struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	MissingBooks int64  `json:"missing_books"`
+}
+
+// MissingIdentifierStats counts owned books lacking identifiers
+type MissingIdentifierStats struct {
+	GoodreadsID int64 `json:"goodreads_id"`
+	ISBN        int64 `json:"isbn"`
+	// Any counts books without a Goodreads ID, ISBN, ASIN, Hardcover ID or Google ID
+	Any int64 `json:"any"`
+}
+
+// handleGetStats returns an overview of the library.
+// top_authors sets how many authors to rank by missing books.
+func (s *Server) handleGetStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	topAuthors := int64(defaultTopAuthors)
+	if value := r.URL.Query().Get("top_authors"); value != "" {
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || n < 1 || n > maxTopAuthors {
+			writeError(w, http.StatusBadRequest, "top_authors must be between 1 and "+strconv.Itoa(maxTopAuthors))
+			return
+		}
+		topAuthors = n
+	}
+
+	counts, err := s.queries.GetLibraryStats(ctx)
+	if err != nil {
+		slog.Error("Failed to get library stats", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to get library stats")
+		return
+	}
+
+	distribution, err := s.queries.GetSeriesCompletionDistribution(ctx)
+	if err != nil {
+		slog.Error("Failed to get series completion distribution", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to get library stats")
+		return
+	}
+
+	authors, err := s.queries.ListTopAuthorsByMissingBooks(ctx, topAuthors)
+	if err != nil {
+		slog.Error("Failed to list top authors by missing books", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to get library stats")
+		return
+	}
+
+	stats := LibraryStats{
+		Books: BookStats{
+			Total:      counts.TotalBooks,
+			Owned:      counts.OwnedBooks,
+			Missing:    counts.MissingBooks,
+			Unreleased: counts.UnreleasedBooks,
+		},
+		Series: SeriesStats{
+			Total:      counts.TotalSeries,
+			Complete:   counts.CompleteSeries,
+			Incomplete: counts.IncompleteSeries,
+			Empty:      counts.EmptySeries,
+			Followed:   counts.FollowedSeries,
+			Unmapped:   counts.UnmappedSeries,
+		},
+		Completion: completionDistribution(distribution),
+		TopAuthors: make([]AuthorMissingBooks, len(authors)),
+		MissingIdentifiers: MissingIdentifierStats{
+			GoodreadsID: counts.BooksWithoutGoodreadsID,
+			ISBN:        counts.BooksWithoutIsbn,
+			Any:         counts.BooksWithoutIdentifiers,
+		},
+	}
+	for i, author := range authors {
+		stats.TopAuthors[i] = AuthorMissingBooks{
+			ID:           author.ID,
+			Name:         author.Name,
+			MissingBooks: author.MissingBooks,
+		}
+	}
+
+	writeJSON(w, stats)
+}
+
+// handleGetStatsHistory returns the daily library snapshots of the last days days, oldest first
+func (s *Server) handleGetStatsHistory(w http.ResponseWriter, r *http.Request) {
+	days := defaultHistoryDays
+	if value := r.URL.Query().Get("days"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 || n > maxHistoryDays {
+			writeError(w, http.StatusBadRequest, "days must be between 1 and "+strconv.Itoa(maxHistoryDays))
+			return
+		}
+		days = n
+	}
+
+	since := time.Now().AddDate(0, 0, 1-days).Format(time.DateOnly)
+	snapshots, err := s.queries.ListLibrarySnapshots(r.Context(), since)
+	if err != nil {
+		slog.Error("Failed to list library snapshots", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to list library snapshots")
+		return
+	}
+	if snapshots == nil {
+		snapshots = []db.LibrarySnapshot{}
+	}
+
+	writeJSON(w, snapshots)
+}
+
+// completionDistribution lists every completion bucket in order, including empty ones
+func completionDistribution(rows []db.GetSeriesCompletionDistributionRow) []CompletionBucket {
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Bucket] = row.SeriesCount
+	}
+
+	buckets := make([]CompletionBucket, len(completionBuckets))
+	for i, bucket := range completionBuckets {
+		buckets[i] = CompletionBucket{Bucket: bucket, Series: counts[bucket]}
+	}
+	return buckets
+}
+
+// snapshotLibrary keeps today's library snapshot up to date, so each day keeps its last counts
+func (s *Server) snapshotLibrary(ctx context.Context) {
+	ticker := time.NewTicker(libraryStatsInterval)
+	defer ticker.Stop()
+	for {
+		s.maintenance.RLock()
+		if _, err := s.takeLibrarySnapshot(ctx, time.Now()); err != nil {
+			slog.Error("Failed to snapshot library", slog.Any("error", err))
+		}
+		s.maintenance.RUnlock()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// takeLibrarySnapshot stores the library counts as the snapshot for the day of now
+func (s *Server) takeLibrarySnapshot(ctx context.Context, now time.Time) (db.LibrarySnapshot, error) {
+	counts, err := s.queries.GetLibraryStats(ctx)
+	if err != nil {
+		return db.LibrarySnapshot{}, fmt.Errorf("getting library stats: %w", err)
+	}
+
+	snapshot, err := s.queries.UpsertLibrarySnapshot(ctx, db.UpsertLibrarySnapshotParams{
+		TakenOn:                 now.Format(time.DateOnly),
+		TotalBooks:              counts.TotalBooks,
+		OwnedBooks:              counts.OwnedBooks,
+		MissingBooks:            counts.MissingBooks,
+		UnreleasedBooks:         counts.UnreleasedBooks,
+		TotalSeries:             counts.TotalSeries,
+		CompleteSeries:          counts.CompleteSeries,
+		IncompleteSeries:        counts.IncompleteSeries,
+		BooksWithoutIdentifiers: counts.BooksWithoutIdentifiers,
+	})
+	if err != nil {
+		return db.LibrarySnapshot{}, fmt.Errorf("storing library snapshot: %w", err)
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServer_handleGetStats(t *testing.T) {
+	mockQuerier := db.NewMockQuerier(t)
+	mockQuerier.On("GetLibraryStats", mock.Anything).Return(db.GetLibraryStatsRow{
+		TotalBooks:              10,
+		OwnedBooks:              7,
+		MissingBooks:            3,
+		TotalSeries:             4,
+		IncompleteSeries:        2,
+		BooksWithoutIdentifiers: 1,
+	}, nil).Once()
+	mockQuerier.On("GetSeriesCompletionDistribution", mock.Anything).Return([]db.GetSeriesCompletionDistributionRow{
+		{Bucket: "100", SeriesCount: 2},
+		{Bucket: "25-49", SeriesCount: 2},
+	}, nil).Once()
+	mockQuerier.On("ListTopAuthorsByMissingBooks", mock.Anything, int64(3)).Return([]db.ListTopAuthorsByMissingBooksRow{
+		{ID: 5, Name: "Andrew Grant", MissingBooks: 3},
+	}, nil).Once()
+
+	server := &Server{queries: mockQuerier}
+	req := httptest.NewRequest("GET", "/api/stats?top_authors=3", nil)
+	w := httptest.NewRecorder()
+	server.handleGetStats(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body)
+	}
+	var stats LibraryStats
+	if err := json.Unmarshal(w.Body.Bytes(), &stats); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if stats.Books.Owned != 7 || stats.Books.Missing != 3 || stats.Series.Incomplete != 2 || stats.MissingIdentifiers.Any != 1 {
+		t.Errorf("unexpected counts %+v", stats)
+	}
+	want := []CompletionBucket{{"0-24", 0}, {"25-49", 2}, {"50-74", 0}, {"75-99", 0}, {"100", 2}}
+	if len(stats.Completion) != len(want) {
+		t.Fatalf("completion = %+v, want %+v", stats.Completion, want)
+	}
+	for i := range want {
+		if stats.Completion[i] != want[i] {
+			t.Errorf("completion[%d] = %+v, want %+v", i, stats.Completion[i], want[i])
+		}
+	}
+	if len(stats.TopAuthors) != 1 || stats.TopAuthors[0].Name != "Andrew Grant" {
+		t.Errorf("top authors = %+v", stats.TopAuthors)
+	}
+}
+
+func TestServer_takeLibrarySnapshot(t *testing.T) {
+	mockQuerier := db.NewMockQuerier(t)
+	mockQuerier.On("GetLibraryStats", mock.Anything).Return(db.GetLibraryStatsRow{TotalBooks: 10, OwnedBooks: 7, MissingBooks: 3}, nil).Once()
+	mockQuerier.On("UpsertLibrarySnapshot", mock.Anything, db.UpsertLibrarySnapshotParams{
+		TakenOn:      "2026-10-18",
+		TotalBooks:   10,
+		OwnedBooks:   7,
+		MissingBooks: 3,
+	}).Return(db.LibrarySnapshot{ID: 1, TakenOn: "2026-10-18"}, nil).Once()
+
+	server := &Server{queries: mockQuerier}
+	snapshot, err := server.takeLibrarySnapshot(context.Background(), time.Date(2026, 10, 18, 23, 30, 0, 0, time.Local))
+	if err != nil {
+		t.Fatalf("takeLibrarySnapshot() error = %v", err)
+	}
+	if snapshot.TakenOn != "2026-10-18" {
+		t.Errorf("snapshot taken on %q", snapshot.TakenOn)
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/amalgamated-tools/bookscraping/pkg/booklore"
 	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/identifiers"
 )
 
 func (s *Server) handleSync(w http.ResponseWriter, r *http.Request) {
@@ -123,7 +124,7 @@ func (s *Server) handleSync(w http.ResponseWriter, r *http.Request) {
 		isbn13 := &book.ISBN13
 		hardcoverID := &book.HardCoverID
 		hardcoverBookID := &book.HardCoverBookID
-		goodreadsID := &book.GoodreadsId
+		goodreadsID := optionalString(identifiers.GoodreadsID(book.GoodreadsId))
 		googleID := &book.GoogleId
 
 		var seriesNamePtr *string
@@ -149,21 +150,23 @@ func (s *Server) handleSync(w http.ResponseWriter, r *http.Request) {
 			continue
 		}
 
+		description, descriptionMarkdown := convertDescription(book.Description)
 		insertedBook, err := s.queries.UpsertBook(ctx, db.UpsertBookParams{
-			BookID:          book.ID,
-			Title:           book.Title,
-			Description:     book.Description,
-			SeriesName:      seriesNamePtr,
-			SeriesNumber:    seriesNumberPtr,
-			Asin:            asin,
-			Isbn10:          isbn10,
-			Isbn13:          isbn13,
-			Language:        nil, // Not currently in Book struct
-			HardcoverID:     hardcoverID,
-			HardcoverBookID: hardcoverBookID,
-			GoodreadsID:     goodreadsID,
-			GoogleID:        googleID,
-			Data:            jsonData,
+			BookID:              book.ID,
+			Title:               book.Title,
+			Description:         description,
+			DescriptionMarkdown: descriptionMarkdown,
+			SeriesName:          seriesNamePtr,
+			SeriesNumber:        seriesNumberPtr,
+			Asin:                asin,
+			Isbn10:              isbn10,
+			Isbn13:              isbn13,
+			Language:            nil, // Not currently in Book struct
+			HardcoverID:         hardcoverID,
+			HardcoverBookID:     hardcoverBookID,
+			GoodreadsID:         goodreadsID,
+			GoogleID:            googleID,
+			Data:                jsonData,
 		})
 
 		if err != nil {
@@ -0,0 +1,112 @@
+package server
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/ical"
+)
+
+// handleListUpcoming returns unreleased books in series we follow, soonest first
+func (s *Server) handleListUpcoming(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	format, err := descriptionFormat(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	books, err := s.queries.ListUpcomingBooks(ctx)
+	if err != nil {
+		slog.Error("Failed to list upcoming books", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to list upcoming books")
+		return
+	}
+	applyDescriptionFormat(books, format)
+
+	booksWithAuthors := make([]BookWithAuthors, len(books))
+	for i, book := range books {
+		authors, err := s.queries.GetAuthorsForBook(ctx, book.ID)
+		if err != nil {
+			slog.Error("Failed to get authors for book", slog.Int64("book_id", book.ID), slog.Any("error", err))
+			authors = []db.Author{}
+		}
+
+		authorNames := make([]string, len(authors))
+		for j, author := range authors {
+			authorNames[j] = author.Name
+		}
+
+		booksWithAuthors[i] = BookWithAuthors{
+			Book:    &books[i],
+			Authors: authorNames,
+		}
+	}
+
+	writeJSON(w, booksWithAuthors)
+}
+
+// handleUpcomingCalendar serves upcoming releases as an iCalendar feed.
+// Only books with a full release date become events; month or year precision is skipped.
+func (s *Server) handleUpcomingCalendar(w http.ResponseWriter, r *http.Request) {
+	books, err := s.queries.ListUpcomingBooks(r.Context())
+	if err != nil {
+		slog.Error("Failed to list upcoming books", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to list upcoming books")
+		return
+	}
+
+	cal := ical.Calendar{
+		ProdID: "-//amalgamated-tools//bookscraping//EN",
+		Name:   "Upcoming book releases",
+		Events: upcomingEvents(books),
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="upcoming.ics"`)
+	if err := cal.Write(w, time.Now()); err != nil {
+		slog.Error("Failed to write calendar", slog.Any("error", err))
+	}
+}
+
+// upcomingEvents converts books with a day-precision publication date into calendar events
+func upcomingEvents(books []db.Book) []ical.Event {
+	events := make([]ical.Event, 0, len(books))
+	for _, book := range books {
+		if book.PublicationDate == nil {
+			continue
+		}
+		date, err := time.Parse("2006-01-02", *book.PublicationDate)
+		if err != nil {
+			continue
+		}
+
+		event := ical.Event{
+			UID:         fmt.Sprintf("book-%d@bookscraping", book.ID),
+			Date:        date,
+			Summary:     bookDisplayTitle(book),
+			Description: book.Description,
+		}
+		if book.GoodreadsID != nil && *book.GoodreadsID != "" {
+			event.URL = "https://www.goodreads.com/book/show/" + *book.GoodreadsID
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+// bookDisplayTitle is the book's title followed by its series and position, like "Die Twice (David Trevellyan, #2)"
+func bookDisplayTitle(book db.Book) string {
+	if book.SeriesName == nil || *book.SeriesName == "" {
+		return book.Title
+	}
+	if book.SeriesNumber != nil && *book.SeriesNumber > 0 {
+		return fmt.Sprintf("%s (%s, #%s)", book.Title, *book.SeriesName, strconv.FormatFloat(*book.SeriesNumber, 'f', -1, 64))
+	}
+	return fmt.Sprintf("%s (%s)", book.Title, *book.SeriesName)
+}
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+)
+
+func TestUpcomingEvents(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+	floatPtr := func(f float64) *float64 { return &f }
+
+	books := []db.Book{
+		{ID: 1, Title: "Die Again", SeriesName: strPtr("David Trevellyan"), SeriesNumber: floatPtr(4), GoodreadsID: strPtr("123"), PublicationDate: strPtr("2026-11-03")},
+		{ID: 2, Title: "Month Only", PublicationDate: strPtr("2027-02")},
+		{ID: 3, Title: "Undated"},
+		{ID: 4, Title: "Novella", SeriesName: strPtr("Shorts"), SeriesNumber: floatPtr(2.5), PublicationDate: strPtr("2026-12-01")},
+	}
+
+	events := upcomingEvents(books)
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+
+	first := events[0]
+	if first.UID != "book-1@bookscraping" {
+		t.Errorf("UID = %q", first.UID)
+	}
+	if first.Summary != "Die Again (David Trevellyan, #4)" {
+		t.Errorf("Summary = %q", first.Summary)
+	}
+	if first.URL != "https://www.goodreads.com/book/show/123" {
+		t.Errorf("URL = %q", first.URL)
+	}
+	if got := first.Date.Format("2006-01-02"); got != "2026-11-03" {
+		t.Errorf("Date = %q", got)
+	}
+
+	if events[1].Summary != "Novella (Shorts, #2.5)" {
+		t.Errorf("Summary = %q", events[1].Summary)
+	}
+	if events[1].URL != "" {
+		t.Errorf("expected no URL, got %q", events[1].URL)
+	}
+}
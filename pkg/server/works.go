@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/goodreads"
+	"github.com/amalgamated-tools/bookscraping/pkg/identifiers"
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+)
+
+const (
+	// maxWorkIDLookups bounds the Goodreads pages fetched to resolve work IDs while completing one series
+	maxWorkIDLookups = 5
+	// workIDRetryDays is how long a book whose work ID couldn't be resolved waits before it is looked up again
+	workIDRetryDays = 30
+)
+
+// needsWorkIDs reports whether any series book with a work ID went unmatched while some
+// existing book still lacks its work ID, i.e. whether resolving work IDs could change the result
+func needsWorkIDs(existing []db.Book, books []metadata.Book) bool {
+	unresolved := false
+	for _, b := range existing {
+		if b.GoodreadsID != nil && *b.GoodreadsID != "" && (b.GoodreadsWorkID == nil || *b.GoodreadsWorkID == "") {
+			unresolved = true
+			break
+		}
+	}
+	if !unresolved {
+		return false
+	}
+
+	for _, book := range books {
+		if book.GoodreadsWorkID != "" && !hasBook(existing, book) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveWorkIDs looks up the Goodreads work ID of the series' books that only have an edition ID.
+// Books are updated in place; failures are logged and the book is left without a work ID.
+// Every lookup is recorded, so a book Goodreads can't resolve waits workIDRetryDays before it is tried again,
+// and at most maxWorkIDLookups pages are fetched per call so a completion isn't held up by a large series.
+func (s *Server) resolveWorkIDs(ctx context.Context, seriesID int64, books []db.Book) (resolved, failed int) {
+	recent, err := s.queries.ListRecentWorkIDLookups(ctx, db.ListRecentWorkIDLookupsParams{SeriesID: &seriesID, RetryDays: workIDRetryDays})
+	if err != nil {
+		slog.Error("Failed to list recent work ID lookups", slog.Int64("series_id", seriesID), slog.Any("error", err))
+		return 0, 0
+	}
+	checked := make(map[int64]bool, len(recent))
+	for _, bookID := range recent {
+		checked[bookID] = true
+	}
+
+	lookups := 0
+	for i := range books {
+		book := &books[i]
+		if book.GoodreadsID == nil || *book.GoodreadsID == "" {
+			continue
+		}
+		if book.GoodreadsWorkID != nil && *book.GoodreadsWorkID != "" {
+			continue
+		}
+		if checked[book.ID] {
+			continue
+		}
+		if lookups == maxWorkIDLookups {
+			slog.Info("Leaving the remaining work IDs for a later completion", slog.Int64("series_id", seriesID), slog.Int("lookups", lookups))
+			break
+		}
+		lookups++
+
+		workID, err := s.grClient.GetWorkID(ctx, identifiers.GoodreadsID(*book.GoodreadsID))
+		if ctx.Err() != nil {
+			// A cancelled lookup says nothing about the book
+			return resolved, failed
+		}
+		if markErr := s.queries.MarkWorkIDLookup(ctx, book.ID); markErr != nil {
+			slog.Error("Failed to record work ID lookup", slog.Int64("book_id", book.ID), slog.Any("error", markErr))
+		}
+		if err != nil {
+			slog.Error("Failed to resolve Goodreads work ID", slog.String("goodreads_id", *book.GoodreadsID), slog.String("error_kind", goodreads.ErrorKind(err)), slog.Any("error", err))
+			failed++
+			continue
+		}
+
+		if err := s.queries.SetBookGoodreadsWorkID(ctx, db.SetBookGoodreadsWorkIDParams{GoodreadsWorkID: &workID, ID: book.ID}); err != nil {
+			slog.Error("Failed to store Goodreads work ID", slog.Int64("book_id", book.ID), slog.Any("error", err))
+			failed++
+			continue
+		}
+		book.GoodreadsWorkID = &workID
+		slog.Info("Resolved Goodreads work ID", slog.String("title", book.Title), slog.String("goodreads_id", *book.GoodreadsID), slog.String("work_id", workID))
+		resolved++
+	}
+	return resolved, failed
+}
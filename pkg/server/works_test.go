@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/amalgamated-tools/bookscraping/pkg/db"
+	"github.com/amalgamated-tools/bookscraping/pkg/goodreads"
+	"github.com/amalgamated-tools/bookscraping/pkg/metadata"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServer_resolveWorkIDs(t *testing.T) {
+	grServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/book/show/18656030":
+			_, _ = w.Write([]byte(`<html><body><a href="/work/editions/7573428-die-twice">All editions</a></body></html>`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer grServer.Close()
+
+	strPtr := func(s string) *string { return &s }
+	books := []db.Book{
+		{ID: 1, Title: "Die Twice (Reissue)", GoodreadsID: strPtr("18656030")},
+		{ID: 2, Title: "Already resolved", GoodreadsID: strPtr("123"), GoodreadsWorkID: strPtr("456")},
+		{ID: 3, Title: "Deleted from Goodreads", GoodreadsID: strPtr("999")},
+		{ID: 4, Title: "No Goodreads ID"},
+		{ID: 5, Title: "Looked up last week", GoodreadsID: strPtr("555")},
+	}
+
+	series := []metadata.Book{
+		{Identifiers: metadata.Identifiers{GoodreadsID: "7315139", GoodreadsWorkID: "7573428"}, Title: "Die Twice"},
+	}
+	if !needsWorkIDs(books, series) {
+		t.Fatal("needsWorkIDs() = false, want true")
+	}
+
+	mockQuerier := db.NewMockQuerier(t)
+	seriesID := int64(7)
+	mockQuerier.On("ListRecentWorkIDLookups", mock.Anything, db.ListRecentWorkIDLookupsParams{SeriesID: &seriesID, RetryDays: workIDRetryDays}).Return([]int64{5}, nil).Once()
+	// Both lookups are recorded, including the one Goodreads couldn't answer
+	mockQuerier.On("MarkWorkIDLookup", mock.Anything, int64(1)).Return(nil).Once()
+	mockQuerier.On("MarkWorkIDLookup", mock.Anything, int64(3)).Return(nil).Once()
+	mockQuerier.On("SetBookGoodreadsWorkID", mock.Anything, mock.MatchedBy(func(arg db.SetBookGoodreadsWorkIDParams) bool {
+		return arg.ID == 1 && *arg.GoodreadsWorkID == "7573428"
+	})).Return(nil).Once()
+
+	server := &Server{
+		queries:  mockQuerier,
+		grClient: goodreads.NewClient(goodreads.WithBaseURL(grServer.URL)),
+	}
+
+	resolved, failed := server.resolveWorkIDs(context.Background(), seriesID, books)
+	if resolved != 1 || failed != 1 {
+		t.Errorf("resolveWorkIDs() = %d resolved, %d failed; want 1, 1", resolved, failed)
+	}
+	if !hasBook(books, series[0]) {
+		t.Error("owned edition should match the series book at work level")
+	}
+	if needsWorkIDs(books[:2], series) {
+		t.Error("needsWorkIDs() = true once every book is resolved")
+	}
+}
+
+func TestServer_resolveWorkIDsCapped(t *testing.T) {
+	var fetched atomic.Int32
+	grServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetched.Add(1)
+		http.NotFound(w, r)
+	}))
+	defer grServer.Close()
+
+	var books []db.Book
+	for i := range maxWorkIDLookups + 3 {
+		goodreadsID := strconv.Itoa(1000 + i)
+		books = append(books, db.Book{ID: int64(i + 1), GoodreadsID: &goodreadsID})
+	}
+
+	mockQuerier := db.NewMockQuerier(t)
+	mockQuerier.On("ListRecentWorkIDLookups", mock.Anything, mock.Anything).Return([]int64{}, nil).Once()
+	mockQuerier.On("MarkWorkIDLookup", mock.Anything, mock.Anything).Return(nil).Times(maxWorkIDLookups)
+
+	server := &Server{
+		queries:  mockQuerier,
+		grClient: goodreads.NewClient(goodreads.WithBaseURL(grServer.URL)),
+	}
+	if _, failed := server.resolveWorkIDs(context.Background(), 7, books); failed != maxWorkIDLookups {
+		t.Errorf("resolveWorkIDs() failed = %d, want %d", failed, maxWorkIDLookups)
+	}
+	if got := fetched.Load(); got != maxWorkIDLookups {
+		t.Errorf("fetched %d Goodreads pages, want %d", got, maxWorkIDLookups)
+	}
+}